@@ -0,0 +1,395 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func writeJSON(t *testing.T, w http.ResponseWriter, statusCode int, body any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func writeProblem(t *testing.T, w http.ResponseWriter, statusCode int, message string) {
+	writeJSON(t, w, statusCode, gradingAPI.ResponseError{Error: &message})
+}
+
+// gradesServer serves total grades, honoring limit and offset.
+func gradesServer(t *testing.T, total int, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var list gradingAPI.GradeList
+		for i := offset; i < total && i < offset+limit; i++ {
+			list.Grades = append(list.Grades, gradingAPI.Grade{
+				CourseId:  strconv.Itoa(i),
+				StudentId: strconv.Itoa(i),
+				Grade:     "3",
+				Gpa:       "B",
+			})
+		}
+		list.Pagination = &gradingAPI.Pagination{Limit: limit, Offset: offset, Total: total}
+		writeJSON(t, w, http.StatusOK, list)
+	}))
+}
+
+func TestClient_Grades(t *testing.T) {
+	testCases := map[string]struct {
+		total         int
+		pageSize      int
+		offset        int
+		expectedCount int
+		expectedCalls int32
+	}{
+		"several pages": {
+			total:         25,
+			pageSize:      10,
+			expectedCount: 25,
+			expectedCalls: 3,
+		},
+		"exact pages": {
+			total:         20,
+			pageSize:      10,
+			expectedCount: 20,
+			expectedCalls: 2,
+		},
+		"single page": {
+			total:         5,
+			pageSize:      10,
+			expectedCount: 5,
+			expectedCalls: 1,
+		},
+		"starting at offset": {
+			total:         25,
+			pageSize:      10,
+			offset:        15,
+			expectedCount: 10,
+			expectedCalls: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			srv := gradesServer(t, tc.total, &calls)
+			defer srv.Close()
+
+			c, err := New(srv.URL)
+			require.NoError(t, err)
+
+			it := c.Grades(context.Background(), GradesQuery{Limit: tc.pageSize, Offset: tc.offset})
+			var seen []string
+			for it.Next() {
+				seen = append(seen, it.Grade().CourseId)
+			}
+			require.NoError(t, it.Err())
+			require.Len(t, seen, tc.expectedCount)
+			require.Equal(t, strconv.Itoa(tc.offset), seen[0])
+			require.Equal(t, tc.total, it.Total())
+			require.Equal(t, tc.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestClient_PostGrade(t *testing.T) {
+	studentID, courseID := uuid.New(), uuid.New()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPut, r.Method)
+		require.Equal(t, "/students/"+studentID.String()+"/courses/"+courseID.String()+"/grade", r.URL.Path)
+		var input gradingAPI.GradeInput
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+		if input.Grade > 4 {
+			writeProblem(t, w, http.StatusBadRequest, "invalid grade")
+			return
+		}
+		payload := map[string]interface{}{"grade": input.Grade}
+		if input.Type != nil {
+			payload["type"] = *input.Type
+		}
+		writeJSON(t, w, http.StatusOK, gradingAPI.Event{
+			Id:      uuid.New(),
+			Type:    gradingAPI.GradePosted,
+			Payload: payload,
+		})
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL)
+	require.NoError(t, err)
+	event, err := c.PostGrade(context.Background(), studentID, courseID, 3)
+	require.NoError(t, err)
+	require.Equal(t, gradingAPI.GradePosted, event.Type)
+	require.EqualValues(t, 3, event.Payload["grade"])
+
+	_, err = c.PostGrade(context.Background(), studentID, courseID, 5)
+	require.ErrorIs(t, err, ErrBadRequest)
+
+	event, err = c.PostOutcome(context.Background(), studentID, courseID, gradingAPI.Withdrawn)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, event.Payload["grade"])
+	require.Equal(t, "withdrawn", event.Payload["type"])
+}
+
+func TestClient_ListGrades_ScaleTypes(t *testing.T) {
+	testCases := map[string]struct {
+		query    GradesQuery
+		expected []string
+	}{
+		"default scale":    {query: GradesQuery{}, expected: nil},
+		"one scale":        {query: GradesQuery{ScaleType: "4.0"}, expected: []string{"4.0"}},
+		"several scales":   {query: GradesQuery{ScaleType: "4.0", ScaleTypes: []string{"ECTS"}}, expected: []string{"4.0", "ECTS"}},
+		"more scales only": {query: GradesQuery{ScaleTypes: []string{"ECTS"}}, expected: []string{"default", "ECTS"}},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, tc.expected, r.URL.Query()["scale_type"])
+				writeJSON(t, w, http.StatusOK, gradingAPI.GradeList{Grades: []gradingAPI.Grade{
+					{CourseId: "1", StudentId: "1", Grade: "3", Gpa: "B", Gpas: &map[string]gradingAPI.ScaleGPA{"4.0": {Letter: "B"}, "ECTS": {Letter: "C"}}},
+				}})
+			}))
+			defer srv.Close()
+
+			c, err := New(srv.URL)
+			require.NoError(t, err)
+			page, err := c.ListGrades(context.Background(), tc.query)
+			require.NoError(t, err)
+			require.Equal(t, "C", (*page.Grades[0].Gpas)["ECTS"].Letter)
+		})
+	}
+}
+
+func TestClient_GetCourseStats(t *testing.T) {
+	courseID := uuid.New()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/courses/"+courseID.String()+"/stats", r.URL.Path)
+		if r.URL.Query().Get("scale_type") != "4.0" {
+			writeProblem(t, w, http.StatusNotFound, "course not found")
+			return
+		}
+		writeJSON(t, w, http.StatusOK, gradingAPI.CourseStats{
+			CourseId:  courseID,
+			ScaleType: "4.0",
+			Count:     2,
+			Mean:      3.5,
+			Histogram: []gradingAPI.LetterCount{{Gpa: "A", Count: 1}, {Gpa: "B", Count: 1}},
+		})
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL)
+	require.NoError(t, err)
+	stats, err := c.GetCourseStats(context.Background(), courseID, "4.0")
+	require.NoError(t, err)
+	require.Equal(t, 3.5, stats.Mean)
+	require.Len(t, stats.Histogram, 2)
+
+	_, err = c.GetCourseStats(context.Background(), courseID, "")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClient_Errors(t *testing.T) {
+	testCases := map[string]struct {
+		statusCode      int
+		message         string
+		expectedErr     error
+		expectedMessage string
+	}{
+		"bad request": {
+			statusCode:      http.StatusBadRequest,
+			message:         `parameter "limit" in query has an error`,
+			expectedErr:     ErrBadRequest,
+			expectedMessage: `parameter "limit" in query has an error`,
+		},
+		"not found": {
+			statusCode:  http.StatusNotFound,
+			message:     "not found",
+			expectedErr: ErrNotFound,
+		},
+		"unauthorized": {
+			statusCode:  http.StatusUnauthorized,
+			message:     "missing token",
+			expectedErr: ErrUnauthorized,
+		},
+		"server error": {
+			statusCode:  http.StatusInternalServerError,
+			message:     "Internal Server Error",
+			expectedErr: ErrServer,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				writeProblem(t, w, tc.statusCode, tc.message)
+			}))
+			defer srv.Close()
+
+			c, err := New(srv.URL, WithoutRetries())
+			require.NoError(t, err)
+
+			_, err = c.ListGrades(context.Background(), GradesQuery{})
+			require.ErrorIs(t, err, tc.expectedErr)
+
+			var apiErr *APIError
+			require.True(t, errors.As(err, &apiErr))
+			require.Equal(t, tc.statusCode, apiErr.StatusCode)
+			require.Equal(t, tc.message, apiErr.Message)
+		})
+	}
+}
+
+func TestClient_Retries(t *testing.T) {
+	testCases := map[string]struct {
+		failures      int32
+		failureStatus int
+		maxRetries    int
+		wantErr       error
+		expectedCalls int32
+	}{
+		"recovers after transient failures": {
+			failures:      2,
+			failureStatus: http.StatusServiceUnavailable,
+			maxRetries:    3,
+			expectedCalls: 3,
+		},
+		"gives up after max retries": {
+			failures:      10,
+			failureStatus: http.StatusServiceUnavailable,
+			maxRetries:    2,
+			wantErr:       ErrServer,
+			expectedCalls: 3,
+		},
+		"does not retry client errors": {
+			failures:      10,
+			failureStatus: http.StatusBadRequest,
+			maxRetries:    3,
+			wantErr:       ErrBadRequest,
+			expectedCalls: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var calls int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) <= tc.failures {
+					writeProblem(t, w, tc.failureStatus, http.StatusText(tc.failureStatus))
+					return
+				}
+				writeJSON(t, w, http.StatusOK, gradingAPI.GradeList{
+					Grades:     []gradingAPI.Grade{},
+					Pagination: &gradingAPI.Pagination{Limit: 10},
+				})
+			}))
+			defer srv.Close()
+
+			c, err := New(srv.URL, WithRetryPolicy(RetryPolicy{
+				MaxRetries: tc.maxRetries,
+				MinBackoff: time.Millisecond,
+				MaxBackoff: 5 * time.Millisecond,
+			}))
+			require.NoError(t, err)
+
+			_, err = c.ListGrades(context.Background(), GradesQuery{})
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedCalls, atomic.LoadInt32(&calls))
+		})
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: 10 * time.Millisecond, MaxBackoff: 40 * time.Millisecond}
+	bounds := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond}
+	for attempt, bound := range bounds {
+		var short bool
+		for i := 0; i < 100; i++ {
+			wait := policy.backoff(attempt)
+			require.GreaterOrEqual(t, wait, time.Duration(0))
+			require.LessOrEqual(t, wait, bound)
+			short = short || wait < bound/2
+		}
+		require.True(t, short, "full jitter must wait less than half the bound at times")
+	}
+	require.Zero(t, RetryPolicy{}.backoff(3))
+}
+
+func TestClient_Auth(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			writeProblem(t, w, http.StatusUnauthorized, "unauthorized")
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, WithBearerToken("secret"))
+	require.NoError(t, err)
+	require.NoError(t, c.Live(context.Background()))
+
+	c, err = New(srv.URL, WithTokenSource(func(context.Context) (string, error) {
+		return "secret", nil
+	}))
+	require.NoError(t, err)
+	require.NoError(t, c.Live(context.Background()))
+
+	c, err = New(srv.URL)
+	require.NoError(t, err)
+	require.ErrorIs(t, c.Live(context.Background()), ErrUnauthorized)
+}
+
+func TestClient_Tenant(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "north-high", r.Header.Get("X-Tenant-ID"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, WithTenant("north-high"))
+	require.NoError(t, err)
+	require.NoError(t, c.Live(context.Background()))
+}
+
+func TestClient_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, WithTimeout(20*time.Millisecond), WithoutRetries())
+	require.NoError(t, err)
+
+	start := time.Now()
+	require.Error(t, c.Live(context.Background()))
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+}
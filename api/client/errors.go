@@ -0,0 +1,80 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+)
+
+var (
+	// ErrBadRequest is matched by errors.Is for 400 responses, e.g. a request that violates the API spec.
+	ErrBadRequest = errors.New("bad request")
+	// ErrUnauthorized is matched by errors.Is for 401 responses.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden is matched by errors.Is for 403 responses.
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound is matched by errors.Is for 404 responses.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is matched by errors.Is for 409 responses.
+	ErrConflict = errors.New("conflict")
+	// ErrRateLimited is matched by errors.Is for 429 responses.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrServer is matched by errors.Is for 5xx responses.
+	ErrServer = errors.New("server error")
+)
+
+// APIError is returned when the service answers with an unexpected status code.
+// Message carries the error reported by the service in its problem response, if any.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("grading api: unexpected status code %d", e.StatusCode)
+	}
+	return fmt.Sprintf("grading api: %d: %s", e.StatusCode, e.Message)
+}
+
+// Is reports whether the error belongs to the class of errors described by target.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrBadRequest:
+		return e.StatusCode == http.StatusBadRequest
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return e.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrConflict:
+		return e.StatusCode == http.StatusConflict
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrServer:
+		return e.StatusCode >= http.StatusInternalServerError
+	}
+	return false
+}
+
+// newAPIError maps a problem response of the service to an APIError.
+func newAPIError(resp *http.Response, body []byte) error {
+	apiErr := &APIError{}
+	if resp != nil {
+		apiErr.StatusCode = resp.StatusCode
+	}
+
+	var problem gradingAPI.ResponseError
+	if err := json.Unmarshal(body, &problem); err == nil && problem.Error != nil {
+		apiErr.Message = *problem.Error
+		return apiErr
+	}
+	apiErr.Message = strings.TrimSpace(string(body))
+	return apiErr
+}
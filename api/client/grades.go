@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+)
+
+type (
+	// GradesQuery selects the grades to list.
+	GradesQuery struct {
+		// ScaleType is the scale the GPA letters are computed with; empty uses the service default.
+		ScaleType string
+		// ScaleTypes are more scales the letters are computed with, keyed by scale type in the Gpas of the grades.
+		ScaleTypes []string
+		// Limit is the page size; zero uses the service default.
+		Limit int
+		// Offset is the number of grades to skip.
+		Offset int
+	}
+
+	// GradePage is a page of grades together with its pagination info.
+	GradePage struct {
+		Grades     []gradingAPI.Grade
+		Pagination gradingAPI.Pagination
+	}
+
+	// GradeIterator walks through all the grades matching a query, fetching one page at a time.
+	//
+	//	it := c.Grades(ctx, client.GradesQuery{ScaleType: "4.0"})
+	//	for it.Next() {
+	//		grade := it.Grade()
+	//	}
+	//	if err := it.Err(); err != nil {
+	//		...
+	//	}
+	GradeIterator struct {
+		ctx    context.Context
+		client *Client
+		query  GradesQuery
+
+		page  []gradingAPI.Grade
+		index int
+		total int
+		done  bool
+		err   error
+	}
+)
+
+// ListGrades fetches a single page of grades.
+func (c *Client) ListGrades(ctx context.Context, query GradesQuery) (GradePage, error) {
+	params := &gradingAPI.GetGPAParams{}
+	if query.ScaleType != "" || len(query.ScaleTypes) > 0 {
+		scaleTypes := gradingAPI.ScaleTypes{gradingAPI.ScaleType(query.ScaleType)}
+		if query.ScaleType == "" {
+			scaleTypes[0] = gradingAPI.Default
+		}
+		for _, scaleType := range query.ScaleTypes {
+			scaleTypes = append(scaleTypes, gradingAPI.ScaleType(scaleType))
+		}
+		params.ScaleType = &scaleTypes
+	}
+	if query.Limit != 0 {
+		params.Limit = &query.Limit
+	}
+	if query.Offset != 0 {
+		params.Offset = &query.Offset
+	}
+
+	resp, err := c.api.GetGPAWithResponse(ctx, params)
+	if err != nil {
+		return GradePage{}, fmt.Errorf("failed to list grades: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return GradePage{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+
+	page := GradePage{
+		Grades: resp.JSON200.Grades,
+	}
+	if resp.JSON200.Pagination != nil {
+		page.Pagination = *resp.JSON200.Pagination
+	}
+	return page, nil
+}
+
+// Grades returns an iterator over all the grades matching query, starting at query.Offset.
+// query.Limit is used as the page size.
+func (c *Client) Grades(ctx context.Context, query GradesQuery) *GradeIterator {
+	if query.Limit == 0 {
+		query.Limit = defaultPageSize
+	}
+	return &GradeIterator{
+		ctx:    ctx,
+		client: c,
+		query:  query,
+	}
+}
+
+// Next advances the iterator to the next grade, fetching the next page when needed.
+// It returns false when there are no more grades or an error occurred.
+func (it *GradeIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if it.index+1 < len(it.page) {
+		it.index++
+		return true
+	}
+	if it.done {
+		return false
+	}
+
+	page, err := it.client.ListGrades(it.ctx, it.query)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.page = page.Grades
+	it.index = 0
+	it.total = page.Pagination.Total
+	it.query.Offset += len(page.Grades)
+	if len(page.Grades) < it.query.Limit || it.query.Offset >= it.total {
+		it.done = true
+	}
+	return len(it.page) > 0
+}
+
+// Grade returns the current grade. It is only valid after a call to Next returned true.
+func (it *GradeIterator) Grade() gradingAPI.Grade {
+	return it.page[it.index]
+}
+
+// Total returns the total number of grades reported by the service, once the first page has been fetched.
+func (it *GradeIterator) Total() int {
+	return it.total
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *GradeIterator) Err() error {
+	return it.err
+}
+
+// PostGrade records the grade of a student in a course, replacing the previous one, and returns the event
+// recording the change.
+func (c *Client) PostGrade(ctx context.Context, studentID, courseID uuid.UUID, grade int) (gradingAPI.Event, error) {
+	return c.putGrade(ctx, studentID, courseID, gradingAPI.GradeInput{Grade: grade})
+}
+
+// PostOutcome records an outcome of a student in a course without a numeric grade, e.g. gradingAPI.Withdrawn,
+// replacing the previous grade, and returns the event recording the change.
+func (c *Client) PostOutcome(ctx context.Context, studentID, courseID uuid.UUID, gradeType gradingAPI.GradeType) (gradingAPI.Event, error) {
+	return c.putGrade(ctx, studentID, courseID, gradingAPI.GradeInput{Type: &gradeType})
+}
+
+func (c *Client) putGrade(ctx context.Context, studentID, courseID uuid.UUID, input gradingAPI.GradeInput) (gradingAPI.Event, error) {
+	resp, err := c.api.PutGradeWithResponse(ctx, studentID, courseID, input)
+	if err != nil {
+		return gradingAPI.Event{}, fmt.Errorf("failed to post grade: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return gradingAPI.Event{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return *resp.JSON200, nil
+}
+
+// GetCourseStats returns how a course went under the given scale type, the default one if empty.
+func (c *Client) GetCourseStats(ctx context.Context, courseID uuid.UUID, scaleType string) (gradingAPI.CourseStats, error) {
+	var params gradingAPI.GetCourseStatsParams
+	if scaleType != "" {
+		params.ScaleType = &scaleType
+	}
+	resp, err := c.api.GetCourseStatsWithResponse(ctx, courseID, &params)
+	if err != nil {
+		return gradingAPI.CourseStats{}, fmt.Errorf("failed to get course stats: %w", err)
+	}
+	if resp.StatusCode() != http.StatusOK || resp.JSON200 == nil {
+		return gradingAPI.CourseStats{}, newAPIError(resp.HTTPResponse, resp.Body)
+	}
+	return *resp.JSON200, nil
+}
@@ -0,0 +1,6 @@
+#!/bin/bash
+
+echo "Generating gRPC API"
+go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.31.0
+go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.3.0
+go run github.com/bufbuild/buf/cmd/buf@v1.28.1 generate
@@ -0,0 +1,1204 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: grading.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Grade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseId  string `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentId string `protobuf:"bytes,2,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// grade is 0 for the grades of a type other than numeric, which do not count in GPAs.
+	Grade int32 `protobuf:"varint,3,opt,name=grade,proto3" json:"grade,omitempty"`
+	// gpa is the letter of the grade under the requested scale, the label of its type when not numeric.
+	Gpa string `protobuf:"bytes,4,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	// type is the outcome the grade records: empty for numeric, else pass, fail, incomplete, withdrawn or audit.
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// label is shown instead of the grade when its type is not numeric, e.g. W for withdrawn.
+	Label string `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	// gpas are the letters of the grade keyed by scale type, when several scales were requested.
+	Gpas map[string]string `protobuf:"bytes,7,rep,name=gpas,proto3" json:"gpas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Grade) Reset() {
+	*x = Grade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grade) ProtoMessage() {}
+
+func (x *Grade) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grade.ProtoReflect.Descriptor instead.
+func (*Grade) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Grade) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *Grade) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Grade) GetGrade() int32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+func (x *Grade) GetGpa() string {
+	if x != nil {
+		return x.Gpa
+	}
+	return ""
+}
+
+func (x *Grade) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Grade) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Grade) GetGpas() map[string]string {
+	if x != nil {
+		return x.Gpas
+	}
+	return nil
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Total  int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Pagination) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *Pagination) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListGradesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// scale_type defaults to the default scale when empty and scale_types is too.
+	ScaleType string `protobuf:"bytes,1,opt,name=scale_type,json=scaleType,proto3" json:"scale_type,omitempty"`
+	// limit defaults to 10 and can not exceed 100.
+	Limit  int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// scale_types are the scales the grades are also converted to, after scale_type when given.
+	ScaleTypes []string `protobuf:"bytes,4,rep,name=scale_types,json=scaleTypes,proto3" json:"scale_types,omitempty"`
+}
+
+func (x *ListGradesRequest) Reset() {
+	*x = ListGradesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradesRequest) ProtoMessage() {}
+
+func (x *ListGradesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradesRequest.ProtoReflect.Descriptor instead.
+func (*ListGradesRequest) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListGradesRequest) GetScaleType() string {
+	if x != nil {
+		return x.ScaleType
+	}
+	return ""
+}
+
+func (x *ListGradesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListGradesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListGradesRequest) GetScaleTypes() []string {
+	if x != nil {
+		return x.ScaleTypes
+	}
+	return nil
+}
+
+type ListGradesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grades     []*Grade    `protobuf:"bytes,1,rep,name=grades,proto3" json:"grades,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListGradesResponse) Reset() {
+	*x = ListGradesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGradesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGradesResponse) ProtoMessage() {}
+
+func (x *ListGradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGradesResponse.ProtoReflect.Descriptor instead.
+func (*ListGradesResponse) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListGradesResponse) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+func (x *ListGradesResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetStudentGPARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId string `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// scale_type defaults to the default scale when empty.
+	ScaleType string `protobuf:"bytes,2,opt,name=scale_type,json=scaleType,proto3" json:"scale_type,omitempty"`
+}
+
+func (x *GetStudentGPARequest) Reset() {
+	*x = GetStudentGPARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStudentGPARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentGPARequest) ProtoMessage() {}
+
+func (x *GetStudentGPARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentGPARequest.ProtoReflect.Descriptor instead.
+func (*GetStudentGPARequest) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetStudentGPARequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetStudentGPARequest) GetScaleType() string {
+	if x != nil {
+		return x.ScaleType
+	}
+	return ""
+}
+
+type GetStudentGPAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StudentId string `protobuf:"bytes,1,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"`
+	// average is the mean of all the grades of the student.
+	Average float64 `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	// gpa is the letter of the average under the requested scale.
+	Gpa    string   `protobuf:"bytes,3,opt,name=gpa,proto3" json:"gpa,omitempty"`
+	Grades []*Grade `protobuf:"bytes,4,rep,name=grades,proto3" json:"grades,omitempty"`
+}
+
+func (x *GetStudentGPAResponse) Reset() {
+	*x = GetStudentGPAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStudentGPAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentGPAResponse) ProtoMessage() {}
+
+func (x *GetStudentGPAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentGPAResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentGPAResponse) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetStudentGPAResponse) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetStudentGPAResponse) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *GetStudentGPAResponse) GetGpa() string {
+	if x != nil {
+		return x.Gpa
+	}
+	return ""
+}
+
+func (x *GetStudentGPAResponse) GetGrades() []*Grade {
+	if x != nil {
+		return x.Grades
+	}
+	return nil
+}
+
+type ScaleBand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// min is the lowest grade, inclusive, of the band.
+	Min int32  `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Gpa string `protobuf:"bytes,2,opt,name=gpa,proto3" json:"gpa,omitempty"`
+}
+
+func (x *ScaleBand) Reset() {
+	*x = ScaleBand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScaleBand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleBand) ProtoMessage() {}
+
+func (x *ScaleBand) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleBand.ProtoReflect.Descriptor instead.
+func (*ScaleBand) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScaleBand) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ScaleBand) GetGpa() string {
+	if x != nil {
+		return x.Gpa
+	}
+	return ""
+}
+
+type Scale struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScaleType string `protobuf:"bytes,1,opt,name=scale_type,json=scaleType,proto3" json:"scale_type,omitempty"`
+	// bands are sorted by min in descending order.
+	Bands []*ScaleBand `protobuf:"bytes,2,rep,name=bands,proto3" json:"bands,omitempty"`
+}
+
+func (x *Scale) Reset() {
+	*x = Scale{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scale) ProtoMessage() {}
+
+func (x *Scale) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scale.ProtoReflect.Descriptor instead.
+func (*Scale) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Scale) GetScaleType() string {
+	if x != nil {
+		return x.ScaleType
+	}
+	return ""
+}
+
+func (x *Scale) GetBands() []*ScaleBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+type GetScaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScaleType string `protobuf:"bytes,1,opt,name=scale_type,json=scaleType,proto3" json:"scale_type,omitempty"`
+}
+
+func (x *GetScaleRequest) Reset() {
+	*x = GetScaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScaleRequest) ProtoMessage() {}
+
+func (x *GetScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScaleRequest.ProtoReflect.Descriptor instead.
+func (*GetScaleRequest) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetScaleRequest) GetScaleType() string {
+	if x != nil {
+		return x.ScaleType
+	}
+	return ""
+}
+
+type GetScaleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scale *Scale `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (x *GetScaleResponse) Reset() {
+	*x = GetScaleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScaleResponse) ProtoMessage() {}
+
+func (x *GetScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScaleResponse.ProtoReflect.Descriptor instead.
+func (*GetScaleResponse) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetScaleResponse) GetScale() *Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return nil
+}
+
+type SetScaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScaleType string       `protobuf:"bytes,1,opt,name=scale_type,json=scaleType,proto3" json:"scale_type,omitempty"`
+	Bands     []*ScaleBand `protobuf:"bytes,2,rep,name=bands,proto3" json:"bands,omitempty"`
+}
+
+func (x *SetScaleRequest) Reset() {
+	*x = SetScaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScaleRequest) ProtoMessage() {}
+
+func (x *SetScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScaleRequest.ProtoReflect.Descriptor instead.
+func (*SetScaleRequest) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetScaleRequest) GetScaleType() string {
+	if x != nil {
+		return x.ScaleType
+	}
+	return ""
+}
+
+func (x *SetScaleRequest) GetBands() []*ScaleBand {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+type SetScaleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// scale is the stored scale.
+	Scale *Scale `protobuf:"bytes,1,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (x *SetScaleResponse) Reset() {
+	*x = SetScaleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetScaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScaleResponse) ProtoMessage() {}
+
+func (x *SetScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScaleResponse.ProtoReflect.Descriptor instead.
+func (*SetScaleResponse) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetScaleResponse) GetScale() *Scale {
+	if x != nil {
+		return x.Scale
+	}
+	return nil
+}
+
+type DeleteScaleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScaleType string `protobuf:"bytes,1,opt,name=scale_type,json=scaleType,proto3" json:"scale_type,omitempty"`
+}
+
+func (x *DeleteScaleRequest) Reset() {
+	*x = DeleteScaleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteScaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScaleRequest) ProtoMessage() {}
+
+func (x *DeleteScaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScaleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScaleRequest) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteScaleRequest) GetScaleType() string {
+	if x != nil {
+		return x.ScaleType
+	}
+	return ""
+}
+
+type DeleteScaleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteScaleResponse) Reset() {
+	*x = DeleteScaleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grading_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteScaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteScaleResponse) ProtoMessage() {}
+
+func (x *DeleteScaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grading_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteScaleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScaleResponse) Descriptor() ([]byte, []int) {
+	return file_grading_proto_rawDescGZIP(), []int{13}
+}
+
+var File_grading_proto protoreflect.FileDescriptor
+
+var file_grading_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x22, 0xff, 0x01, 0x0a, 0x05,
+	0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x67, 0x70, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x67, 0x70, 0x61, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x72, 0x61, 0x64, 0x65, 0x2e, 0x47, 0x70, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x67, 0x70, 0x61, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x47, 0x70, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a,
+	0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22,
+	0x81, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x50, 0x41, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x47, 0x50, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x67, 0x70, 0x61, 0x12, 0x29, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x61, 0x64, 0x65, 0x52, 0x06, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x73, 0x22, 0x2f, 0x0a, 0x09, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6d, 0x69,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x70, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x67, 0x70, 0x61, 0x22, 0x53, 0x0a, 0x05, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x61, 0x6e,
+	0x64, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x3b, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65,
+	0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x5d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x63,
+	0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x62, 0x61, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x52,
+	0x05, 0x62, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x3b, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x72, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0x33, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0x91, 0x03, 0x0a, 0x0e, 0x47, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73,
+	0x12, 0x1d, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x47, 0x72, 0x61, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x54, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x50, 0x41,
+	0x12, 0x20, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x50, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x75, 0x64, 0x65, 0x6e, 0x74, 0x47, 0x50, 0x41, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x08,
+	0x53, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x61,
+	0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x6e, 0x61, 0x62, 0x62, 0x61, 0x73, 0x61, 0x62, 0x61, 0x64, 0x69, 0x2f, 0x67,
+	0x72, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f,
+	0x76, 0x31, 0x3b, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_grading_proto_rawDescOnce sync.Once
+	file_grading_proto_rawDescData = file_grading_proto_rawDesc
+)
+
+func file_grading_proto_rawDescGZIP() []byte {
+	file_grading_proto_rawDescOnce.Do(func() {
+		file_grading_proto_rawDescData = protoimpl.X.CompressGZIP(file_grading_proto_rawDescData)
+	})
+	return file_grading_proto_rawDescData
+}
+
+var file_grading_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_grading_proto_goTypes = []interface{}{
+	(*Grade)(nil),                 // 0: grading.v1.Grade
+	(*Pagination)(nil),            // 1: grading.v1.Pagination
+	(*ListGradesRequest)(nil),     // 2: grading.v1.ListGradesRequest
+	(*ListGradesResponse)(nil),    // 3: grading.v1.ListGradesResponse
+	(*GetStudentGPARequest)(nil),  // 4: grading.v1.GetStudentGPARequest
+	(*GetStudentGPAResponse)(nil), // 5: grading.v1.GetStudentGPAResponse
+	(*ScaleBand)(nil),             // 6: grading.v1.ScaleBand
+	(*Scale)(nil),                 // 7: grading.v1.Scale
+	(*GetScaleRequest)(nil),       // 8: grading.v1.GetScaleRequest
+	(*GetScaleResponse)(nil),      // 9: grading.v1.GetScaleResponse
+	(*SetScaleRequest)(nil),       // 10: grading.v1.SetScaleRequest
+	(*SetScaleResponse)(nil),      // 11: grading.v1.SetScaleResponse
+	(*DeleteScaleRequest)(nil),    // 12: grading.v1.DeleteScaleRequest
+	(*DeleteScaleResponse)(nil),   // 13: grading.v1.DeleteScaleResponse
+	nil,                           // 14: grading.v1.Grade.GpasEntry
+}
+var file_grading_proto_depIdxs = []int32{
+	14, // 0: grading.v1.Grade.gpas:type_name -> grading.v1.Grade.GpasEntry
+	0,  // 1: grading.v1.ListGradesResponse.grades:type_name -> grading.v1.Grade
+	1,  // 2: grading.v1.ListGradesResponse.pagination:type_name -> grading.v1.Pagination
+	0,  // 3: grading.v1.GetStudentGPAResponse.grades:type_name -> grading.v1.Grade
+	6,  // 4: grading.v1.Scale.bands:type_name -> grading.v1.ScaleBand
+	7,  // 5: grading.v1.GetScaleResponse.scale:type_name -> grading.v1.Scale
+	6,  // 6: grading.v1.SetScaleRequest.bands:type_name -> grading.v1.ScaleBand
+	7,  // 7: grading.v1.SetScaleResponse.scale:type_name -> grading.v1.Scale
+	2,  // 8: grading.v1.GradingService.ListGrades:input_type -> grading.v1.ListGradesRequest
+	4,  // 9: grading.v1.GradingService.GetStudentGPA:input_type -> grading.v1.GetStudentGPARequest
+	8,  // 10: grading.v1.GradingService.GetScale:input_type -> grading.v1.GetScaleRequest
+	10, // 11: grading.v1.GradingService.SetScale:input_type -> grading.v1.SetScaleRequest
+	12, // 12: grading.v1.GradingService.DeleteScale:input_type -> grading.v1.DeleteScaleRequest
+	3,  // 13: grading.v1.GradingService.ListGrades:output_type -> grading.v1.ListGradesResponse
+	5,  // 14: grading.v1.GradingService.GetStudentGPA:output_type -> grading.v1.GetStudentGPAResponse
+	9,  // 15: grading.v1.GradingService.GetScale:output_type -> grading.v1.GetScaleResponse
+	11, // 16: grading.v1.GradingService.SetScale:output_type -> grading.v1.SetScaleResponse
+	13, // 17: grading.v1.GradingService.DeleteScale:output_type -> grading.v1.DeleteScaleResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_grading_proto_init() }
+func file_grading_proto_init() {
+	if File_grading_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_grading_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Grade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGradesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGradesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStudentGPARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStudentGPAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScaleBand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scale); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScaleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetScaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetScaleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScaleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grading_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteScaleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_grading_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_grading_proto_goTypes,
+		DependencyIndexes: file_grading_proto_depIdxs,
+		MessageInfos:      file_grading_proto_msgTypes,
+	}.Build()
+	File_grading_proto = out.File
+	file_grading_proto_rawDesc = nil
+	file_grading_proto_goTypes = nil
+	file_grading_proto_depIdxs = nil
+}
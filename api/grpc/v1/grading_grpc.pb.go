@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: grading.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GradingService_ListGrades_FullMethodName    = "/grading.v1.GradingService/ListGrades"
+	GradingService_GetStudentGPA_FullMethodName = "/grading.v1.GradingService/GetStudentGPA"
+	GradingService_GetScale_FullMethodName      = "/grading.v1.GradingService/GetScale"
+	GradingService_SetScale_FullMethodName      = "/grading.v1.GradingService/SetScale"
+	GradingService_DeleteScale_FullMethodName   = "/grading.v1.GradingService/DeleteScale"
+)
+
+// GradingServiceClient is the client API for GradingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GradingServiceClient interface {
+	// ListGrades lists the grades of all students with their GPA letter under one or several scales.
+	ListGrades(ctx context.Context, in *ListGradesRequest, opts ...grpc.CallOption) (*ListGradesResponse, error)
+	// GetStudentGPA returns the grades of a student and the GPA of their average grade under a scale.
+	GetStudentGPA(ctx context.Context, in *GetStudentGPARequest, opts ...grpc.CallOption) (*GetStudentGPAResponse, error)
+	// GetScale returns the bands of a scale.
+	GetScale(ctx context.Context, in *GetScaleRequest, opts ...grpc.CallOption) (*GetScaleResponse, error)
+	// SetScale replaces all the bands of a scale.
+	SetScale(ctx context.Context, in *SetScaleRequest, opts ...grpc.CallOption) (*SetScaleResponse, error)
+	// DeleteScale removes all the bands of a scale.
+	DeleteScale(ctx context.Context, in *DeleteScaleRequest, opts ...grpc.CallOption) (*DeleteScaleResponse, error)
+}
+
+type gradingServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradingServiceClient(cc grpc.ClientConnInterface) GradingServiceClient {
+	return &gradingServiceClient{cc}
+}
+
+func (c *gradingServiceClient) ListGrades(ctx context.Context, in *ListGradesRequest, opts ...grpc.CallOption) (*ListGradesResponse, error) {
+	out := new(ListGradesResponse)
+	err := c.cc.Invoke(ctx, GradingService_ListGrades_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetStudentGPA(ctx context.Context, in *GetStudentGPARequest, opts ...grpc.CallOption) (*GetStudentGPAResponse, error) {
+	out := new(GetStudentGPAResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetStudentGPA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) GetScale(ctx context.Context, in *GetScaleRequest, opts ...grpc.CallOption) (*GetScaleResponse, error) {
+	out := new(GetScaleResponse)
+	err := c.cc.Invoke(ctx, GradingService_GetScale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) SetScale(ctx context.Context, in *SetScaleRequest, opts ...grpc.CallOption) (*SetScaleResponse, error) {
+	out := new(SetScaleResponse)
+	err := c.cc.Invoke(ctx, GradingService_SetScale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradingServiceClient) DeleteScale(ctx context.Context, in *DeleteScaleRequest, opts ...grpc.CallOption) (*DeleteScaleResponse, error) {
+	out := new(DeleteScaleResponse)
+	err := c.cc.Invoke(ctx, GradingService_DeleteScale_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradingServiceServer is the server API for GradingService service.
+// All implementations must embed UnimplementedGradingServiceServer
+// for forward compatibility
+type GradingServiceServer interface {
+	// ListGrades lists the grades of all students with their GPA letter under one or several scales.
+	ListGrades(context.Context, *ListGradesRequest) (*ListGradesResponse, error)
+	// GetStudentGPA returns the grades of a student and the GPA of their average grade under a scale.
+	GetStudentGPA(context.Context, *GetStudentGPARequest) (*GetStudentGPAResponse, error)
+	// GetScale returns the bands of a scale.
+	GetScale(context.Context, *GetScaleRequest) (*GetScaleResponse, error)
+	// SetScale replaces all the bands of a scale.
+	SetScale(context.Context, *SetScaleRequest) (*SetScaleResponse, error)
+	// DeleteScale removes all the bands of a scale.
+	DeleteScale(context.Context, *DeleteScaleRequest) (*DeleteScaleResponse, error)
+	mustEmbedUnimplementedGradingServiceServer()
+}
+
+// UnimplementedGradingServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGradingServiceServer struct {
+}
+
+func (UnimplementedGradingServiceServer) ListGrades(context.Context, *ListGradesRequest) (*ListGradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrades not implemented")
+}
+func (UnimplementedGradingServiceServer) GetStudentGPA(context.Context, *GetStudentGPARequest) (*GetStudentGPAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentGPA not implemented")
+}
+func (UnimplementedGradingServiceServer) GetScale(context.Context, *GetScaleRequest) (*GetScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScale not implemented")
+}
+func (UnimplementedGradingServiceServer) SetScale(context.Context, *SetScaleRequest) (*SetScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetScale not implemented")
+}
+func (UnimplementedGradingServiceServer) DeleteScale(context.Context, *DeleteScaleRequest) (*DeleteScaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScale not implemented")
+}
+func (UnimplementedGradingServiceServer) mustEmbedUnimplementedGradingServiceServer() {}
+
+// UnsafeGradingServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradingServiceServer will
+// result in compilation errors.
+type UnsafeGradingServiceServer interface {
+	mustEmbedUnimplementedGradingServiceServer()
+}
+
+func RegisterGradingServiceServer(s grpc.ServiceRegistrar, srv GradingServiceServer) {
+	s.RegisterService(&GradingService_ServiceDesc, srv)
+}
+
+func _GradingService_ListGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).ListGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_ListGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).ListGrades(ctx, req.(*ListGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetStudentGPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentGPARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetStudentGPA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetStudentGPA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetStudentGPA(ctx, req.(*GetStudentGPARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_GetScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).GetScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_GetScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).GetScale(ctx, req.(*GetScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_SetScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).SetScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_SetScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).SetScale(ctx, req.(*SetScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradingService_DeleteScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradingServiceServer).DeleteScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradingService_DeleteScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradingServiceServer).DeleteScale(ctx, req.(*DeleteScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradingService_ServiceDesc is the grpc.ServiceDesc for GradingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grading.v1.GradingService",
+	HandlerType: (*GradingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListGrades",
+			Handler:    _GradingService_ListGrades_Handler,
+		},
+		{
+			MethodName: "GetStudentGPA",
+			Handler:    _GradingService_GetStudentGPA_Handler,
+		},
+		{
+			MethodName: "GetScale",
+			Handler:    _GradingService_GetScale_Handler,
+		},
+		{
+			MethodName: "SetScale",
+			Handler:    _GradingService_SetScale_Handler,
+		},
+		{
+			MethodName: "DeleteScale",
+			Handler:    _GradingService_DeleteScale_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "grading.proto",
+}
@@ -15,45 +15,228 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 )
 
+// Defines values for ConversionMethod.
+const (
+	Linear     ConversionMethod = "linear"
+	Percentile ConversionMethod = "percentile"
+	Table      ConversionMethod = "table"
+)
+
+// Defines values for DeliveryStatus.
+const (
+	Dead      DeliveryStatus = "dead"
+	Delivered DeliveryStatus = "delivered"
+	Pending   DeliveryStatus = "pending"
+)
+
+// Defines values for EventType.
+const (
+	GradeChanged           EventType = "grade.changed"
+	GradePosted            EventType = "grade.posted"
+	ScaleUpdated           EventType = "scale.updated"
+	StudentGpaChanged      EventType = "student.gpa_changed"
+	StudentStandingFlagged EventType = "student.standing_flagged"
+)
+
+// Defines values for GradeType.
+const (
+	Audit      GradeType = "audit"
+	Fail       GradeType = "fail"
+	Incomplete GradeType = "incomplete"
+	Pass       GradeType = "pass"
+	Withdrawn  GradeType = "withdrawn"
+)
+
 // Defines values for ScaleType.
 const (
-	ScaleTypeECTS ScaleType = "ECTS"
-	ScaleTypeN10  ScaleType = "10"
-	ScaleTypeN4   ScaleType = "4"
-	ScaleTypeN43  ScaleType = "4.3"
-	ScaleTypeN5   ScaleType = "5"
-	ScaleTypeN7   ScaleType = "7"
+	Default ScaleType = "default"
+	ECTS    ScaleType = "ECTS"
+	N100    ScaleType = "10.0"
+	N40     ScaleType = "4.0"
+	N43     ScaleType = "4.3"
+	N50     ScaleType = "5.0"
+	N70     ScaleType = "7.0"
 )
 
-// Defines values for GetGPAParamsScaleType.
+// Defines values for TieMethod.
 const (
-	GetGPAParamsScaleTypeECTS GetGPAParamsScaleType = "ECTS"
-	GetGPAParamsScaleTypeN10  GetGPAParamsScaleType = "10"
-	GetGPAParamsScaleTypeN4   GetGPAParamsScaleType = "4"
-	GetGPAParamsScaleTypeN43  GetGPAParamsScaleType = "4.3"
-	GetGPAParamsScaleTypeN5   GetGPAParamsScaleType = "5"
-	GetGPAParamsScaleTypeN7   GetGPAParamsScaleType = "7"
+	Competition TieMethod = "competition"
+	Dense       TieMethod = "dense"
 )
 
+// ClassRank defines model for ClassRank.
+type ClassRank struct {
+	// Average average of all the grades of the student
+	Average    float64 `json:"average"`
+	CohortSize int     `json:"cohort_size"`
+
+	// CourseId the course of the cohort, absent for every graded student
+	CourseId *openapi_types.UUID `json:"course_id,omitempty"`
+
+	// Percentile percentage of the cohort with an average up to the one of the student
+	Percentile float64 `json:"percentile"`
+
+	// Rank position in the cohort, 1 for the highest average
+	Rank      int                `json:"rank"`
+	StudentId openapi_types.UUID `json:"student_id"`
+	Ties      TieMethod          `json:"ties"`
+}
+
+// Conversion defines model for Conversion.
+type Conversion struct {
+	From       string `json:"from"`
+	FromGrade  int    `json:"from_grade"`
+	FromLetter string `json:"from_letter"`
+
+	// Grade the converted grade, the lowest of the band of the letter with a table
+	Grade  int    `json:"grade"`
+	Letter string `json:"letter"`
+
+	// Method table maps the letters with the configured table of the scales, linear puts the grade at the same position in the range of the other scale, from the lowest to the highest grade of its bands, percentile gives the grade at the same percent rank among the grades of the tenant on the other scale, those its bands have a letter for
+	Method ConversionMethod `json:"method"`
+	To     string           `json:"to"`
+}
+
+// ConversionInput defines model for ConversionInput.
+type ConversionInput struct {
+	// From the scale type of the grade
+	From string `json:"from"`
+
+	// Grade the grade to convert, unless a letter is given
+	Grade *int `json:"grade,omitempty"`
+
+	// Letter the letter to convert, from the lowest grade of its band, unless a grade is given
+	Letter *string `json:"letter,omitempty"`
+
+	// Method table maps the letters with the configured table of the scales, linear puts the grade at the same position in the range of the other scale, from the lowest to the highest grade of its bands, percentile gives the grade at the same percent rank among the grades of the tenant on the other scale, those its bands have a letter for
+	Method *ConversionMethod `json:"method,omitempty"`
+
+	// To the scale type to convert the grade to
+	To string `json:"to"`
+}
+
+// ConversionMethod table maps the letters with the configured table of the scales, linear puts the grade at the same position in the range of the other scale, from the lowest to the highest grade of its bands, percentile gives the grade at the same percent rank among the grades of the tenant on the other scale, those its bands have a letter for
+type ConversionMethod string
+
+// CourseStats defines model for CourseStats.
+type CourseStats struct {
+	// Count number of grades
+	Count    int                `json:"count"`
+	CourseId openapi_types.UUID `json:"course_id"`
+
+	// Histogram a bar per band of the scale, the highest first, including the empty ones
+	Histogram []LetterCount `json:"histogram"`
+	Max       int           `json:"max"`
+	Mean      float64       `json:"mean"`
+	Median    float64       `json:"median"`
+	Min       int           `json:"min"`
+
+	// Percentiles grades below which the given share of the grades fall, interpolated between grades
+	Percentiles struct {
+		P10 float64 `json:"p10"`
+		P25 float64 `json:"p25"`
+		P75 float64 `json:"p75"`
+		P90 float64 `json:"p90"`
+	} `json:"percentiles"`
+
+	// ScaleType the scale of the histogram
+	ScaleType string `json:"scale_type"`
+
+	// StdDev population standard deviation
+	StdDev float64 `json:"std_dev"`
+}
+
+// Delivery defines model for Delivery.
+type Delivery struct {
+	Attempts  int                `json:"attempts"`
+	CreatedAt time.Time          `json:"created_at"`
+	EventId   openapi_types.UUID `json:"event_id"`
+	EventType EventType          `json:"event_type"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// LastError why the last attempt failed
+	LastError *string `json:"last_error,omitempty"`
+
+	// LastStatusCode the status the webhook answered the last attempt with, if it answered
+	LastStatusCode *int      `json:"last_status_code,omitempty"`
+	NextAttemptAt  time.Time `json:"next_attempt_at"`
+
+	// Status pending until delivered, or dead once out of attempts
+	Status    DeliveryStatus     `json:"status"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	WebhookId openapi_types.UUID `json:"webhook_id"`
+}
+
+// DeliveryStatus pending until delivered, or dead once out of attempts
+type DeliveryStatus string
+
+// DeliveryList defines model for DeliveryList.
+type DeliveryList struct {
+	Deliveries []Delivery `json:"deliveries"`
+
+	// Pagination pagination for response
+	Pagination Pagination `json:"pagination"`
+}
+
+// Event defines model for Event.
+type Event struct {
+	// Id event id, for consumers to deduplicate deliveries
+	Id         openapi_types.UUID `json:"id"`
+	OccurredAt time.Time          `json:"occurred_at"`
+
+	// Payload the change, whose fields depend on the type
+	Payload map[string]interface{} `json:"payload"`
+
+	// TenantId the tenant the change was made in, whose webhooks the event is delivered to
+	TenantId *string   `json:"tenant_id,omitempty"`
+	Type     EventType `json:"type"`
+}
+
+// EventType defines model for EventType.
+type EventType string
+
 // Grade defines model for Grade.
 type Grade struct {
 	// CourseId course name
 	CourseId string `json:"course_id"`
 
-	// Gpa grade point average
+	// Gpa grade point average, the label of its type for the grades other than numeric ones
 	Gpa string `json:"gpa"`
 
-	// Grade grade
+	// Gpas the grade under every scale type asked for with scale_type, keyed by scale type
+	Gpas *map[string]ScaleGPA `json:"gpas,omitempty"`
+
+	// Grade grade, the label of its type for the grades other than numeric ones
 	Grade string `json:"grade"`
 
 	// StudentId student id
 	StudentId string `json:"student_id"`
+
+	// Type the outcome of a grade without a numeric value, shown as P, NP, I, W and AU and not counted in GPAs, averages, ranks and course stats. absent for numeric grades.
+	Type *GradeType `json:"type,omitempty"`
+}
+
+// GradeInput defines model for GradeInput.
+type GradeInput struct {
+	// Credits the credits of the course
+	Credits *int `json:"credits,omitempty"`
+
+	// Grade grade, 0 for the grades with a type
+	Grade int `json:"grade"`
+
+	// Term the term the grade was given in, named to sort chronologically
+	Term *string `json:"term,omitempty"`
+
+	// Type the outcome of a grade without a numeric value, shown as P, NP, I, W and AU and not counted in GPAs, averages, ranks and course stats. absent for numeric grades.
+	Type *GradeType `json:"type,omitempty"`
 }
 
 // GradeList defines model for GradeList.
@@ -64,6 +247,15 @@ type GradeList struct {
 	Pagination *Pagination `json:"pagination,omitempty"`
 }
 
+// GradeType the outcome of a grade without a numeric value, shown as P, NP, I, W and AU and not counted in GPAs, averages, ranks and course stats. absent for numeric grades.
+type GradeType string
+
+// LetterCount defines model for LetterCount.
+type LetterCount struct {
+	Count int    `json:"count"`
+	Gpa   string `json:"gpa"`
+}
+
 // Pagination pagination for response
 type Pagination struct {
 	// Limit number of items per page
@@ -82,23 +274,176 @@ type ResponseError struct {
 	Error *string `json:"error,omitempty"`
 }
 
+// ScaleGPA defines model for ScaleGPA.
+type ScaleGPA struct {
+	// Letter the letter or points of the grade under the scale, the label of its type for the grades other than numeric ones
+	Letter string `json:"letter"`
+
+	// Points the value of the points, absent for the scales giving letters
+	Points *float64 `json:"points,omitempty"`
+}
+
 // ScaleType defines model for ScaleType.
 type ScaleType string
 
+// Standing defines model for Standing.
+type Standing struct {
+	CumulativeCredits int `json:"cumulative_credits"`
+
+	// CumulativeGpa average of the grades up to the term weighted by their credits, null when none of them counts
+	CumulativeGpa *float64 `json:"cumulative_gpa"`
+
+	// Standings the names of the standing rules the student meets, in the order of the rules
+	Standings   []string           `json:"standings"`
+	StudentId   openapi_types.UUID `json:"student_id"`
+	Term        string             `json:"term"`
+	TermCredits int                `json:"term_credits"`
+
+	// TermGpa average of the grades of the term weighted by their credits, null when none of them counts
+	TermGpa *float64 `json:"term_gpa"`
+}
+
+// StandingRun defines model for StandingRun.
+type StandingRun struct {
+	// Evaluated number of students graded in the term
+	Evaluated int `json:"evaluated"`
+
+	// Flagged the standings of the students meeting a rule
+	Flagged []Standing `json:"flagged"`
+	Term    string     `json:"term"`
+}
+
+// TieMethod defines model for TieMethod.
+type TieMethod string
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	Active     bool               `json:"active"`
+	CreatedAt  time.Time          `json:"created_at"`
+	EventTypes []EventType        `json:"event_types"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// Secret the secret signing the deliveries, only returned on creation
+	Secret    *string   `json:"secret,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Url       string    `json:"url"`
+}
+
+// WebhookInput defines model for WebhookInput.
+type WebhookInput struct {
+	// Active whether the events are posted
+	Active *bool `json:"active,omitempty"`
+
+	// EventTypes the types of the events posted, all of them when empty
+	EventTypes *[]EventType `json:"event_types,omitempty"`
+
+	// Secret the secret signing the deliveries, generated when empty on creation and kept when empty on update
+	Secret *string `json:"secret,omitempty"`
+
+	// Url absolute http or https url the events are posted to
+	Url string `json:"url"`
+}
+
+// WebhookList defines model for WebhookList.
+type WebhookList struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+// CourseID defines model for CourseID.
+type CourseID = openapi_types.UUID
+
+// DeliveryID defines model for DeliveryID.
+type DeliveryID = openapi_types.UUID
+
+// ScaleTypes defines model for ScaleTypes.
+type ScaleTypes = []ScaleType
+
+// StudentID defines model for StudentID.
+type StudentID = openapi_types.UUID
+
+// Ties defines model for Ties.
+type Ties = TieMethod
+
+// WebhookID defines model for WebhookID.
+type WebhookID = openapi_types.UUID
+
 // LimitQuery defines model for limitQuery.
 type LimitQuery = int
 
 // OffsetQuery defines model for offsetQuery.
 type OffsetQuery = int
 
+// ClassRankResponse defines model for ClassRankResponse.
+type ClassRankResponse = ClassRank
+
+// ConversionResponse defines model for ConversionResponse.
+type ConversionResponse = Conversion
+
+// CourseStatsResponse defines model for CourseStatsResponse.
+type CourseStatsResponse = CourseStats
+
+// DeliveryListResponse defines model for DeliveryListResponse.
+type DeliveryListResponse = DeliveryList
+
+// DeliveryResponse defines model for DeliveryResponse.
+type DeliveryResponse = Delivery
+
+// EventResponse defines model for EventResponse.
+type EventResponse = Event
+
 // GPAResponse defines model for GPAResponse.
 type GPAResponse = GradeList
 
+// StandingResponse defines model for StandingResponse.
+type StandingResponse = Standing
+
+// StandingRunResponse defines model for StandingRunResponse.
+type StandingRunResponse = StandingRun
+
+// WebhookListResponse defines model for WebhookListResponse.
+type WebhookListResponse = WebhookList
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse = Webhook
+
+// GetCourseStatsParams defines parameters for GetCourseStats.
+type GetCourseStatsParams struct {
+	// ScaleType scale type of the histogram, the default one if empty
+	ScaleType *string `form:"scale_type,omitempty" json:"scale_type,omitempty"`
+}
+
+// GetCourseRankParams defines parameters for GetCourseRank.
+type GetCourseRankParams struct {
+	// Ties how students with the same average are ranked, competition (1, 2, 2, 4) or dense (1, 2, 2, 3)
+	Ties *Ties `form:"ties,omitempty" json:"ties,omitempty"`
+}
+
 // GetGPAParams defines parameters for GetGPA.
 type GetGPAParams struct {
-	// ScaleType scale type
-	ScaleType *GetGPAParamsScaleType `form:"scale_type,omitempty" json:"scale_type,omitempty"`
+	// ScaleType scale type, repeated for the letters under several scales, the first one giving gpa
+	ScaleType *ScaleTypes `form:"scale_type,omitempty" json:"scale_type,omitempty"`
+
+	// Limit the maximum number of items to return
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset the number of results to skip
+	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetClassRankParams defines parameters for GetClassRank.
+type GetClassRankParams struct {
+	// Ties how students with the same average are ranked, competition (1, 2, 2, 4) or dense (1, 2, 2, 3)
+	Ties *Ties `form:"ties,omitempty" json:"ties,omitempty"`
+}
+
+// GetStandingParams defines parameters for GetStanding.
+type GetStandingParams struct {
+	// Term the term, the latest one the student was graded in if empty
+	Term *string `form:"term,omitempty" json:"term,omitempty"`
+}
 
+// ListDeliveriesParams defines parameters for ListDeliveries.
+type ListDeliveriesParams struct {
 	// Limit the maximum number of items to return
 	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
 
@@ -106,8 +451,17 @@ type GetGPAParams struct {
 	Offset *OffsetQuery `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
-// GetGPAParamsScaleType defines parameters for GetGPA.
-type GetGPAParamsScaleType string
+// ConvertGradeJSONRequestBody defines body for ConvertGrade for application/json ContentType.
+type ConvertGradeJSONRequestBody = ConversionInput
+
+// PutGradeJSONRequestBody defines body for PutGrade for application/json ContentType.
+type PutGradeJSONRequestBody = GradeInput
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = WebhookInput
+
+// UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
+type UpdateWebhookJSONRequestBody = WebhookInput
 
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
@@ -182,6 +536,17 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ConvertGrade request with any body
+	ConvertGradeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ConvertGrade(ctx context.Context, body ConvertGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCourseStats request
+	GetCourseStats(ctx context.Context, courseId CourseID, params *GetCourseStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCourseRank request
+	GetCourseRank(ctx context.Context, courseId CourseID, studentId StudentID, params *GetCourseRankParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLiveness request
 	GetLiveness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -190,6 +555,93 @@ type ClientInterface interface {
 
 	// GetGPA request
 	GetGPA(ctx context.Context, params *GetGPAParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutGrade request with any body
+	PutGradeWithBody(ctx context.Context, studentId StudentID, courseId CourseID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutGrade(ctx context.Context, studentId StudentID, courseId CourseID, body PutGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClassRank request
+	GetClassRank(ctx context.Context, studentId StudentID, params *GetClassRankParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStanding request
+	GetStanding(ctx context.Context, studentId StudentID, params *GetStandingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunStanding request
+	RunStanding(ctx context.Context, term string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhooks request
+	ListWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhook request with any body
+	CreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhook request
+	DeleteWebhook(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhook request
+	GetWebhook(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhook request with any body
+	UpdateWebhookWithBody(ctx context.Context, webhookId WebhookID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhook(ctx context.Context, webhookId WebhookID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDeliveries request
+	ListDeliveries(ctx context.Context, webhookId WebhookID, params *ListDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Redeliver request
+	Redeliver(ctx context.Context, webhookId WebhookID, deliveryId DeliveryID, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) ConvertGradeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertGradeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ConvertGrade(ctx context.Context, body ConvertGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewConvertGradeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCourseStats(ctx context.Context, courseId CourseID, params *GetCourseStatsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCourseStatsRequest(c.Server, courseId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCourseRank(ctx context.Context, courseId CourseID, studentId StudentID, params *GetCourseRankParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCourseRankRequest(c.Server, courseId, studentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) GetLiveness(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -228,83 +680,244 @@ func (c *Client) GetGPA(ctx context.Context, params *GetGPAParams, reqEditors ..
 	return c.Client.Do(req)
 }
 
-// NewGetLivenessRequest generates requests for GetLiveness
-func NewGetLivenessRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PutGradeWithBody(ctx context.Context, studentId StudentID, courseId CourseID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutGradeRequestWithBody(c.Server, studentId, courseId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/live")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PutGrade(ctx context.Context, studentId StudentID, courseId CourseID, body PutGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutGradeRequest(c.Server, studentId, courseId, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetClassRank(ctx context.Context, studentId StudentID, params *GetClassRankParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClassRankRequest(c.Server, studentId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetReadinessRequest generates requests for GetReadiness
-func NewGetReadinessRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetStanding(ctx context.Context, studentId StudentID, params *GetStandingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStandingRequest(c.Server, studentId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/ready")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RunStanding(ctx context.Context, term string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunStandingRequest(c.Server, term)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ListWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhooksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetGPARequest generates requests for GetGPA
-func NewGetGPARequest(server string, params *GetGPAParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateWebhookWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/students/gpa")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateWebhook(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhook(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookRequest(c.Server, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookWithBody(ctx context.Context, webhookId WebhookID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequestWithBody(c.Server, webhookId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhook(ctx context.Context, webhookId WebhookID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequest(c.Server, webhookId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListDeliveries(ctx context.Context, webhookId WebhookID, params *ListDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDeliveriesRequest(c.Server, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Redeliver(ctx context.Context, webhookId WebhookID, deliveryId DeliveryID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRedeliverRequest(c.Server, webhookId, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewConvertGradeRequest calls the generic ConvertGrade builder with application/json body
+func NewConvertGradeRequest(server string, body ConvertGradeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewConvertGradeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewConvertGradeRequestWithBody generates requests for ConvertGrade with any type of body
+func NewConvertGradeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conversions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCourseStatsRequest generates requests for GetCourseStats
+func NewGetCourseStatsRequest(server string, courseId CourseID, params *GetCourseStatsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "course_id", runtime.ParamLocationPath, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/courses/%s/stats", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.ScaleType != nil {
 
-	queryValues := queryURL.Query()
-
-	if params.ScaleType != nil {
-
 		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scale_type", runtime.ParamLocationQuery, *params.ScaleType); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
@@ -319,25 +932,54 @@ func NewGetGPARequest(server string, params *GetGPAParams) (*http.Request, error
 
 	}
 
-	if params.Limit != nil {
+	queryURL.RawQuery = queryValues.Encode()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCourseRankRequest generates requests for GetCourseRank
+func NewGetCourseRankRequest(server string, courseId CourseID, studentId StudentID, params *GetCourseRankParams) (*http.Request, error) {
+	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "course_id", runtime.ParamLocationPath, courseId)
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Offset != nil {
+	var pathParam1 string
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "student_id", runtime.ParamLocationPath, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/courses/%s/students/%s/rank", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Ties != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ties", runtime.ParamLocationQuery, *params.Ties); err != nil {
 			return nil, err
 		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 			return nil, err
@@ -361,260 +1003,2522 @@ func NewGetGPARequest(server string, params *GetGPAParams) (*http.Request, error
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetLivenessRequest generates requests for GetLiveness
+func NewGetLivenessRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetLiveness request
-	GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error)
+	return req, nil
+}
 
-	// GetReadiness request
-	GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error)
+// NewGetReadinessRequest generates requests for GetReadiness
+func NewGetReadinessRequest(server string) (*http.Request, error) {
+	var err error
 
-	// GetGPA request
-	GetGPAWithResponse(ctx context.Context, params *GetGPAParams, reqEditors ...RequestEditorFn) (*GetGPAResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type GetLivenessResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetLivenessResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLivenessResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type GetReadinessResponse struct {
-	Body         []byte
+// NewGetGPARequest generates requests for GetGPA
+func NewGetGPARequest(server string, params *GetGPAParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/students/gpa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.ScaleType != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scale_type", runtime.ParamLocationQuery, *params.ScaleType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutGradeRequest calls the generic PutGrade builder with application/json body
+func NewPutGradeRequest(server string, studentId StudentID, courseId CourseID, body PutGradeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutGradeRequestWithBody(server, studentId, courseId, "application/json", bodyReader)
+}
+
+// NewPutGradeRequestWithBody generates requests for PutGrade with any type of body
+func NewPutGradeRequestWithBody(server string, studentId StudentID, courseId CourseID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "student_id", runtime.ParamLocationPath, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "course_id", runtime.ParamLocationPath, courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/students/%s/courses/%s/grade", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetClassRankRequest generates requests for GetClassRank
+func NewGetClassRankRequest(server string, studentId StudentID, params *GetClassRankParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "student_id", runtime.ParamLocationPath, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/students/%s/rank", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Ties != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ties", runtime.ParamLocationQuery, *params.Ties); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStandingRequest generates requests for GetStanding
+func NewGetStandingRequest(server string, studentId StudentID, params *GetStandingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "student_id", runtime.ParamLocationPath, studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/students/%s/standing", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Term != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "term", runtime.ParamLocationQuery, *params.Term); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRunStandingRequest generates requests for RunStanding
+func NewRunStandingRequest(server string, term string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "term", runtime.ParamLocationPath, term)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/terms/%s/standings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWebhooksRequest generates requests for ListWebhooks
+func NewListWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, webhookId WebhookID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookRequest generates requests for GetWebhook
+func NewGetWebhookRequest(server string, webhookId WebhookID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
+func NewUpdateWebhookRequest(server string, webhookId WebhookID, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookRequestWithBody(server, webhookId, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
+func NewUpdateWebhookRequestWithBody(server string, webhookId WebhookID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListDeliveriesRequest generates requests for ListDeliveries
+func NewListDeliveriesRequest(server string, webhookId WebhookID, params *ListDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRedeliverRequest generates requests for Redeliver
+func NewRedeliverRequest(server string, webhookId WebhookID, deliveryId DeliveryID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "delivery_id", runtime.ParamLocationPath, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries/%s/redeliver", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ConvertGrade request with any body
+	ConvertGradeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertGradeResponse, error)
+
+	ConvertGradeWithResponse(ctx context.Context, body ConvertGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertGradeResponse, error)
+
+	// GetCourseStats request
+	GetCourseStatsWithResponse(ctx context.Context, courseId CourseID, params *GetCourseStatsParams, reqEditors ...RequestEditorFn) (*GetCourseStatsResponse, error)
+
+	// GetCourseRank request
+	GetCourseRankWithResponse(ctx context.Context, courseId CourseID, studentId StudentID, params *GetCourseRankParams, reqEditors ...RequestEditorFn) (*GetCourseRankResponse, error)
+
+	// GetLiveness request
+	GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error)
+
+	// GetReadiness request
+	GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error)
+
+	// GetGPA request
+	GetGPAWithResponse(ctx context.Context, params *GetGPAParams, reqEditors ...RequestEditorFn) (*GetGPAResponse, error)
+
+	// PutGrade request with any body
+	PutGradeWithBodyWithResponse(ctx context.Context, studentId StudentID, courseId CourseID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutGradeResponse, error)
+
+	PutGradeWithResponse(ctx context.Context, studentId StudentID, courseId CourseID, body PutGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutGradeResponse, error)
+
+	// GetClassRank request
+	GetClassRankWithResponse(ctx context.Context, studentId StudentID, params *GetClassRankParams, reqEditors ...RequestEditorFn) (*GetClassRankResponse, error)
+
+	// GetStanding request
+	GetStandingWithResponse(ctx context.Context, studentId StudentID, params *GetStandingParams, reqEditors ...RequestEditorFn) (*GetStandingResponse, error)
+
+	// RunStanding request
+	RunStandingWithResponse(ctx context.Context, term string, reqEditors ...RequestEditorFn) (*RunStandingResponse, error)
+
+	// ListWebhooks request
+	ListWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error)
+
+	// CreateWebhook request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhook request
+	DeleteWebhookWithResponse(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// GetWebhook request
+	GetWebhookWithResponse(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error)
+
+	// UpdateWebhook request with any body
+	UpdateWebhookWithBodyWithResponse(ctx context.Context, webhookId WebhookID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	UpdateWebhookWithResponse(ctx context.Context, webhookId WebhookID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	// ListDeliveries request
+	ListDeliveriesWithResponse(ctx context.Context, webhookId WebhookID, params *ListDeliveriesParams, reqEditors ...RequestEditorFn) (*ListDeliveriesResponse, error)
+
+	// Redeliver request
+	RedeliverWithResponse(ctx context.Context, webhookId WebhookID, deliveryId DeliveryID, reqEditors ...RequestEditorFn) (*RedeliverResponse, error)
+}
+
+type ConvertGradeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Conversion
+	JSON400      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r ConvertGradeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ConvertGradeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCourseStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CourseStats
+	JSON400      *ResponseError
+	JSON404      *ResponseError
+	JSON422      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCourseStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCourseStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCourseRankResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassRank
+	JSON400      *ResponseError
+	JSON403      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCourseRankResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCourseRankResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLivenessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLivenessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLivenessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetReadinessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetReadinessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetReadinessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetGPAResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GradeList
+	JSON400      *ResponseError
+	JSON404      *ResponseError
+	JSON422      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetGPAResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetGPAResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutGradeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Event
+	JSON400      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r PutGradeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutGradeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClassRankResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClassRank
+	JSON400      *ResponseError
+	JSON403      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClassRankResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClassRankResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Standing
+	JSON400      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunStandingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StandingRun
+	JSON400      *ResponseError
+	JSON403      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r RunStandingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunStandingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookList
+	JSON403      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Webhook
+	JSON400      *ResponseError
+	JSON403      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON403      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON400      *ResponseError
+	JSON403      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeliveryList
+	JSON403      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RedeliverResponse struct {
+	Body         []byte
 	HTTPResponse *http.Response
+	JSON202      *Delivery
+	JSON403      *ResponseError
+	JSON404      *ResponseError
+	JSON500      *ResponseError
+}
+
+// Status returns HTTPResponse.Status
+func (r RedeliverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RedeliverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ConvertGradeWithBodyWithResponse request with arbitrary body returning *ConvertGradeResponse
+func (c *ClientWithResponses) ConvertGradeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ConvertGradeResponse, error) {
+	rsp, err := c.ConvertGradeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertGradeResponse(rsp)
+}
+
+func (c *ClientWithResponses) ConvertGradeWithResponse(ctx context.Context, body ConvertGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*ConvertGradeResponse, error) {
+	rsp, err := c.ConvertGrade(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConvertGradeResponse(rsp)
+}
+
+// GetCourseStatsWithResponse request returning *GetCourseStatsResponse
+func (c *ClientWithResponses) GetCourseStatsWithResponse(ctx context.Context, courseId CourseID, params *GetCourseStatsParams, reqEditors ...RequestEditorFn) (*GetCourseStatsResponse, error) {
+	rsp, err := c.GetCourseStats(ctx, courseId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCourseStatsResponse(rsp)
+}
+
+// GetCourseRankWithResponse request returning *GetCourseRankResponse
+func (c *ClientWithResponses) GetCourseRankWithResponse(ctx context.Context, courseId CourseID, studentId StudentID, params *GetCourseRankParams, reqEditors ...RequestEditorFn) (*GetCourseRankResponse, error) {
+	rsp, err := c.GetCourseRank(ctx, courseId, studentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCourseRankResponse(rsp)
+}
+
+// GetLivenessWithResponse request returning *GetLivenessResponse
+func (c *ClientWithResponses) GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error) {
+	rsp, err := c.GetLiveness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLivenessResponse(rsp)
+}
+
+// GetReadinessWithResponse request returning *GetReadinessResponse
+func (c *ClientWithResponses) GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error) {
+	rsp, err := c.GetReadiness(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReadinessResponse(rsp)
+}
+
+// GetGPAWithResponse request returning *GetGPAResponse
+func (c *ClientWithResponses) GetGPAWithResponse(ctx context.Context, params *GetGPAParams, reqEditors ...RequestEditorFn) (*GetGPAResponse, error) {
+	rsp, err := c.GetGPA(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetGPAResponse(rsp)
+}
+
+// PutGradeWithBodyWithResponse request with arbitrary body returning *PutGradeResponse
+func (c *ClientWithResponses) PutGradeWithBodyWithResponse(ctx context.Context, studentId StudentID, courseId CourseID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutGradeResponse, error) {
+	rsp, err := c.PutGradeWithBody(ctx, studentId, courseId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutGradeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutGradeWithResponse(ctx context.Context, studentId StudentID, courseId CourseID, body PutGradeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutGradeResponse, error) {
+	rsp, err := c.PutGrade(ctx, studentId, courseId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutGradeResponse(rsp)
+}
+
+// GetClassRankWithResponse request returning *GetClassRankResponse
+func (c *ClientWithResponses) GetClassRankWithResponse(ctx context.Context, studentId StudentID, params *GetClassRankParams, reqEditors ...RequestEditorFn) (*GetClassRankResponse, error) {
+	rsp, err := c.GetClassRank(ctx, studentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClassRankResponse(rsp)
+}
+
+// GetStandingWithResponse request returning *GetStandingResponse
+func (c *ClientWithResponses) GetStandingWithResponse(ctx context.Context, studentId StudentID, params *GetStandingParams, reqEditors ...RequestEditorFn) (*GetStandingResponse, error) {
+	rsp, err := c.GetStanding(ctx, studentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStandingResponse(rsp)
+}
+
+// RunStandingWithResponse request returning *RunStandingResponse
+func (c *ClientWithResponses) RunStandingWithResponse(ctx context.Context, term string, reqEditors ...RequestEditorFn) (*RunStandingResponse, error) {
+	rsp, err := c.RunStanding(ctx, term, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunStandingResponse(rsp)
+}
+
+// ListWebhooksWithResponse request returning *ListWebhooksResponse
+func (c *ClientWithResponses) ListWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListWebhooksResponse, error) {
+	rsp, err := c.ListWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// GetWebhookWithResponse request returning *GetWebhookResponse
+func (c *ClientWithResponses) GetWebhookWithResponse(ctx context.Context, webhookId WebhookID, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error) {
+	rsp, err := c.GetWebhook(ctx, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookResponse(rsp)
+}
+
+// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
+func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, webhookId WebhookID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhookWithBody(ctx, webhookId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, webhookId WebhookID, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhook(ctx, webhookId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookResponse(rsp)
+}
+
+// ListDeliveriesWithResponse request returning *ListDeliveriesResponse
+func (c *ClientWithResponses) ListDeliveriesWithResponse(ctx context.Context, webhookId WebhookID, params *ListDeliveriesParams, reqEditors ...RequestEditorFn) (*ListDeliveriesResponse, error) {
+	rsp, err := c.ListDeliveries(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDeliveriesResponse(rsp)
+}
+
+// RedeliverWithResponse request returning *RedeliverResponse
+func (c *ClientWithResponses) RedeliverWithResponse(ctx context.Context, webhookId WebhookID, deliveryId DeliveryID, reqEditors ...RequestEditorFn) (*RedeliverResponse, error) {
+	rsp, err := c.Redeliver(ctx, webhookId, deliveryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRedeliverResponse(rsp)
+}
+
+// ParseConvertGradeResponse parses an HTTP response from a ConvertGradeWithResponse call
+func ParseConvertGradeResponse(rsp *http.Response) (*ConvertGradeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ConvertGradeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Conversion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCourseStatsResponse parses an HTTP response from a GetCourseStatsWithResponse call
+func ParseGetCourseStatsResponse(rsp *http.Response) (*GetCourseStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCourseStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CourseStats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCourseRankResponse parses an HTTP response from a GetCourseRankWithResponse call
+func ParseGetCourseRankResponse(rsp *http.Response) (*GetCourseRankResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCourseRankResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassRank
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLivenessResponse parses an HTTP response from a GetLivenessWithResponse call
+func ParseGetLivenessResponse(rsp *http.Response) (*GetLivenessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLivenessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetReadinessResponse parses an HTTP response from a GetReadinessWithResponse call
+func ParseGetReadinessResponse(rsp *http.Response) (*GetReadinessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReadinessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetGPAResponse parses an HTTP response from a GetGPAWithResponse call
+func ParseGetGPAResponse(rsp *http.Response) (*GetGPAResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetGPAResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GradeList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutGradeResponse parses an HTTP response from a PutGradeWithResponse call
+func ParsePutGradeResponse(rsp *http.Response) (*PutGradeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutGradeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClassRankResponse parses an HTTP response from a GetClassRankWithResponse call
+func ParseGetClassRankResponse(rsp *http.Response) (*GetClassRankResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClassRankResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClassRank
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStandingResponse parses an HTTP response from a GetStandingWithResponse call
+func ParseGetStandingResponse(rsp *http.Response) (*GetStandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Standing
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRunStandingResponse parses an HTTP response from a RunStandingWithResponse call
+func ParseRunStandingResponse(rsp *http.Response) (*RunStandingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunStandingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StandingRun
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhooksResponse parses an HTTP response from a ListWebhooksWithResponse call
+func ParseListWebhooksResponse(rsp *http.Response) (*ListWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookResponse parses an HTTP response from a DeleteWebhookWithResponse call
+func ParseDeleteWebhookResponse(rsp *http.Response) (*DeleteWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookResponse parses an HTTP response from a GetWebhookWithResponse call
+func ParseGetWebhookResponse(rsp *http.Response) (*GetWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookResponse parses an HTTP response from a UpdateWebhookWithResponse call
+func ParseUpdateWebhookResponse(rsp *http.Response) (*UpdateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDeliveriesResponse parses an HTTP response from a ListDeliveriesWithResponse call
+func ParseListDeliveriesResponse(rsp *http.Response) (*ListDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeliveryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetReadinessResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRedeliverResponse parses an HTTP response from a RedeliverWithResponse call
+func ParseRedeliverResponse(rsp *http.Response) (*RedeliverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &RedeliverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Delivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ResponseError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetReadinessResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Convert a grade
+	// (POST /conversions)
+	ConvertGrade(w http.ResponseWriter, r *http.Request)
+	// Get course stats
+	// (GET /courses/{course_id}/stats)
+	GetCourseStats(w http.ResponseWriter, r *http.Request, courseId CourseID, params GetCourseStatsParams)
+	// Get course rank
+	// (GET /courses/{course_id}/students/{student_id}/rank)
+	GetCourseRank(w http.ResponseWriter, r *http.Request, courseId CourseID, studentId StudentID, params GetCourseRankParams)
+	// Get liveness status
+	// (GET /live)
+	GetLiveness(w http.ResponseWriter, r *http.Request)
+	// Get readiness status
+	// (GET /ready)
+	GetReadiness(w http.ResponseWriter, r *http.Request)
+	// Get GPA
+	// (GET /students/gpa)
+	GetGPA(w http.ResponseWriter, r *http.Request, params GetGPAParams)
+	// Post grade
+	// (PUT /students/{student_id}/courses/{course_id}/grade)
+	PutGrade(w http.ResponseWriter, r *http.Request, studentId StudentID, courseId CourseID)
+	// Get class rank
+	// (GET /students/{student_id}/rank)
+	GetClassRank(w http.ResponseWriter, r *http.Request, studentId StudentID, params GetClassRankParams)
+	// Get academic standing
+	// (GET /students/{student_id}/standing)
+	GetStanding(w http.ResponseWriter, r *http.Request, studentId StudentID, params GetStandingParams)
+	// Run the standing of a term
+	// (POST /terms/{term}/standings)
+	RunStanding(w http.ResponseWriter, r *http.Request, term string)
+	// List webhooks
+	// (GET /webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	// Create webhook
+	// (POST /webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	// Delete webhook
+	// (DELETE /webhooks/{webhook_id})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookID)
+	// Get webhook
+	// (GET /webhooks/{webhook_id})
+	GetWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookID)
+	// Update webhook
+	// (PUT /webhooks/{webhook_id})
+	UpdateWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookID)
+	// List deliveries
+	// (GET /webhooks/{webhook_id}/deliveries)
+	ListDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookID, params ListDeliveriesParams)
+	// Redeliver
+	// (POST /webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver)
+	Redeliver(w http.ResponseWriter, r *http.Request, webhookId WebhookID, deliveryId DeliveryID)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ConvertGrade operation middleware
+func (siw *ServerInterfaceWrapper) ConvertGrade(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConvertGrade(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetGPAResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GradeList
-	JSON400      *ResponseError
-	JSON404      *ResponseError
-	JSON500      *ResponseError
+// GetCourseStats operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, chi.URLParam(r, "course_id"), &courseId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "course_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCourseStatsParams
+
+	// ------------- Optional query parameter "scale_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scale_type", r.URL.Query(), &params.ScaleType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scale_type", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseStats(w, r, courseId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Status returns HTTPResponse.Status
-func (r GetGPAResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// GetCourseRank operation middleware
+func (siw *ServerInterfaceWrapper) GetCourseRank(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, chi.URLParam(r, "course_id"), &courseId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "course_id", Err: err})
+		return
 	}
-	return http.StatusText(0)
+
+	// ------------- Path parameter "student_id" -------------
+	var studentId StudentID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "student_id", runtime.ParamLocationPath, chi.URLParam(r, "student_id"), &studentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCourseRankParams
+
+	// ------------- Optional query parameter "ties" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ties", r.URL.Query(), &params.Ties)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ties", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCourseRank(w, r, courseId, studentId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetGPAResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetLiveness operation middleware
+func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLiveness(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetReadiness operation middleware
+func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReadiness(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetGPA operation middleware
+func (siw *ServerInterfaceWrapper) GetGPA(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGPAParams
+
+	// ------------- Optional query parameter "scale_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scale_type", r.URL.Query(), &params.ScaleType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scale_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetGPA(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutGrade operation middleware
+func (siw *ServerInterfaceWrapper) PutGrade(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "student_id" -------------
+	var studentId StudentID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "student_id", runtime.ParamLocationPath, chi.URLParam(r, "student_id"), &studentId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "course_id" -------------
+	var courseId CourseID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "course_id", runtime.ParamLocationPath, chi.URLParam(r, "course_id"), &courseId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "course_id", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutGrade(w, r, studentId, courseId)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	return 0
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetLivenessWithResponse request returning *GetLivenessResponse
-func (c *ClientWithResponses) GetLivenessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetLivenessResponse, error) {
-	rsp, err := c.GetLiveness(ctx, reqEditors...)
+// GetClassRank operation middleware
+func (siw *ServerInterfaceWrapper) GetClassRank(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "student_id" -------------
+	var studentId StudentID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "student_id", runtime.ParamLocationPath, chi.URLParam(r, "student_id"), &studentId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_id", Err: err})
+		return
 	}
-	return ParseGetLivenessResponse(rsp)
-}
 
-// GetReadinessWithResponse request returning *GetReadinessResponse
-func (c *ClientWithResponses) GetReadinessWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetReadinessResponse, error) {
-	rsp, err := c.GetReadiness(ctx, reqEditors...)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClassRankParams
+
+	// ------------- Optional query parameter "ties" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ties", r.URL.Query(), &params.Ties)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ties", Err: err})
+		return
 	}
-	return ParseGetReadinessResponse(rsp)
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClassRank(w, r, studentId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetGPAWithResponse request returning *GetGPAResponse
-func (c *ClientWithResponses) GetGPAWithResponse(ctx context.Context, params *GetGPAParams, reqEditors ...RequestEditorFn) (*GetGPAResponse, error) {
-	rsp, err := c.GetGPA(ctx, params, reqEditors...)
+// GetStanding operation middleware
+func (siw *ServerInterfaceWrapper) GetStanding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "student_id" -------------
+	var studentId StudentID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "student_id", runtime.ParamLocationPath, chi.URLParam(r, "student_id"), &studentId)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "student_id", Err: err})
+		return
 	}
-	return ParseGetGPAResponse(rsp)
-}
 
-// ParseGetLivenessResponse parses an HTTP response from a GetLivenessWithResponse call
-func ParseGetLivenessResponse(rsp *http.Response) (*GetLivenessResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStandingParams
+
+	// ------------- Optional query parameter "term" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "term", r.URL.Query(), &params.Term)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "term", Err: err})
+		return
 	}
 
-	response := &GetLivenessResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStanding(w, r, studentId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseGetReadinessResponse parses an HTTP response from a GetReadinessWithResponse call
-func ParseGetReadinessResponse(rsp *http.Response) (*GetReadinessResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// RunStanding operation middleware
+func (siw *ServerInterfaceWrapper) RunStanding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "term" -------------
+	var term string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "term", runtime.ParamLocationPath, chi.URLParam(r, "term"), &term)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "term", Err: err})
+		return
 	}
 
-	response := &GetReadinessResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunStanding(w, r, term)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ParseGetGPAResponse parses an HTTP response from a GetGPAWithResponse call
-func ParseGetGPAResponse(rsp *http.Response) (*GetGPAResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	response := &GetGPAResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GradeList
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ResponseError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ResponseError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	var err error
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
-		var dest ResponseError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON500 = &dest
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, chi.URLParam(r, "webhook_id"), &webhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
 	}
 
-	return response, nil
-}
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, webhookId)
+	})
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get liveness status
-	// (GET /live)
-	GetLiveness(w http.ResponseWriter, r *http.Request)
-	// Get readiness status
-	// (GET /ready)
-	GetReadiness(w http.ResponseWriter, r *http.Request)
-	// Get GPA
-	// (GET /students/gpa)
-	GetGPA(w http.ResponseWriter, r *http.Request, params GetGPAParams)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// GetLiveness operation middleware
-func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Request) {
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, chi.URLParam(r, "webhook_id"), &webhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLiveness(w, r)
+		siw.Handler.GetWebhook(w, r, webhookId)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -624,12 +3528,23 @@ func (siw *ServerInterfaceWrapper) GetLiveness(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetReadiness operation middleware
-func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.Request) {
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, chi.URLParam(r, "webhook_id"), &webhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
+	}
+
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetReadiness(w, r)
+		siw.Handler.UpdateWebhook(w, r, webhookId)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -639,23 +3554,24 @@ func (siw *ServerInterfaceWrapper) GetReadiness(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetGPA operation middleware
-func (siw *ServerInterfaceWrapper) GetGPA(w http.ResponseWriter, r *http.Request) {
+// ListDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListDeliveries(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetGPAParams
-
-	// ------------- Optional query parameter "scale_type" -------------
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
 
-	err = runtime.BindQueryParameter("form", true, false, "scale_type", r.URL.Query(), &params.ScaleType)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, chi.URLParam(r, "webhook_id"), &webhookId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scale_type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDeliveriesParams
+
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
@@ -673,7 +3589,42 @@ func (siw *ServerInterfaceWrapper) GetGPA(w http.ResponseWriter, r *http.Request
 	}
 
 	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetGPA(w, r, params)
+		siw.Handler.ListDeliveries(w, r, webhookId, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Redeliver operation middleware
+func (siw *ServerInterfaceWrapper) Redeliver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "webhook_id" -------------
+	var webhookId WebhookID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "webhook_id", runtime.ParamLocationPath, chi.URLParam(r, "webhook_id"), &webhookId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "delivery_id" -------------
+	var deliveryId DeliveryID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "delivery_id", runtime.ParamLocationPath, chi.URLParam(r, "delivery_id"), &deliveryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "delivery_id", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Redeliver(w, r, webhookId, deliveryId)
 	})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -796,6 +3747,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/conversions", wrapper.ConvertGrade)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{course_id}/stats", wrapper.GetCourseStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/courses/{course_id}/students/{student_id}/rank", wrapper.GetCourseRank)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/live", wrapper.GetLiveness)
 	})
@@ -805,6 +3765,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/students/gpa", wrapper.GetGPA)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/students/{student_id}/courses/{course_id}/grade", wrapper.PutGrade)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/students/{student_id}/rank", wrapper.GetClassRank)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/students/{student_id}/standing", wrapper.GetStanding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/terms/{term}/standings", wrapper.RunStanding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.ListWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.CreateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{webhook_id}", wrapper.DeleteWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhook_id}", wrapper.GetWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/webhooks/{webhook_id}", wrapper.UpdateWebhook)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhook_id}/deliveries", wrapper.ListDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver", wrapper.Redeliver)
+	})
 
 	return r
 }
@@ -812,22 +3805,92 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/6xWW2/bNhT+K8TZ3kZY8pygnd68oTAKdIOX9C0ICtY6ttlJFEtSQQND/304pGTd6MQI",
-	"+ibq3L7znQt5gl1V6kqhchayE2hhRIkOjT/d70SBn5810iFHuzNSO1kpyMCSiDmScZD053uN5hk4KFFi",
-	"p/ClVbC7I5aCvKCqS8gebvjNYsVv+TLl7zh8+OvzPTxy8NoZWGekOkDTcChkKd2/3vMMgjsiK8UPWdYl",
-	"U3X5FQ2r9kw6LC1zFTPoaqMuoPN+R8By3Iu6cJAtUw74Q5S6wHBoY9CBTlK1pzNeqRwe0HjA1X5v8SXE",
-	"PVKDti6cx2r/k/oC0uAwDnWIdAgtjUBrOBi0ulIWfW032/Vde6bjrlIOlaNPoXUhd4IwJ98sAT8Ngv9q",
-	"cA8Z/JL0jZMEqU02RuT4SVoX4o2T32zX7Byx4dB9fzCmMj8NwthrBIZQDEnGzBlL05EbiKEkfKt21BK0",
-	"2hBP8LdwR+Bw0AIyWP9Gn0Ed/lhSUtpUGo2TgeNg9kXm81YIIuaLPGv8NsDUxodiupLKMfGERhzI9gwT",
-	"/ox66tKJ+BparxbvItbW1TkqF02hlTGZQ2x0DX6vpcEcsocBESOX/AyD8u0XQPX1G+4cxe87alwQb2ch",
-	"e7i6No8ctDhIJQL6U7sC/IS3M+bnpnKi8KPezMrZBT2BXzJXTQMl0WYljBHPvklGOF5yse01p5S2YGKk",
-	"bUf+x0XrY7P9cAimubbsTM2ne1ajYXrSh8vI+uk5fs1jvw2Hq23ury3UbMXS7+l1MEaXRhf3kNvudjjv",
-	"3hAsxvVsi41pxO73GGXYQCVaG7ibT88kEP2Sal+RKyedH3fqL6kObL39CBye0NjgfLlIF6mnXKMSWkIG",
-	"q0W6WFGNhTt6XEkhn8IgxWqyQcdIQaG1zDrhaqKQ8vKd8zEPOp9aFZhcLb+n6dwnKROo23Q1F/5ThYBh",
-	"F9dlKej6vIDDiQNNfrdILDySVWJQ5M8vpkQa8pWc7jqdq5K68zFfyiqgmqcVwXIhr+6YtHfCxfTogqWZ",
-	"FuxAnLHWMJblZrv23dA/9h7ii6hXSfrHYMNfVR68267QHj6aaFHHiI+5OOslw9dMw+HmGpvJU4Gsbt5g",
-	"dfuGWLN2CAWJdQCponnqijQue1HtRMGCHDjUpoAMjs7pLEm87FhZl71P36ee19b/1Mt9e5Gf28QOnvEd",
-	"FCrjpOf8cyRm1N5NzWPzfwAAAP//6UuAC2AMAAA=",
+	"H4sIAAAAAAAC/+xce2/kOHL/KgQTIDmc3G+PPZ2/vDMbY4Ddg+PxYoMsBgZbYnfrRi3qSMrejtHfPagi",
+	"KVES1ZZfOzvIAYsdt0iRxaofi/WiHmgsdoXIea4VXT7Qgkm245pL/PVBlFLxTx/h74SrWKaFTkVOlzTG",
+	"FpImNKIpPCiY3tKI5mzHq+ZbbJb8H2UqeUKXWpY8oire8h2DIddC7pimS1qW2FPvC3hZaZnmG3o4RPQj",
+	"z9I7LvchEhLb1kuE6/ByMj7HLOM3+4KrLhkK2gi8ExHJC840T8haSKK3nGRcAy9JmSdcEsXvuGQZwVdU",
+	"hD3WqVSaiJyTTXqX5huyKRiNKP+9yETCHbG4un+UXO7r5eEot0isv5pU8x2S+a+Sr+mS/su4lvDYdFPj",
+	"akH0UK2XScn28FvpfQYPgC/w+7MuE57rkAyUaeoVgW1/uQRu0hDvt+Ke2CkUuU/1Flmq2I4TBqzecMIk",
+	"J5LlX3kSEWAE1ym8TP59GpEZ/rf4CxGSJDxX3Hs6/wsNs10DJT7xx/h8k/Kfud6KBBfxK19thfgaYuS9",
+	"aeplpG1/OSOzdJfq/8IldYgA5u3Y7+mu3JG83K24JGJNEFBECyK5LmXewxYct8GXhK9ZmWm6nE4Az2xX",
+	"ZNz8sHPAD/iV5vZXRW+aa77hEgkW67XixyiuKZVclZlGWtXXtOih1AwYJtWn1CdtEiDtAIJQhciVgeaH",
+	"jCl1zfKv1/YpPIxFrnmu4U9WFFkaM6B8/HcF5D8MRFE1spm1yQJsRIjDXv0g8jsuVSry16eiGjpIBraC",
+	"7ttIlnBDCxwCnzXT6g2IqcYOUwPNRJn2+hz5KVX61WnxBw8Rw0gBmkisiT2RUt4g6s0IChOTVK0R/fGO",
+	"56/PEBw1NDlsVw6NRPJYyATOO3gWb1m+QcxcXl28OjmXAMg+4VxeXZBqxkNE3d8/Sinkq5HQHDUklpxw",
+	"aCPSo+WzZjnw6NU54gYOUXIRs4Tv0pioqpNHSZm/GTHXZVCxuGYiob06RN9kJ3tj98HXHdSqXFVNyqPr",
+	"rWgK7+UQNXhq2rcb5xL8qE63B2otJLqcj2anEY3FVkh9q9L/5XS5mES04DLmuU6h9/uz0WlE8ZBZziLf",
+	"rFvS98k0nqzO+Mk8PlufLNjp4oS9W81Ozvl0PY3nyYxNJzQydtOSejYYcK2QouBSW+uuIql9ztsG0KEs",
+	"y1Bn4DGj4An8shTRqDaBElGuMl4bQcZOoIfWUh86J3vkuS9Bi8M0u6nNaBFhKwWaDUx/jn4JUpiESAtb",
+	"Z02Wtye2bWzTmtjYvSyvLN6yAAMIOoicP4s/0oKlRYBQxnRO88a6p5W3s003W660o4RGAdb60Hl4nCMO",
+	"GcON7No4/q3pfljDvSYOl9lEQ0MEXyp6xOrvPNZN+6q1m9ZS7OiS/vjh5jMwWYrdrTGElucT+9s4g3RJ",
+	"f6ARtY3ziDYe78xCllQzKxxBl3QxmnQ3i5nxocszf/IQuhvEBN6vXg0hv2HlGRc2E/cgdYu0FcsT97eZ",
+	"xCKUVEvqUHSEGMeQobapQ4Lh3EPAA/IBgjzErtVMDf41meVYUxF8HCGf8qJE5R+WW5e7dSjBMdDNVwHN",
+	"AewpQsMm0AlWehEp84wrRZgTUKog+sDzR4TTHdq+748Ny/NhYWZHP1IhOLzpTZs3e73OH0KLfCkYjrK8",
+	"XgTxudagCjZiNBhTx+Hxc7WYFlmwTciOFaoRQqrCG7HI1+mmlDwxO6pS8TaqlKU5Z5IUpVbeQpiuYyNt",
+	"TS7B+nbDCL3l0gzWFaYWDUXfka2KSK1AUaq9NJhu6LgSthP5pu5Yneqa5yzXRORdyvRWKF7PS7bsjteA",
+	"XgtJI8pz8N1/q3Sp4Uyfkq9h5juXTS0fizLXdHnaMBHoZD1fLZJZfHLK34EFdLY6OY/fJydTPlvP2WJ1",
+	"Gr9LaES3qdJiIxnQVA01iyhE/Jb0gh6i6unUPf0h+PRD8OlH/+nEPf1PeviCIRe6XMAOYjldzkbn8GeS",
+	"wo85xjlwnJovuPBiOqHL6WgR0WJ2iqQWZ6c4TPF+QpeLQ+QHIZdVGCWiSie3Cb+Dt6dnnZPLktgGfh3I",
+	"MSgIaqOGafao+eCx/KFjPK9gl3DZOLEqdNUgxwhtRNI8zsrKY+W7Qu+JyJHKQSHXnxCZH3DpgaArCih0",
+	"WhuJPQyx25xEh3VO8/CELRA0uWb354pn4p7cb9PY6CTU30RtmWweXYqsWZYB8zSXhcgwOL7i+p7zvJZy",
+	"Ex2IukFLQFQO63k2uOf7YbO3lH6BPg4QZCYzA4UOAH/H9J9Ilok1fgPgrjZZ11Ivygx9TePEM5mQhN+l",
+	"+IhGT16cn81pZB3MRrYYrfBXU2ZQZsDdBJa/NUNcqkJYLZ9Va9h5ii7PIxpLTLbc4lJmk9niZDI9mU9v",
+	"prPlZLKcTP6HRhSjTUZLn67O+TReLU5m6wk/Wawm8cn75JSdTPg0nrH368nqdFq9UTEbHYjRpmC3JkiF",
+	"8XkY7oydr97HE6PlTxZsDkp/keAZ4NrgzGFK33ITTKKnkzn5zOVdGnPyS87uWJq5owm6Kc10qW5jTPyc",
+	"TuYRzfnv+tYuurvOeb1O8yrqYAbTlkUS5o33jpdTWNJ362kyi+crcOD5yWL9bnJyDgcZLMOdYwGHvZJH",
+	"0I32BORvKab5iU53PATqWmAD1LsvqwEhSZfwGji6L7pOvma7N3YRA3/XcIGsWZrx/qEa4g1ufexA/DAT",
+	"y9U9R1OvPRnYgxFJwfaqegVPzA6GhorCYaobiTABuRL2sgsn8yQyaTSWEJHHnIgSXcIKIbU5Zt+nEa3e",
+	"xb9ZErTGfCgPJd2H9pCsmK/tsEsj31ZhsoG4ikER9RbZ5nZjFzQWc0ztYQSy4zl66YOhid6PXrC/bXIU",
+	"bJPm5kx4ZJSrumebWx5NjRFDq8NN2NLoT1HNIo5LKR/R+AXbZ4Il1s58qo1eh2UKye9SUapnBjyB3+i9",
+	"mHdq09hyBScauTOlo1hDoUeTOUmTCANuschVueMS050JT0oTYOakIZFHlVyDp0O3l8djliToS7LsyluA",
+	"yUsH4ke43ojco/e2TnmWKJJw0AjOy7N7qwMej5sh3WmavWwSuWeK7DC+kLsJ7a42KtayU9UazHj7ndU+",
+	"8XwJqRO7KJ/ZNRd7t8qNndgpToOZQijNK6yOarMETbOR1TA06jFe3FOX3rldZ2yz4T4Z9dIvXVSp6QNX",
+	"u2pKnZt58VfzJ6IXg1TLhzq0Cc5tnWX3G/6KDLPbjr6f0tZmm87mNORA9sXnTRPBXH8oTFawHqeGFCLN",
+	"q9i1jWyyFc9cfAPGqkLdLk6BIQm9ZTmUIXCZxs4nfCSS5TgV3j4DincuryxPw9E+U3Bk8hBejIupr7Y6",
+	"CcNJtTEfka98D76Z391fxgPGvnzRzTEWhlxTdDk/RF2x/4DC7YC7J1bph5RfgfHz0VnQqPHzD0eqmZ6l",
+	"BnC/BNVAw4fy0xIuxAvIDGkCHLInlBxLnqRa9cTqTWOdLQICfAYtjhe4PCanSVsmLszfQs78sWk0l7s+",
+	"pS539Qyo0U2kAVQ6bPEEa32E1CTeSpGLTGzSmGXZvrED0VKYvb5MDX96heaMOG8PGU65+J9U8NbPptDL",
+	"V6O1Njx8aVhVywdbaIV1VLaSCbkqNMvg6eTQ0ZZu0oEm46Wr33kTe9ES08u0m97AiCh1LHYmB+wAkeot",
+	"+BmsUgJ3LCt5RNRW3OeEKXIVkb9dReRTRH4lEOa7+AX/yYUmGLzgCQTBL68uVORUv4owKK2wY+wVEo38",
+	"FK+b0Kxn5Ls3TKHlxdIMK9CAPxnXHLyKVG8Tye5zGlFWJqkOHrp+pLC75d3jwHYtmNfQ491ssLzUjBIS",
+	"wlVDyC3Hr2oja79UpQ04C9H++K4pKSy4xMoo2ioP7K7MAf2xEevCP7+Krzue3S0dlMHjduVjk7pJsEbR",
+	"Z7ArhKzKDM1kIV53ao2abOwJPeBjsuNKNXLsnrw7E1UWQ2eOAck9IY1ppBqRXWthtGLmr2IwzUdnQafD",
+	"WhohUnHbO/pMx0ZBRkWmcmXWZnFdg+GpsdEjmeC6yNqz4WtH0OQSF6M5jegp/j2d4D9n+H80pkLqoSre",
+	"apnl5Q6Dvnf8tjIL3k0i/zkqiPloipELHAOOIvqRs/zfFMHj6sszi3vwCPcOWi53NRnTU/vEEnB22rXo",
+	"A9QHQ4qt1RwpFPJQV5fEoEVxz9PNVhtrV295Kp2tFJG8zDJyv+U5yevqmZ05K1QwdA5vYBDXOr2djILH",
+	"62D5Mtv5ZUxVsVtmU6fOKN1xDgTalK2QidFR8AM7+3moHjvHL/F/WhWOtdCCDccFVst9mKjsrz9cTkcr",
+	"h2D53lJa6+6gMgrB2QdCUFt4ZZDdowAUHLr1R87A6iaELTyzUHHUd+uArOPfF4s2xLYqyBQCESDKEHdD",
+	"05+fvWrSNhp74NUSiV1HzYp6BSF+1lVhfoF/o/6wttmaT/EuSFD3umLMVlYqBkk7YA1PS2lzo+i3YKTm",
+	"S0SHJ2aOJHy8WUuZ0SXdal2o5XhcMKlzLkd2IWNAjTtqm0keu7xKQCshMs7yl6V4tLtONQg+jfxNGz8D",
+	"tZjisQxZkYhubCMq3eQuxV/HUSMi8mxv779wjFTiug1aXiVhgaJ5GJKZgJ5NHkZOQk/LNbjbSOHQQi30",
+	"au+Egrr3W24tOhtQVXjjqopRdhHTkn5XFNjktI4d04wXYfGv0/Wo/bEOg0avAKIXoGPDcy6B1R5NPkjQ",
+	"k/zKC91qNwI6AofWgblSIis1J7CDwSyHfxUpZRZmf7t47bGN/1hiDIg6AqRwzsqF3Afv9KrevS2iFjXV",
+	"wF2SoGuarwMlfz9iQBSG4Qpj/yoWhYkiMZtCMI5Mmiud6hKlZ3IH1j4BUVpPItVEcpaYZ/cy1YAFE5Uy",
+	"Bgv575MbHPPk00ey5Swx3hTPFIcejMQZS3dV4SxnEnaS+MrzEbk2NCoYEDCHZg7ItqbYwBA3p6XdVVYa",
+	"zJo6A8szRUTOIUyhU41ouDRSJxdXn2hEq6JmOh1NTLWxKHjOitREetFLgSuJKL9xXFUx4m/AW5fZ9kpY",
+	"Fa0R0ivQk2JHmIs1C8Jy9AyjoWWOMNhq72od6/Iio5IBgPj3p6Sm49IGWq30fxDJ/g3uxhl1emiiFTRn",
+	"+7rgbDLpG7XqNw5c5ztEdDHk1daVo4iePuMt0IvlbsfkvitPGlHNjAfpgnrQf2xCZmr8UIW8D2Plaik3",
+	"IRV7yTWBq7zMhdvuuduHSQrKaGU2oo0reDuR1RVSFh+pbF26tiAbkQtXbgw+Q7foD7QBI4vZLIK4oQsa",
+	"QGRw1EHUJdd+jWjUuDb/W5jHdZdxda3+ELVZ0S0BrxYYNTY8qIN0XZ9/wy+It5X8l+cBs3u389nIXEwW",
+	"z3lrNvsWuwDA6keFvW1gHh/fB8aJGj/UDuZh7O7bBDfHNRZIV2EAODfc1faqajrg+bm9NCKfXaPi3O4Q",
+	"CI7DpJFNp6z2/jD9x1KEmw6eSgFKmGVQFw6HkTcSbFFoNsNEOC3L90d20bW5iPOCTfRI3/oLBgM646cG",
+	"nrkrOlfAX7An5n/YTnrNPWEvVYW3RGZdit5TADrkXClSlVZ1IPOT7ULDAmqOCZ3NCufdxr8JM2FgMV06",
+	"3ILcVrMrkpwl+6NLgh7pI2u6dn0GLeoa5zy2KkNVd1kBWnrW5X6Obeiud3lwf3qNlp2tAa8uGnZWCQmI",
+	"p+5y78srA7au93GLAb39L0s8b7/7t9X//5x+Ro6PAadxwoXOwqq6oCgD2LrGDwR4+SZM+1YVGvUBh1/d",
+	"yVjsHHNXt4f+Drmp68FSRYpylaVqyxMw8Rjx66nAoWgUVBl/untqXZWVJ/FEKD/lEKpPty9v47F4ZSWv",
+	"5aw0Pybx7fyUK+GupvW5KG9ghoWufP/JbS9nrLwtkv9pTj3PnKo/6/NEXau8/HAQyj/aDEog4xgRPtqM",
+	"SGIywlmqNKb/pVghdCLCNizNlW5l7FqK2WZqOoirckAvA1y4TswVIGhuv6fm7yqsHKt8o0f8Zkt+rUcf",
+	"ryR7Hr4733f5g22IVwEq63wvphevwFg1foB/apgeCSAeAar3mQ0nY9/3hSkg4tNX7FwXf/Ndqt0X+5rj",
+	"NfOcI3KR7NLcKl/IB3WV6nWZ90M8jNrw195sS/933t4ckWX+rXTui0F5XeZNwKCCsix1yISfDpZ+iiKo",
+	"MCGzQXo//hNVZYjmgDfJIjUEMDDwr27258gr9DGkb8d55NN9vR7H7DpVc4h6tvpnw9EVJ8wktISX0zJm",
+	"vKOBbAXcGnlSXs7mRdBO+g9I3KQKa0CrlC6ea0NE9gEzrJbvb5RNaGRmB1nn08FQ+W63tWG8g1cYXf5u",
+	"Hj/Ud/gO9vYc14Ha4o/4nIQ/poVGN1jVNa6GoMSMWaPkaeZO/Z3QgBZf9K0g+e7sXMv5oyKN+kNPYYnV",
+	"6jjVymqIISK75PpN5DV55tb8zvyVR4TYE+OBwI0x70qZRdYqMwUgmN6DIpRU780RHpa226D2LMAKC0yO",
+	"WXU/RPa/YCHGa4n/z3AgTP70B8I3wqoR9TOPkXHzBvZxY7Hu24Bvw0/Fb8sMtRQ/Nq9aPxOif74oevDj",
+	"vN8brlDsjavXzwTW+MH7bv1hLLn92e8mX9gvQtSf9jXmLJFQPk3YPdvbuhoonwNd6b5XEIGuNRfmzEcb",
+	"Es6SQZ5uRdWbItH7/H8AWrPh0PpuYeUzOgQo6MzlXTjIkImYZcS0N8p/l+Mxtm2F0svzyfkE2WvH77hm",
+	"Nh5SIUB1vu+vAnG5S5O1CbxkQ/HdV+xXu0PvuDxy96VfQz45aFxb5kjUXrnLYbVL2fmyfmjoGy53QWpM",
+	"9ODw5fB/AwB8RhboP2MAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
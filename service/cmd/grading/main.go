@@ -3,21 +3,63 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/mnabbasabadi/grading/service/config"
-	"github.com/mnabbasabadi/grading/service/internal/storage/migration/postgres"
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/internal/outbox"
+	"github.com/mnabbasabadi/grading/service/internal/storage/memory"
+	"github.com/mnabbasabadi/grading/service/internal/storage/migration"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/internal/webhook"
 	"github.com/mnabbasabadi/grading/service/pkg/app"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
 	"golang.org/x/exp/slog"
 
+	kitGRPC "github.com/mnabbasabadi/grading/service/foundation/grpc"
 	kitHTTP "github.com/mnabbasabadi/grading/service/foundation/http"
 )
 
+const usage = `usage:
+  grading [serve]                 serve the APIs
+  grading migrate COMMAND [ARGS]  run a migration command: %s
+  grading migrate create NAME     write a blank migration for every driver, from the service directory
+  grading seed [FLAGS]            load synthetic grades, see grading seed -h
+`
+
 func main() {
+	command, args := "serve", os.Args[1:]
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+	switch command {
+	case "serve":
+		serve()
+	case "migrate":
+		if err := migrate(args); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	case "seed":
+		if err := seedDB(args); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, usage, strings.Join(migration.Commands, ", "))
+		os.Exit(2)
+	}
+}
+
+// serve serves the APIs until a shutdown signal.
+func serve() {
 	ctx := context.Background()
 	// Load config
 	cfg, err := config.NewConfig()
@@ -38,30 +80,94 @@ func main() {
 	defer config.RecoverAndLogPanic(logger)
 
 	httpServer := setupHTTPServer(5*time.Second, 5*time.Second, 5*time.Second, *logger)
+	grpcServer := setupGRPCServer(5*time.Second, *logger,
+		kitGRPC.UnaryInterceptor(app.ConsistencyInterceptor(cfg.DB.ReadYourWrites)),
+		kitGRPC.UnaryInterceptor(app.CallerInterceptor(cfg.Caller.RoleClaim, cfg.Caller.StudentClaim)),
+		kitGRPC.UnaryInterceptor(app.TenantInterceptor(cfg.Tenancy.Claim, cfg.Tenancy.Required)),
+	)
 
-	dbConn, dbCloser, err := cfg.GetConnectionPQDB(logger)
+	standingRules, err := domain.ParseStandingRules(cfg.Standing.Rules)
 	if err != nil {
-		logger.Error("error setting up database connection", "err", err)
+		logger.Error("error parsing the standing rules", "err", err)
 		os.Exit(1)
 	}
-	defer dbCloser()
-
-	// migrate database
-	if err := postgres.GooseUP(dbConn.DB); err != nil {
-		logger.Error("error running migrations", "err", err)
+	conversionTables, err := domain.ParseConversionTables(cfg.Conversions.Tables)
+	if err != nil {
+		logger.Error("error parsing the conversion tables", "err", err)
 		os.Exit(1)
 	}
 
 	params := app.Params{
-		Logger:       logger,
-		DB:           dbConn,
-		HTTPRegister: httpServer.Register,
+		Logger:             logger,
+		DBDriver:           cfg.DB.Driver,
+		ReadYourWrites:     cfg.DB.ReadYourWrites,
+		RankViewerRoles:    cfg.Rank.ViewerRoles,
+		AdminRoles:         cfg.Caller.AdminRoles,
+		CallerRoleClaim:    cfg.Caller.RoleClaim,
+		CallerStudentClaim: cfg.Caller.StudentClaim,
+		StandingRules:      standingRules,
+		ConversionTables:   conversionTables,
+		TenantClaim:        cfg.Tenancy.Claim,
+		TenantRequired:     cfg.Tenancy.Required,
+		HTTPRegister:       httpServer.Register,
+		GRPCRegister:       grpcServer.Register,
+	}
+
+	if cfg.DB.Driver == db.Memory {
+		params.Repository, err = newMemoryStore(cfg.DB.DBName)
+		if err != nil {
+			logger.Error("error loading fixtures", "err", err)
+			os.Exit(1)
+		}
+		logger.Warn("serving from memory, data is lost on shutdown")
+	} else {
+		// a signal while the database is still unreachable stops the startup
+		startupCtx, stopStartup := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+		dbConn, dbCloser, err := cfg.GetConnectionDB(startupCtx, logger)
+		stopStartup()
+		if err != nil {
+			logger.Error("error setting up database connection", "err", err)
+			os.Exit(1)
+		}
+		defer dbCloser()
+
+		// migrate database, unless a separate job does
+		if cfg.DB.AutoMigrate {
+			if err := migration.Up(ctx, cfg.DB.Driver, dbConn.DB); err != nil {
+				logger.Error("error running migrations", "err", err)
+				os.Exit(1)
+			}
+		}
+		params.DB = dbConn
+
+		replicas, err := cfg.GetReplicaCluster(logger, dbConn)
+		if err != nil {
+			logger.Error("error setting up read replicas", "err", err)
+			os.Exit(1)
+		}
+		if replicas != nil {
+			replicas.Start(ctx)
+			defer func() {
+				if err := replicas.Stop(); err != nil {
+					logger.Error("error closing read replicas", "err", err)
+				}
+			}()
+			params.Replicas = replicas
+		}
 	}
 
 	env := app.NewEnvironment(ctx, params)
 
+	stopRelay, err := startRelay(ctx, cfg.Outbox, env.Repository(), logger)
+	if err != nil {
+		logger.Error("error setting up the outbox relay", "err", err)
+		os.Exit(1)
+	}
+	stopDeliverer := startDeliverer(ctx, cfg.Webhooks, env.Repository(), logger)
+
 	logger.Info("setup complete, starting server")
 	startHTTPServer(httpServer, cfg.Host, cfg.Port, serverErrors)
+	startGRPCServer(grpcServer, cfg.Host, cfg.GRPCPort, serverErrors)
 	shutdown := listenForShutdown()
 	select {
 	case err := <-serverErrors:
@@ -71,7 +177,62 @@ func main() {
 		logger.Error("caught signal", "signal", sig)
 		env.Shutdown()
 		httpServer.Stop()
+		grpcServer.Stop()
+		stopRelay()
+		stopDeliverer()
+	}
+}
+
+// startRelay relays the events of the outbox to the webhook deliveries and the configured sinks until the
+// returned function is called.
+func startRelay(ctx context.Context, cfg config.Outbox, repo rdbms.Repository, logger *slog.Logger) (func(), error) {
+	sinks := outbox.MultiSink{webhook.NewDispatcher(repo)}
+	closeSinks := func() error { return nil }
+	if len(cfg.Sinks) > 0 {
+		sink, closeFiles, err := outbox.ParseSinks(cfg.Sinks)
+		if err != nil {
+			return nil, err
+		}
+		sinks, closeSinks = append(sinks, sink), closeFiles
+	}
+	relay := outbox.NewRelay(repo, sinks, logger, outbox.Interval(cfg.Interval), outbox.BatchSize(cfg.BatchSize),
+		outbox.Lease(cfg.Lease))
+	relay.Start(ctx)
+	return func() {
+		relay.Stop()
+		if err := closeSinks(); err != nil {
+			logger.Error("error closing outbox sinks", "err", err)
+		}
+	}, nil
+}
+
+// startDeliverer posts the webhook deliveries until the returned function is called.
+func startDeliverer(ctx context.Context, cfg config.Webhooks, repo rdbms.Repository, logger *slog.Logger) func() {
+	deliverer := webhook.NewDeliverer(repo, logger,
+		webhook.Interval(cfg.Interval),
+		webhook.MaxAttempts(cfg.MaxAttempts),
+		webhook.Backoff(cfg.InitialBackoff, cfg.MaxBackoff),
+		webhook.Client(&http.Client{Timeout: cfg.Timeout}),
+	)
+	deliverer.Start(ctx)
+	return deliverer.Stop
+}
+
+// newMemoryStore returns an in-memory store holding the fixtures of the given file, or the demo ones.
+func newMemoryStore(fixturesPath string) (*memory.Store, error) {
+	var (
+		fixtures memory.Fixtures
+		err      error
+	)
+	if fixturesPath == "" {
+		fixtures, err = memory.DemoFixtures()
+	} else {
+		fixtures, err = memory.LoadFixturesFile(fixturesPath)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return memory.New(memory.WithFixtures(fixtures)), nil
 }
 
 // ListenForShutdown creates a channel and subscribes to specific signals to trigger a shutdown of the service.
@@ -94,3 +255,16 @@ func startHTTPServer(httpServer kitHTTP.Server, host, port string, serverErrors
 	addr := net.JoinHostPort(host, port)
 	httpServer.Start(addr, serverErrors)
 }
+
+func setupGRPCServer(shutdownTimeout time.Duration, logger slog.Logger, options ...kitGRPC.ServerOption) kitGRPC.Server {
+	serverOptions := []kitGRPC.ServerOption{
+		kitGRPC.ShutdownTimeout(shutdownTimeout),
+	}
+	serverOptions = append(serverOptions, options...)
+	return kitGRPC.NewServer(logger, serverOptions...)
+}
+
+func startGRPCServer(grpcServer kitGRPC.Server, host, port string, serverErrors chan<- error) {
+	addr := net.JoinHostPort(host, port)
+	grpcServer.Start(addr, serverErrors)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/mnabbasabadi/grading/service/config"
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/internal/storage/migration"
+	"golang.org/x/exp/slog"
+)
+
+// migrationsDir is where create writes the migrations, relative to the service directory.
+const migrationsDir = "internal/storage/migration"
+
+// migrate runs a migration command against the configured database, for running migrations as a job
+// separate from serving.
+func migrate(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing migration command, one of create, %s", strings.Join(migration.Commands, ", "))
+	}
+	command, args := args[0], args[1:]
+	if command == "create" {
+		if len(args) != 1 {
+			return errors.New("usage: grading migrate create NAME")
+		}
+		return migration.Create(migrationsDir, args[0])
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.DB.Driver == db.Memory {
+		return fmt.Errorf("the %s driver has no migrations", db.Memory)
+	}
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	conn, closer, err := cfg.GetConnectionDB(ctx, logger)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	return migration.Run(ctx, cfg.DB.Driver, conn.DB, command, args...)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/mnabbasabadi/grading/service/config"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/internal/storage/migration"
+	"github.com/mnabbasabadi/grading/service/internal/storage/seed"
+	"golang.org/x/exp/slog"
+)
+
+// seedDB loads synthetic grades into the configured database.
+func seedDB(args []string) error {
+	options := seed.DefaultOptions
+	flags := flag.NewFlagSet("seed", flag.ContinueOnError)
+	flags.IntVar(&options.Students, "students", options.Students, "number of students")
+	flags.IntVar(&options.Courses, "courses", options.Courses, "number of courses")
+	flags.IntVar(&options.Terms, "terms", options.Terms, "number of terms, of six months")
+	flags.IntVar(&options.CoursesPerTerm, "courses-per-term", options.CoursesPerTerm, "courses a student takes per term")
+	flags.IntVar(&options.MaxGrade, "max-grade", options.MaxGrade, "highest grade, the lowest being 0")
+	flags.Int64Var(&options.Seed, "seed", options.Seed, "random seed, the same seed generating the same grades")
+	reset := flags.Bool("reset", false, "delete the existing grades of the tenant first")
+	tenant := flags.String("tenant", auth.DefaultTenant, "tenant the grades belong to")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	if !auth.ValidTenant(*tenant) {
+		return fmt.Errorf("invalid tenant %q", *tenant)
+	}
+	grades, err := seed.Generate(options)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.DB.Driver == db.Memory {
+		return fmt.Errorf("the %s driver loads its grades from a fixtures file, there is no database to seed", db.Memory)
+	}
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	conn, closer, err := cfg.GetConnectionDB(ctx, logger)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	if cfg.DB.AutoMigrate {
+		if err := migration.Up(ctx, cfg.DB.Driver, conn.DB); err != nil {
+			return err
+		}
+	}
+	if err := seed.Insert(auth.WithTenant(ctx, *tenant), conn, grades, *reset); err != nil {
+		return err
+	}
+	logger.Info("seeded grades", "tenant", *tenant, "grades", len(grades), "students", options.Students,
+		"courses", options.Courses, "terms", options.Terms)
+	return nil
+}
@@ -3,8 +3,10 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
 	"golang.org/x/exp/slog"
 
 	"github.com/spf13/viper"
@@ -14,21 +16,127 @@ import (
 type (
 	// DB ...
 	DB struct {
+		Driver   db.Driver
 		User     string
 		Password string
 		Host     string
 		Port     string
 		DBName   string
 		SslMode  db.SSLMode
+		// URL is a postgres:// connection URL taking precedence over the fields above,
+		// also read from the DATABASE_URL environment variable.
+		URL string
+		// SSLRootCert is the CA certificate verifying the server with the verify-ca and verify-full modes.
+		SSLRootCert string
+		// SSLCert and SSLKey authenticate the client with a certificate.
+		SSLCert string
+		SSLKey  string
+		// ApplicationName is the name the connections report to the server.
+		ApplicationName string
+		// StatementTimeout aborts the statements running longer, zero means no timeout.
+		StatementTimeout time.Duration
+		// pool
+		MaxOpenConns    int
+		MaxIdleConns    int
+		ConnMaxLifetime time.Duration
+		ConnMaxIdleTime time.Duration
+		// ConnectAttempts bounds the connection attempts at startup, zero meaning until ConnectTimeout.
+		ConnectAttempts int
+		// ConnectBackoff is the first wait between connection attempts, doubling up to ConnectMaxBackoff.
+		ConnectBackoff    time.Duration
+		ConnectMaxBackoff time.Duration
+		// ConnectTimeout bounds the time spent connecting at startup, zero meaning until ConnectAttempts.
+		ConnectTimeout time.Duration
+		// ReplicaDSNs are the connection strings of the read replicas of a postgres primary.
+		ReplicaDSNs []string
+		// MaxReplicaLag is the lag beyond which reads fall back to the primary.
+		MaxReplicaLag time.Duration
+		// ReplicaCheckInterval is how often the health and the lag of the replicas are checked.
+		ReplicaCheckInterval time.Duration
+		// ReadYourWrites makes the reads following a write of a request go to the primary,
+		// for requests not choosing with the X-Read-Your-Writes header.
+		ReadYourWrites bool
+		// AutoMigrate applies the pending migrations when serving, instead of a separate migrate job.
+		AutoMigrate bool
+	}
+
+	// Outbox ...
+	Outbox struct {
+		// Sinks the events are relayed to besides the webhooks: "stdout", "file:PATH" or an http(s) URL.
+		Sinks []string
+		// Interval is how often the outbox is polled when it was found empty.
+		Interval time.Duration
+		// BatchSize is the maximum number of events delivered at once.
+		BatchSize int
+		// Lease is how long a batch is left alone by the other relays while it is delivered.
+		Lease time.Duration
+	}
+
+	// Webhooks ...
+	Webhooks struct {
+		// MaxAttempts is the number of attempts of a delivery before it is dead.
+		MaxAttempts int
+		// InitialBackoff is the wait after the first failed attempt, doubling on each failure up to MaxBackoff.
+		InitialBackoff time.Duration
+		MaxBackoff     time.Duration
+		// Timeout bounds an attempt.
+		Timeout time.Duration
+		// Interval is how often the due deliveries are looked for.
+		Interval time.Duration
+	}
+
+	// Rank ...
+	Rank struct {
+		// ViewerRoles are the roles allowed to see the rank of any student, students only see their own.
+		ViewerRoles []string
+	}
+
+	// Caller ...
+	Caller struct {
+		// RoleClaim is the claim of the bearer token naming the role of the caller of a request.
+		RoleClaim string
+		// StudentClaim is the claim of the bearer token naming the student the caller is, if a student.
+		StudentClaim string
+		// AdminRoles are the roles allowed to manage the webhooks and to run the standing of a term.
+		AdminRoles []string
+	}
+
+	// Standing ...
+	Standing struct {
+		// Rules are the standing rules, see domain.ParseStandingRules.
+		Rules string
+	}
+
+	// Conversions ...
+	Conversions struct {
+		// Tables are the tables converting the letters of a scale to another, see domain.ParseConversionTables.
+		Tables string
+	}
+
+	// Tenancy ...
+	Tenancy struct {
+		// Claim is the claim of the bearer token naming the tenant of a request, which the X-Tenant-ID header
+		// must match.
+		Claim string
+		// Required rejects the requests naming no tenant, instead of serving them the default one.
+		Required bool
 	}
 
 	// Config is a struct that holds the configuration values
 	Config struct {
 		Host        string
 		Port        string
+		GRPCPort    string
 		LogLevel    string
 		ServiceName string
 		DB          DB
+		Outbox      Outbox
+		Webhooks    Webhooks
+		Rank        Rank
+		Caller      Caller
+		Standing    Standing
+		Conversions Conversions
+		Tenancy     Tenancy
 	}
 )
 
@@ -50,13 +158,55 @@ func initConfig() error {
 		}
 	}
 
+	viper.SetDefault("GRPCPort", "9090")
+	viper.SetDefault("DB.Driver", string(db.Postgres))
+	viper.SetDefault("DB.MaxReplicaLag", "10s")
+	viper.SetDefault("DB.ReplicaCheckInterval", "5s")
+	viper.SetDefault("DB.ApplicationName", "grading")
+	viper.SetDefault("DB.MaxOpenConns", 20)
+	viper.SetDefault("DB.MaxIdleConns", 10)
+	viper.SetDefault("DB.ConnMaxLifetime", "30m")
+	viper.SetDefault("DB.ConnMaxIdleTime", "5m")
+	viper.SetDefault("DB.AutoMigrate", true)
+	viper.SetDefault("DB.ConnectAttempts", db.DefaultRetryPolicy.MaxAttempts)
+	viper.SetDefault("DB.ConnectBackoff", db.DefaultRetryPolicy.InitialInterval.String())
+	viper.SetDefault("DB.ConnectMaxBackoff", db.DefaultRetryPolicy.MaxInterval.String())
+	viper.SetDefault("DB.ConnectTimeout", db.DefaultRetryPolicy.MaxElapsedTime.String())
+	viper.SetDefault("Outbox.Interval", "1s")
+	viper.SetDefault("Outbox.BatchSize", 100)
+	viper.SetDefault("Outbox.Lease", "1m")
+	viper.SetDefault("Webhooks.MaxAttempts", 8)
+	viper.SetDefault("Webhooks.InitialBackoff", "30s")
+	viper.SetDefault("Webhooks.MaxBackoff", "1h")
+	viper.SetDefault("Webhooks.Timeout", "10s")
+	viper.SetDefault("Webhooks.Interval", "1s")
+	viper.SetDefault("Rank.ViewerRoles", []string{"registrar", "committee"})
+	viper.SetDefault("Caller.RoleClaim", "role")
+	viper.SetDefault("Caller.StudentClaim", "student_id")
+	viper.SetDefault("Caller.AdminRoles", []string{"registrar"})
+	viper.SetDefault("Standing.Rules", domain.DefaultStandingRules)
+	viper.SetDefault("Tenancy.Claim", "tenant_id")
+
 	keys := []string{
-		"Host", "Port", "LogLevel", "ServiceName",
-		"DB.User", "DB.Password", "DB.Host", "DB.Port", "DB.DBName", "DB.Sslmode",
+		"Host", "Port", "GRPCPort", "LogLevel", "ServiceName",
+		"DB.Driver", "DB.User", "DB.Password", "DB.Host", "DB.Port", "DB.DBName", "DB.Sslmode",
+		"DB.SSLRootCert", "DB.SSLCert", "DB.SSLKey", "DB.ApplicationName", "DB.StatementTimeout",
+		"DB.MaxOpenConns", "DB.MaxIdleConns", "DB.ConnMaxLifetime", "DB.ConnMaxIdleTime",
+		"DB.ConnectAttempts", "DB.ConnectBackoff", "DB.ConnectMaxBackoff", "DB.ConnectTimeout",
+		"DB.ReplicaDSNs", "DB.MaxReplicaLag", "DB.ReplicaCheckInterval", "DB.ReadYourWrites",
+		"DB.AutoMigrate",
+		"Outbox.Sinks", "Outbox.Interval", "Outbox.BatchSize", "Outbox.Lease",
+		"Webhooks.MaxAttempts", "Webhooks.InitialBackoff", "Webhooks.MaxBackoff", "Webhooks.Timeout",
+		"Webhooks.Interval", "Rank.ViewerRoles", "Caller.RoleClaim", "Caller.StudentClaim", "Caller.AdminRoles",
+		"Standing.Rules", "Conversions.Tables", "Tenancy.Claim", "Tenancy.Required",
 	}
 	if err := bindEnv(keys...); err != nil {
 		return fmt.Errorf("failed to bind environment variables: %v", err)
 	}
+	// the conventional DATABASE_URL is honoured next to DB.URL
+	if err := viper.BindEnv("DB.URL", "DB.URL", "DATABASE_URL"); err != nil {
+		return fmt.Errorf("failed to bind environment variables: %v", err)
+	}
 
 	return nil
 }
@@ -4,7 +4,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
@@ -26,11 +29,15 @@ LogLevel: debug
 ServiceName: test-service
 IsDryRun: true
 DB:
+  Driver: mysql
   User: testuser
   Password: testpassword
   Host: localhost
   Port: 5432
-  DBName: testdb`)
+  DBName: testdb
+  ReplicaDSNs:
+    - host=replica1 dbname=testdb
+    - host=replica2 dbname=testdb`)
 	require.NoError(t, err)
 	// Set up test environment variables
 	_ = os.Setenv("CONFIG_FILE", filePath)
@@ -49,6 +56,15 @@ DB:
 	require.Equal(t, "test-service", config.ServiceName)
 	require.Equal(t, "testuser", config.DB.User)
 	require.Equal(t, "testpassword", config.DB.Password)
+	require.Equal(t, db.MySQL, config.DB.Driver)
+	require.Equal(t, []string{"host=replica1 dbname=testdb", "host=replica2 dbname=testdb"}, config.DB.ReplicaDSNs)
+	require.Equal(t, 10*time.Second, config.DB.MaxReplicaLag)
+	require.Equal(t, 20, config.DB.MaxOpenConns)
+	require.Equal(t, 30*time.Minute, config.DB.ConnMaxLifetime)
+	require.Equal(t, "grading", config.DB.ApplicationName)
+	require.Equal(t, 10, config.DB.ConnectAttempts)
+	require.Equal(t, time.Minute, config.DB.ConnectTimeout)
+	require.True(t, config.DB.AutoMigrate)
 }
 
 func TestBindEnv(t *testing.T) {
@@ -64,3 +80,107 @@ func TestBindEnv(t *testing.T) {
 	require.Equal(t, "testuser", c.DB.User)
 
 }
+
+func TestDatabaseURL(t *testing.T) {
+	defer os.Clearenv()
+	_ = os.Setenv("DATABASE_URL", "postgres://postgres@db/grading")
+	_ = os.Setenv("DB.MAXOPENCONNS", "50")
+	_ = os.Setenv("DB.STATEMENTTIMEOUT", "30s")
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, "postgres://postgres@db/grading", c.DB.URL)
+	require.Equal(t, 50, c.DB.MaxOpenConns)
+	require.Equal(t, 30*time.Second, c.DB.StatementTimeout)
+}
+
+func TestOutbox(t *testing.T) {
+	defer os.Clearenv()
+	_ = os.Setenv("OUTBOX.SINKS", "stdout,https://example.com/events")
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, []string{"stdout", "https://example.com/events"}, c.Outbox.Sinks)
+	require.Equal(t, time.Second, c.Outbox.Interval)
+	require.Equal(t, 100, c.Outbox.BatchSize)
+	require.Equal(t, time.Minute, c.Outbox.Lease)
+}
+
+func TestWebhooks(t *testing.T) {
+	defer os.Clearenv()
+	_ = os.Setenv("WEBHOOKS.MAXATTEMPTS", "3")
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, 3, c.Webhooks.MaxAttempts)
+	require.Equal(t, 30*time.Second, c.Webhooks.InitialBackoff)
+	require.Equal(t, time.Hour, c.Webhooks.MaxBackoff)
+	require.Equal(t, 10*time.Second, c.Webhooks.Timeout)
+	require.Equal(t, time.Second, c.Webhooks.Interval)
+}
+
+func TestRank(t *testing.T) {
+	defer os.Clearenv()
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, []string{"registrar", "committee"}, c.Rank.ViewerRoles)
+
+	_ = os.Setenv("RANK.VIEWERROLES", "dean,registrar")
+	c, err = NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, []string{"dean", "registrar"}, c.Rank.ViewerRoles)
+}
+
+func TestCaller(t *testing.T) {
+	defer os.Clearenv()
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, "role", c.Caller.RoleClaim)
+	require.Equal(t, "student_id", c.Caller.StudentClaim)
+	require.Equal(t, []string{"registrar"}, c.Caller.AdminRoles)
+
+	_ = os.Setenv("CALLER.ROLECLAIM", "scope")
+	_ = os.Setenv("CALLER.STUDENTCLAIM", "sub")
+	_ = os.Setenv("CALLER.ADMINROLES", "registrar,dean")
+	c, err = NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, "scope", c.Caller.RoleClaim)
+	require.Equal(t, "sub", c.Caller.StudentClaim)
+	require.Equal(t, []string{"registrar", "dean"}, c.Caller.AdminRoles)
+}
+
+func TestStanding(t *testing.T) {
+	defer os.Clearenv()
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, domain.DefaultStandingRules, c.Standing.Rules)
+
+	_ = os.Setenv("STANDING.RULES", "Honors: term_gpa >= 3.8")
+	c, err = NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, "Honors: term_gpa >= 3.8", c.Standing.Rules)
+}
+
+func TestConversions(t *testing.T) {
+	defer os.Clearenv()
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Empty(t, c.Conversions.Tables)
+
+	_ = os.Setenv("CONVERSIONS.TABLES", "ECTS->4.0: A=A, B=B")
+	c, err = NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, "ECTS->4.0: A=A, B=B", c.Conversions.Tables)
+}
+
+func TestTenancy(t *testing.T) {
+	defer os.Clearenv()
+	c, err := NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, "tenant_id", c.Tenancy.Claim)
+	require.False(t, c.Tenancy.Required)
+
+	_ = os.Setenv("TENANCY.CLAIM", "org")
+	_ = os.Setenv("TENANCY.REQUIRED", "true")
+	c, err = NewConfig()
+	require.NoError(t, err)
+	require.Equal(t, "org", c.Tenancy.Claim)
+	require.True(t, c.Tenancy.Required)
+}
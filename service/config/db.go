@@ -1,13 +1,17 @@
 package config
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/jmoiron/sqlx"
 	"github.com/mnabbasabadi/grading/service/foundation/db"
 	"golang.org/x/exp/slog"
 )
 
-// GetConnectionPQDB ...
-func (c Config) GetConnectionPQDB(logger *slog.Logger) (*sqlx.DB, func(), error) {
+// GetConnectionDB connects to the database of the configured driver, retrying while it is unreachable
+// until the configured attempts or timeout are exhausted, or ctx is done.
+func (c Config) GetConnectionDB(ctx context.Context, logger *slog.Logger) (*sqlx.DB, func(), error) {
 	opts := []db.Option{
 		db.WithUser(c.DB.User),
 		db.WithPassword(c.DB.Password),
@@ -15,14 +19,66 @@ func (c Config) GetConnectionPQDB(logger *slog.Logger) (*sqlx.DB, func(), error)
 		db.WithPort(c.DB.Port),
 		db.WithDatabase(c.DB.DBName),
 		db.WithSSlMode(c.DB.SslMode),
+		db.WithURL(c.DB.URL),
+		db.WithSSLRootCert(c.DB.SSLRootCert),
+		db.WithSSLClientCert(c.DB.SSLCert, c.DB.SSLKey),
+		db.WithApplicationName(c.DB.ApplicationName),
+		db.WithStatementTimeout(c.DB.StatementTimeout),
 	}
-	postgres, err := db.ConnectToPostgres(opts...)
+	opts = append(opts, c.poolOptions()...)
+	conn, err := db.ConnectWithRetry(ctx, c.DB.Driver, c.retryPolicy(), logger, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
-	return postgres, func() {
-		if err := postgres.Close(); err != nil {
+	return conn, func() {
+		if err := conn.Close(); err != nil {
 			logger.Error("error closing database connection", "err", err)
 		}
 	}, nil
 }
+
+// GetReplicaCluster opens the configured read replicas of the primary, unreachable ones being left to the
+// health checks of the cluster. It returns nil when there is none.
+func (c Config) GetReplicaCluster(logger *slog.Logger, primary *sqlx.DB) (*db.Cluster, error) {
+	if len(c.DB.ReplicaDSNs) == 0 {
+		return nil, nil
+	}
+	if c.DB.Driver != db.Postgres {
+		return nil, fmt.Errorf("read replicas are only supported with %s", db.Postgres)
+	}
+	replicas := make([]*sqlx.DB, 0, len(c.DB.ReplicaDSNs))
+	for i, dsn := range c.DB.ReplicaDSNs {
+		replica, err := db.OpenPostgres(dsn, c.poolOptions()...)
+		if err != nil {
+			for _, r := range replicas {
+				_ = r.Close()
+			}
+			return nil, fmt.Errorf("replica %d: %w", i, err)
+		}
+		replicas = append(replicas, replica)
+	}
+	return db.NewCluster(primary, replicas, logger,
+		db.MaxReplicaLag(c.DB.MaxReplicaLag),
+		db.ReplicaCheckInterval(c.DB.ReplicaCheckInterval),
+	), nil
+}
+
+// poolOptions returns the connection pool settings, shared by the primary and the replicas.
+func (c Config) poolOptions() []db.Option {
+	return []db.Option{
+		db.WithMaxOpenConns(c.DB.MaxOpenConns),
+		db.WithMaxIdleConns(c.DB.MaxIdleConns),
+		db.WithConnMaxLifetime(c.DB.ConnMaxLifetime),
+		db.WithConnMaxIdleTime(c.DB.ConnMaxIdleTime),
+	}
+}
+
+// retryPolicy returns the policy of the connection attempts at startup.
+func (c Config) retryPolicy() db.RetryPolicy {
+	return db.RetryPolicy{
+		MaxAttempts:     c.DB.ConnectAttempts,
+		InitialInterval: c.DB.ConnectBackoff,
+		MaxInterval:     c.DB.ConnectMaxBackoff,
+		MaxElapsedTime:  c.DB.ConnectTimeout,
+	}
+}
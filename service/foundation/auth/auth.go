@@ -0,0 +1,45 @@
+// Package auth carries the identity of the caller of a request. The service does not authenticate callers
+// itself: a gateway in front of it does, and passes the identity along.
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Caller is who a request is made by: a role, and the student they are when the caller is a student.
+	Caller struct {
+		Role      string
+		StudentID uuid.UUID
+	}
+
+	callerKey struct{}
+)
+
+// WithCaller returns a copy of ctx made by the caller.
+func WithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFrom returns the caller of ctx, if known.
+func CallerFrom(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(Caller)
+	return caller, ok
+}
+
+// Is reports whether the caller is the given student.
+func (c Caller) Is(studentID uuid.UUID) bool {
+	return c.StudentID != uuid.Nil && c.StudentID == studentID
+}
+
+// HasRole reports whether the caller has one of the roles.
+func (c Caller) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		if c.Role != "" && c.Role == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCaller(t *testing.T) {
+	_, ok := CallerFrom(context.Background())
+	require.False(t, ok)
+
+	studentID := uuid.New()
+	caller, ok := CallerFrom(WithCaller(context.Background(), Caller{Role: "student", StudentID: studentID}))
+	require.True(t, ok)
+	require.True(t, caller.Is(studentID))
+	require.False(t, caller.Is(uuid.New()))
+	require.True(t, caller.HasRole("registrar", "student"))
+	require.False(t, caller.HasRole("registrar"))
+
+	anonymous := Caller{}
+	require.False(t, anonymous.Is(uuid.Nil))
+	require.False(t, anonymous.HasRole(""))
+}
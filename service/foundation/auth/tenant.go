@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	"regexp"
+)
+
+// DefaultTenant is the tenant of the requests naming none, and of the data stored before there were tenants.
+const DefaultTenant = "default"
+
+// AllTenants scopes the background workers relaying the outbox and delivering the webhooks to the events,
+// webhooks and deliveries of every tenant. It is not a valid tenant, so no request can be scoped to it.
+const AllTenants = "*"
+
+// tenantPattern is what a tenant identifier looks like, e.g. north-high: lowercase letters, digits, - and _.
+var tenantPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx scoped to the tenant, the institution whose data it reads and writes.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFrom returns the tenant of ctx, DefaultTenant when it is not scoped to one.
+func TenantFrom(ctx context.Context) string {
+	if tenant, ok := ctx.Value(tenantKey{}).(string); ok && tenant != "" {
+		return tenant
+	}
+	return DefaultTenant
+}
+
+// ValidTenant reports whether the tenant identifier is well-formed.
+func ValidTenant(tenant string) bool {
+	return tenantPattern.MatchString(tenant)
+}
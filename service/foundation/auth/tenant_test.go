@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenant(t *testing.T) {
+	require.Equal(t, DefaultTenant, TenantFrom(context.Background()))
+	require.Equal(t, DefaultTenant, TenantFrom(WithTenant(context.Background(), "")))
+	require.Equal(t, "north-high", TenantFrom(WithTenant(context.Background(), "north-high")))
+}
+
+func TestValidTenant(t *testing.T) {
+	testCases := map[string]struct {
+		tenant string
+		valid  bool
+	}{
+		"default":      {tenant: DefaultTenant, valid: true},
+		"with digits":  {tenant: "school_42", valid: true},
+		"empty":        {tenant: ""},
+		"uppercase":    {tenant: "North"},
+		"leading dash": {tenant: "-north"},
+		"too long":     {tenant: "a234567890123456789012345678901234567890123456789012345678901234"},
+		"quote":        {tenant: "north'high"},
+		"all tenants":  {tenant: AllTenants},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.valid, ValidTenant(tc.tenant))
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/exp/slog"
+)
+
+// language=postgresql
+const postgresReplicationLag = `select case
+	when pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() then 0
+	else coalesce(extract(epoch from now() - pg_last_xact_replay_timestamp()), 0)
+end`
+
+type (
+	// LagFunc returns how far a replica is behind its primary.
+	LagFunc func(ctx context.Context, replica *sqlx.DB) (time.Duration, error)
+
+	// Cluster routes reads to healthy replicas and everything else to the primary.
+	// A replica is healthy when it answers pings and lags behind the primary by less than the maximum lag.
+	Cluster struct {
+		primary  *sqlx.DB
+		replicas []*replica
+		next     atomic.Uint64
+		logger   *slog.Logger
+		options  clusterOptions
+		stop     chan struct{}
+		stopOnce sync.Once
+		done     sync.WaitGroup
+	}
+
+	replica struct {
+		db      *sqlx.DB
+		index   int
+		healthy atomic.Bool
+	}
+
+	clusterOptions struct {
+		maxLag        time.Duration
+		checkInterval time.Duration
+		lag           LagFunc
+	}
+
+	// ClusterOption is used to provide overrides to the Cluster implementation.
+	ClusterOption func(*clusterOptions)
+)
+
+// MaxReplicaLag sets the lag beyond which reads fall back to the primary. The default is 10 seconds.
+func MaxReplicaLag(lag time.Duration) ClusterOption {
+	return func(o *clusterOptions) {
+		o.maxLag = lag
+	}
+}
+
+// ReplicaCheckInterval sets how often the health of the replicas is checked. The default is 5 seconds.
+func ReplicaCheckInterval(interval time.Duration) ClusterOption {
+	return func(o *clusterOptions) {
+		o.checkInterval = interval
+	}
+}
+
+// ReplicaLag sets how the lag of a replica is measured. The default works for PostgreSQL streaming replicas.
+func ReplicaLag(lag LagFunc) ClusterOption {
+	return func(o *clusterOptions) {
+		o.lag = lag
+	}
+}
+
+// PostgresReplicationLag measures the replay lag of a PostgreSQL streaming replica.
+// A replica that has replayed everything it received reports no lag, however old its last transaction.
+func PostgresReplicationLag(ctx context.Context, replica *sqlx.DB) (time.Duration, error) {
+	var seconds float64
+	if err := replica.QueryRowxContext(ctx, postgresReplicationLag).Scan(&seconds); err != nil {
+		return 0, err
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// NewCluster returns a cluster of the primary and its replicas. Replicas are unhealthy until checked,
+// see Check and Start.
+func NewCluster(primary *sqlx.DB, replicas []*sqlx.DB, logger *slog.Logger, options ...ClusterOption) *Cluster {
+	c := &Cluster{
+		primary: primary,
+		logger:  logger,
+		options: clusterOptions{
+			maxLag:        10 * time.Second,
+			checkInterval: 5 * time.Second,
+			lag:           PostgresReplicationLag,
+		},
+		stop: make(chan struct{}),
+	}
+	for _, opt := range options {
+		opt(&c.options)
+	}
+	for i, db := range replicas {
+		c.replicas = append(c.replicas, &replica{db: db, index: i})
+	}
+	return c
+}
+
+// Primary returns the primary, for writes.
+func (c *Cluster) Primary() *sqlx.DB {
+	return c.primary
+}
+
+// Reader returns the database to read from: the primary when the request wants to read its own writes
+// or no replica is healthy, the next healthy replica otherwise.
+func (c *Cluster) Reader(ctx context.Context) *sqlx.DB {
+	if mustReadPrimary(ctx) || len(c.replicas) == 0 {
+		return c.primary
+	}
+	start := c.next.Add(1)
+	for i := range c.replicas {
+		r := c.replicas[(start+uint64(i))%uint64(len(c.replicas))]
+		if r.healthy.Load() {
+			return r.db
+		}
+	}
+	return c.primary
+}
+
+// Check updates the health of every replica.
+func (c *Cluster) Check(ctx context.Context) {
+	for _, r := range c.replicas {
+		healthy := c.check(ctx, r)
+		if was := r.healthy.Swap(healthy); was != healthy {
+			c.logger.Info("replica health changed", "replica", r.index, "healthy", healthy)
+		}
+	}
+}
+
+func (c *Cluster) check(ctx context.Context, r *replica) bool {
+	ctx, cancel := context.WithTimeout(ctx, c.options.checkInterval)
+	defer cancel()
+	if err := r.db.PingContext(ctx); err != nil {
+		c.logger.Warn("replica unreachable", "replica", r.index, "err", err)
+		return false
+	}
+	lag, err := c.options.lag(ctx, r.db)
+	if err != nil {
+		c.logger.Warn("measuring replica lag failed", "replica", r.index, "err", err)
+		return false
+	}
+	if lag > c.options.maxLag {
+		c.logger.Warn("replica lagging", "replica", r.index, "lag", lag, "max", c.options.maxLag)
+		return false
+	}
+	return true
+}
+
+// Start checks the replicas right away, then in the background until Stop is called.
+func (c *Cluster) Start(ctx context.Context) {
+	c.Check(ctx)
+	c.done.Add(1)
+	go func() {
+		defer c.done.Done()
+		ticker := time.NewTicker(c.options.checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.Check(ctx)
+			case <-c.stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops checking the replicas and closes them. The primary is left open.
+func (c *Cluster) Stop() error {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+	c.done.Wait()
+	var errs []error
+	for _, r := range c.replicas {
+		if err := r.db.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("closing replica %d: %w", r.index, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
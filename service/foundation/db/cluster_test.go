@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+// fakeLag reports the lag set for each replica.
+type fakeLag struct {
+	mu   sync.Mutex
+	lags map[*sqlx.DB]time.Duration
+	errs map[*sqlx.DB]error
+}
+
+func (f *fakeLag) set(db *sqlx.DB, lag time.Duration, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.lags[db] = lag
+	f.errs[db] = err
+}
+
+func (f *fakeLag) lag(_ context.Context, db *sqlx.DB) (time.Duration, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.lags[db], f.errs[db]
+}
+
+func newNode(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db, err := ConnectToSQLite(WithDatabase(":memory:"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+	return db
+}
+
+func TestCluster_Reader(t *testing.T) {
+	primary, replica1, replica2 := newNode(t), newNode(t), newNode(t)
+	lags := &fakeLag{lags: map[*sqlx.DB]time.Duration{}, errs: map[*sqlx.DB]error{}}
+
+	testCases := map[string]struct {
+		setLags  func()
+		ctx      func() context.Context
+		expected []*sqlx.DB
+	}{
+		"round robin over healthy replicas": {
+			setLags:  func() {},
+			ctx:      context.Background,
+			expected: []*sqlx.DB{replica1, replica2},
+		},
+		"lagging replica is skipped": {
+			setLags: func() {
+				lags.set(replica1, time.Minute, nil)
+			},
+			ctx:      context.Background,
+			expected: []*sqlx.DB{replica2},
+		},
+		"failing lag check is skipped": {
+			setLags: func() {
+				lags.set(replica2, 0, errors.New("not a replica"))
+			},
+			ctx:      context.Background,
+			expected: []*sqlx.DB{replica1},
+		},
+		"fallback to primary": {
+			setLags: func() {
+				lags.set(replica1, time.Minute, nil)
+				lags.set(replica2, time.Minute, nil)
+			},
+			ctx:      context.Background,
+			expected: []*sqlx.DB{primary},
+		},
+		"read your writes before writing": {
+			setLags: func() {},
+			ctx: func() context.Context {
+				return WithSession(context.Background(), true)
+			},
+			expected: []*sqlx.DB{replica1, replica2},
+		},
+		"read your writes after writing": {
+			setLags: func() {},
+			ctx: func() context.Context {
+				ctx := WithSession(context.Background(), true)
+				MarkWritten(ctx)
+				return ctx
+			},
+			expected: []*sqlx.DB{primary},
+		},
+		"eventual consistency after writing": {
+			setLags: func() {},
+			ctx: func() context.Context {
+				ctx := WithSession(context.Background(), false)
+				MarkWritten(ctx)
+				return ctx
+			},
+			expected: []*sqlx.DB{replica1, replica2},
+		},
+		"forced primary": {
+			setLags: func() {},
+			ctx: func() context.Context {
+				return WithPrimary(context.Background())
+			},
+			expected: []*sqlx.DB{primary},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			lags.set(replica1, 0, nil)
+			lags.set(replica2, 0, nil)
+			tc.setLags()
+
+			c := NewCluster(primary, []*sqlx.DB{replica1, replica2}, slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+				MaxReplicaLag(time.Second),
+				ReplicaLag(lags.lag),
+			)
+			c.Check(context.Background())
+
+			ctx := tc.ctx()
+			seen := map[*sqlx.DB]bool{}
+			for i := 0; i < 4; i++ {
+				seen[c.Reader(ctx)] = true
+			}
+			require.Len(t, seen, len(tc.expected))
+			for _, db := range tc.expected {
+				require.True(t, seen[db])
+			}
+			require.Equal(t, primary, c.Primary())
+		})
+	}
+}
+
+func TestCluster_Unchecked(t *testing.T) {
+	primary, replica := newNode(t), newNode(t)
+	c := NewCluster(primary, []*sqlx.DB{replica}, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	require.Equal(t, primary, c.Reader(context.Background()), "replicas are unhealthy until checked")
+}
+
+func TestCluster_StartStop(t *testing.T) {
+	primary, replica := newNode(t), newNode(t)
+	lags := &fakeLag{lags: map[*sqlx.DB]time.Duration{}, errs: map[*sqlx.DB]error{}}
+	c := NewCluster(primary, []*sqlx.DB{replica}, slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+		ReplicaLag(lags.lag),
+		ReplicaCheckInterval(10*time.Millisecond),
+	)
+	c.Start(context.Background())
+	require.Equal(t, replica, c.Reader(context.Background()))
+
+	lags.set(replica, time.Hour, nil)
+	require.Eventually(t, func() bool {
+		return c.Reader(context.Background()) == primary
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, c.Stop())
+	require.Error(t, replica.Ping(), "replicas are closed")
+	require.NoError(t, primary.Ping(), "the primary is left open")
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type (
+	// session tracks the consistency a request needs from its reads.
+	session struct {
+		readYourWrites bool
+		wrote          atomic.Bool
+	}
+
+	sessionKey struct{}
+)
+
+// WithSession starts the consistency session of a request. When readYourWrites is set, reads following a
+// write of the same request go to the primary, so the request sees what it wrote despite replica lag.
+func WithSession(ctx context.Context, readYourWrites bool) context.Context {
+	return context.WithValue(ctx, sessionKey{}, &session{readYourWrites: readYourWrites})
+}
+
+// WithPrimary makes every read of ctx go to the primary.
+func WithPrimary(ctx context.Context) context.Context {
+	s := &session{readYourWrites: true}
+	s.wrote.Store(true)
+	return context.WithValue(ctx, sessionKey{}, s)
+}
+
+// MarkWritten records that the request of ctx wrote to the primary.
+func MarkWritten(ctx context.Context) {
+	if s, ok := ctx.Value(sessionKey{}).(*session); ok {
+		s.wrote.Store(true)
+	}
+}
+
+func mustReadPrimary(ctx context.Context) bool {
+	s, ok := ctx.Value(sessionKey{}).(*session)
+	return ok && s.readYourWrites && s.wrote.Load()
+}
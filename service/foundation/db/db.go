@@ -3,17 +3,39 @@ package db
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	// PostgreSQL driver
 	_ "github.com/lib/pq"
 	// MySQL driver
 	_ "github.com/go-sql-driver/mysql"
+	// SQLite driver
+	_ "modernc.org/sqlite"
 )
 
 // SSLMode ...
 type SSLMode string
 
+// Driver is the relational database backing the service.
+type Driver string
+
+const (
+	// Postgres ...
+	Postgres Driver = "postgres"
+	// MySQL ...
+	MySQL Driver = "mysql"
+	// SQLite is an embedded database, Database being the path of its file.
+	SQLite Driver = "sqlite"
+	// Memory keeps the data in memory, for demos; there is nothing to connect to.
+	Memory Driver = "memory"
+)
+
+func init() {
+	// sqlx does not know the name the pure Go SQLite driver registers under
+	sqlx.BindDriver(string(SQLite), sqlx.QUESTION)
+}
+
 const (
 	// Disable ...
 	Disable SSLMode = "disable"
@@ -33,6 +55,23 @@ type Config struct {
 	Port     string
 	Database string
 	Sslmode  SSLMode
+	// URL is a postgres:// connection URL taking precedence over the fields above.
+	URL string
+
+	// TLS
+	SSLRootCert string
+	SSLCert     string
+	SSLKey      string
+
+	// session
+	ApplicationName  string
+	StatementTimeout time.Duration
+
+	// pool
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
 }
 
 // Option ...
@@ -80,6 +119,101 @@ func WithSSlMode(sslmode SSLMode) Option {
 	}
 }
 
+// WithURL ...
+func WithURL(url string) Option {
+	return func(c *Config) {
+		c.URL = url
+	}
+}
+
+// WithSSLRootCert sets the CA certificate verifying the server, needed by VerifyCA and VerifyFull
+// unless the server certificate is signed by a system CA.
+func WithSSLRootCert(path string) Option {
+	return func(c *Config) {
+		c.SSLRootCert = path
+	}
+}
+
+// WithSSLClientCert sets the certificate and key authenticating the client.
+func WithSSLClientCert(certPath, keyPath string) Option {
+	return func(c *Config) {
+		c.SSLCert = certPath
+		c.SSLKey = keyPath
+	}
+}
+
+// WithApplicationName sets the name the connections report to the server, e.g. in pg_stat_activity.
+func WithApplicationName(name string) Option {
+	return func(c *Config) {
+		c.ApplicationName = name
+	}
+}
+
+// WithStatementTimeout aborts the statements running longer than timeout. Zero means no timeout.
+func WithStatementTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.StatementTimeout = timeout
+	}
+}
+
+// WithMaxOpenConns ...
+func WithMaxOpenConns(n int) Option {
+	return func(c *Config) {
+		c.MaxOpenConns = n
+	}
+}
+
+// WithMaxIdleConns ...
+func WithMaxIdleConns(n int) Option {
+	return func(c *Config) {
+		c.MaxIdleConns = n
+	}
+}
+
+// WithConnMaxLifetime ...
+func WithConnMaxLifetime(d time.Duration) Option {
+	return func(c *Config) {
+		c.ConnMaxLifetime = d
+	}
+}
+
+// WithConnMaxIdleTime ...
+func WithConnMaxIdleTime(d time.Duration) Option {
+	return func(c *Config) {
+		c.ConnMaxIdleTime = d
+	}
+}
+
+// applyPool applies the pool settings of the config, zero values keeping the database/sql defaults.
+func (c *Config) applyPool(db *sqlx.DB) {
+	if c.MaxOpenConns > 0 {
+		db.SetMaxOpenConns(c.MaxOpenConns)
+	}
+	if c.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(c.MaxIdleConns)
+	}
+	if c.ConnMaxLifetime > 0 {
+		db.SetConnMaxLifetime(c.ConnMaxLifetime)
+	}
+	if c.ConnMaxIdleTime > 0 {
+		db.SetConnMaxIdleTime(c.ConnMaxIdleTime)
+	}
+}
+
+// Connect connects to the database of the given driver.
+func Connect(driver Driver, options ...Option) (*sqlx.DB, error) {
+	switch driver {
+	case Postgres:
+		return ConnectToPostgres(options...)
+	case MySQL:
+		return ConnectToMySQL(options...)
+	case SQLite:
+		return ConnectToSQLite(options...)
+	default:
+		return nil, fmt.Errorf("%w: unsupported database driver %q", ErrInvalidConfig, driver)
+	}
+}
+
 // ConnectToPostgres ...
 func ConnectToPostgres(options ...Option) (*sqlx.DB, error) {
 	config := &Config{}
@@ -89,19 +223,40 @@ func ConnectToPostgres(options ...Option) (*sqlx.DB, error) {
 		opt(config)
 	}
 
-	connectionString := fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s sslmode=%s",
-		config.User, config.Password, config.Host, config.Port, config.Database, config.Sslmode)
+	connectionString, err := postgresDSN(config)
+	if err != nil {
+		return nil, err
+	}
 
 	db, err := sqlx.Connect("postgres", connectionString)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to PostgreSQL: %v", err)
 	}
+	config.applyPool(db)
 
 	fmt.Println("Successfully connected to PostgreSQL!")
 
 	return db, nil
 }
 
+// OpenPostgres opens a PostgreSQL pool with a connection string, in key=value or URL form,
+// without checking the database is reachable. Only the pool options apply.
+func OpenPostgres(dsn string, options ...Option) (*sqlx.DB, error) {
+	config := &Config{}
+
+	// Apply options
+	for _, opt := range options {
+		opt(config)
+	}
+
+	db, err := sqlx.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PostgreSQL: %v", err)
+	}
+	config.applyPool(db)
+	return db, nil
+}
+
 // ConnectToMySQL ...
 func ConnectToMySQL(option ...Option) (*sqlx.DB, error) {
 	config := &Config{}
@@ -118,8 +273,31 @@ func ConnectToMySQL(option ...Option) (*sqlx.DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to MySQL: %v", err)
 	}
+	config.applyPool(db)
 
 	fmt.Println("Successfully connected to MySQL!")
 
 	return db, nil
 }
+
+// ConnectToSQLite opens the SQLite database file named by the database option, creating it if needed.
+// ":memory:" opens a database living as long as the returned connection.
+func ConnectToSQLite(option ...Option) (*sqlx.DB, error) {
+	config := &Config{}
+
+	// Apply options
+	for _, opt := range option {
+		opt(config)
+	}
+
+	connectionString := fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)", config.Database)
+
+	db, err := sqlx.Connect(string(SQLite), connectionString)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to SQLite: %v", err)
+	}
+	// SQLite serializes writes anyway, and every connection to ":memory:" would open a distinct database
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
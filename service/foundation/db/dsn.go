@@ -0,0 +1,65 @@
+package db
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// postgresDSN returns the connection string of the config: its URL completed with the other settings when set,
+// a key=value connection string otherwise.
+func postgresDSN(c *Config) (string, error) {
+	params := [][2]string{
+		{"sslmode", string(c.Sslmode)},
+		{"sslrootcert", c.SSLRootCert},
+		{"sslcert", c.SSLCert},
+		{"sslkey", c.SSLKey},
+		{"application_name", c.ApplicationName},
+	}
+	if c.StatementTimeout > 0 {
+		params = append(params, [2]string{"statement_timeout", strconv.FormatInt(c.StatementTimeout.Milliseconds(), 10)})
+	}
+
+	if c.URL != "" {
+		u, err := url.Parse(c.URL)
+		if err != nil {
+			return "", fmt.Errorf("%w: invalid database URL: %v", ErrInvalidConfig, err)
+		}
+		if u.Scheme != "postgres" && u.Scheme != "postgresql" {
+			return "", fmt.Errorf("%w: invalid database URL: unsupported scheme %q", ErrInvalidConfig, u.Scheme)
+		}
+		// settings spelled out in the URL win
+		query := u.Query()
+		for _, param := range params {
+			if param[1] != "" && !query.Has(param[0]) {
+				query.Set(param[0], param[1])
+			}
+		}
+		u.RawQuery = query.Encode()
+		return u.String(), nil
+	}
+
+	params = append([][2]string{
+		{"user", c.User},
+		{"password", c.Password},
+		{"host", c.Host},
+		{"port", c.Port},
+		{"dbname", c.Database},
+	}, params...)
+	pairs := make([]string, 0, len(params))
+	for _, param := range params {
+		if param[1] != "" {
+			pairs = append(pairs, param[0]+"="+quoteDSNValue(param[1]))
+		}
+	}
+	return strings.Join(pairs, " "), nil
+}
+
+// quoteDSNValue quotes the values of a key=value connection string containing spaces or quotes.
+func quoteDSNValue(value string) string {
+	if !strings.ContainsAny(value, ` '\`) {
+		return value
+	}
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
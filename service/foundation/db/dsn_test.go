@@ -0,0 +1,85 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresDSN(t *testing.T) {
+	testCases := map[string]struct {
+		options  []Option
+		expected string
+		err      bool
+	}{
+		"key value": {
+			options: []Option{
+				WithUser("postgres"), WithPassword("postgres"), WithHost("localhost"), WithPort("5432"),
+				WithDatabase("grading"), WithSSlMode(Disable),
+			},
+			expected: "user=postgres password=postgres host=localhost port=5432 dbname=grading sslmode=disable",
+		},
+		"quoted values": {
+			options:  []Option{WithUser("postgres"), WithPassword(`it's a s\cret`)},
+			expected: `user=postgres password='it\'s a s\\cret'`,
+		},
+		"tls and session settings": {
+			options: []Option{
+				WithHost("db"), WithSSlMode(VerifyFull), WithSSLRootCert("/certs/ca.pem"),
+				WithSSLClientCert("/certs/client.pem", "/certs/client.key"),
+				WithApplicationName("grading"), WithStatementTimeout(1500 * time.Millisecond),
+			},
+			expected: "host=db sslmode=verify-full sslrootcert=/certs/ca.pem sslcert=/certs/client.pem " +
+				"sslkey=/certs/client.key application_name=grading statement_timeout=1500",
+		},
+		"url": {
+			options: []Option{
+				WithURL("postgres://postgres:secret@db:5432/grading"), WithHost("ignored"),
+				WithSSlMode(VerifyCA), WithSSLRootCert("/certs/ca.pem"), WithApplicationName("grading"),
+			},
+			expected: "postgres://postgres:secret@db:5432/grading?application_name=grading&sslmode=verify-ca&sslrootcert=%2Fcerts%2Fca.pem",
+		},
+		"url settings win": {
+			options: []Option{
+				WithURL("postgresql://db/grading?sslmode=require&statement_timeout=100"),
+				WithSSlMode(Disable), WithStatementTimeout(time.Second),
+			},
+			expected: "postgresql://db/grading?sslmode=require&statement_timeout=100",
+		},
+		"url with unsupported scheme": {
+			options: []Option{WithURL("mysql://db/grading")},
+			err:     true,
+		},
+		"invalid url": {
+			options: []Option{WithURL("postgres://db:port/grading")},
+			err:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			config := &Config{}
+			for _, opt := range tc.options {
+				opt(config)
+			}
+			dsn, err := postgresDSN(config)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, dsn)
+		})
+	}
+}
+
+func TestConfig_ApplyPool(t *testing.T) {
+	db, err := OpenPostgres("host=localhost", WithMaxOpenConns(7), WithMaxIdleConns(3))
+	require.NoError(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+	require.Equal(t, 7, db.Stats().MaxOpenConnections)
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/exp/slog"
+)
+
+// ErrInvalidConfig is returned when connecting cannot succeed whatever the number of attempts.
+var ErrInvalidConfig = errors.New("invalid database configuration")
+
+// RetryPolicy is how connecting is retried while the database is unreachable, e.g. still starting next to
+// the service. Intervals grow exponentially from InitialInterval up to MaxInterval.
+type RetryPolicy struct {
+	// MaxAttempts bounds the attempts, zero meaning no bound other than MaxElapsedTime.
+	MaxAttempts     int
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	// MaxElapsedTime bounds the time spent retrying, zero meaning no bound other than MaxAttempts.
+	MaxElapsedTime time.Duration
+}
+
+// DefaultRetryPolicy retries for up to a minute.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:     10,
+	InitialInterval: 500 * time.Millisecond,
+	MaxInterval:     10 * time.Second,
+	MaxElapsedTime:  time.Minute,
+}
+
+func (p RetryPolicy) backOff(ctx context.Context) backoff.BackOff {
+	exp := backoff.NewExponentialBackOff()
+	if p.InitialInterval > 0 {
+		exp.InitialInterval = p.InitialInterval
+	}
+	if p.MaxInterval > 0 {
+		exp.MaxInterval = p.MaxInterval
+	}
+	exp.MaxElapsedTime = p.MaxElapsedTime
+	var b backoff.BackOff = exp
+	if p.MaxAttempts > 0 {
+		b = backoff.WithMaxRetries(b, uint64(p.MaxAttempts-1))
+	}
+	return backoff.WithContext(b, ctx)
+}
+
+// ConnectWithRetry connects to the database of the given driver, retrying with the policy until it succeeds,
+// the policy gives up or ctx is done. Configuration errors are not retried.
+func ConnectWithRetry(ctx context.Context, driver Driver, policy RetryPolicy, logger *slog.Logger,
+	options ...Option) (*sqlx.DB, error) {
+	return retry(ctx, policy, logger, func() (*sqlx.DB, error) {
+		return Connect(driver, options...)
+	})
+}
+
+func retry(ctx context.Context, policy RetryPolicy, logger *slog.Logger, connect func() (*sqlx.DB, error)) (*sqlx.DB, error) {
+	var (
+		conn    *sqlx.DB
+		attempt int
+	)
+	err := backoff.RetryNotify(func() error {
+		attempt++
+		var err error
+		conn, err = connect()
+		if errors.Is(err, ErrInvalidConfig) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, policy.backOff(ctx), func(err error, next time.Duration) {
+		logger.Warn("database unreachable, retrying", "attempt", attempt, "retry_in", next, "err", err)
+	})
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, fmt.Errorf("connecting to the database after %d attempts: %w", attempt, ctxErr)
+		}
+		return nil, fmt.Errorf("connecting to the database after %d attempts: %w", attempt, err)
+	}
+	return conn, nil
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestRetry(t *testing.T) {
+	node := newNode(t)
+	errUnreachable := errors.New("connection refused")
+	policy := RetryPolicy{MaxAttempts: 3, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+	testCases := map[string]struct {
+		failures int
+		err      error
+		ctx      func() context.Context
+		attempts int
+		expected error
+	}{
+		"first attempt": {
+			ctx:      context.Background,
+			attempts: 1,
+		},
+		"after failures": {
+			failures: 2,
+			err:      errUnreachable,
+			ctx:      context.Background,
+			attempts: 3,
+		},
+		"gives up": {
+			failures: 5,
+			err:      errUnreachable,
+			ctx:      context.Background,
+			attempts: 3,
+			expected: errUnreachable,
+		},
+		"invalid config is not retried": {
+			failures: 5,
+			err:      ErrInvalidConfig,
+			ctx:      context.Background,
+			attempts: 1,
+			expected: ErrInvalidConfig,
+		},
+		"cancelled": {
+			failures: 5,
+			err:      errUnreachable,
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			attempts: 1,
+			expected: context.Canceled,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			conn, err := retry(tc.ctx(), policy, slog.New(slog.NewJSONHandler(os.Stdout, nil)), func() (*sqlx.DB, error) {
+				attempts++
+				if attempts <= tc.failures {
+					return nil, tc.err
+				}
+				return node, nil
+			})
+			require.Equal(t, tc.attempts, attempts)
+			if tc.expected != nil {
+				require.ErrorIs(t, err, tc.expected)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, node, conn)
+		})
+	}
+}
+
+func TestConnectWithRetry_UnsupportedDriver(t *testing.T) {
+	_, err := ConnectWithRetry(context.Background(), Driver("oracle"), DefaultRetryPolicy,
+		slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	require.ErrorIs(t, err, ErrInvalidConfig)
+}
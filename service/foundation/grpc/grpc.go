@@ -0,0 +1,149 @@
+// Package grpc provides utilities for working with gRPC servers.
+package grpc
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+type (
+	// serverOptions contains various configuration options for the server.
+	serverOptions struct {
+		shutdownTimeout time.Duration
+		reflection      bool
+		interceptors    []grpc.UnaryServerInterceptor
+	}
+
+	// Server is a utility wrapper for the default grpc.Server.
+	// It always serves the standard health checking service, and optionally server reflection.
+	Server struct {
+		logger   slog.Logger
+		server   *grpc.Server
+		health   *health.Server
+		listener net.Listener
+		options  *serverOptions
+	}
+
+	// ServerOption is used to provide overrides to the Server implementation.
+	ServerOption func(options *serverOptions)
+
+	// Registrar is a function that registers services on the underlying grpc.Server.
+	Registrar func(register func(server *grpc.Server, health *health.Server))
+)
+
+// defaultServerOptions contains the default options for the server.
+var defaultServerOptions = []ServerOption{
+	ShutdownTimeout(30 * time.Second),
+	Reflection(true),
+}
+
+// ShutdownTimeout sets the time to wait for in-flight RPCs to complete on Stop. The default is 30 seconds.
+func ShutdownTimeout(timeout time.Duration) ServerOption {
+	return func(options *serverOptions) {
+		options.shutdownTimeout = timeout
+	}
+}
+
+// Reflection enables the server reflection service, used by tools like grpcurl. It is enabled by default.
+func Reflection(enabled bool) ServerOption {
+	return func(options *serverOptions) {
+		options.reflection = enabled
+	}
+}
+
+// UnaryInterceptor adds an interceptor to be executed for every unary RPC.
+func UnaryInterceptor(interceptor grpc.UnaryServerInterceptor) ServerOption {
+	return func(options *serverOptions) {
+		options.interceptors = append(options.interceptors, interceptor)
+	}
+}
+
+// NewServer creates a new instance of the gRPC server wrapper.
+func NewServer(logger slog.Logger, options ...ServerOption) Server {
+	serverOptions := &serverOptions{}
+	// Apply defaults
+	for _, defaultOpt := range defaultServerOptions {
+		defaultOpt(serverOptions)
+	}
+
+	// Apply overrides if any
+	for _, opt := range options {
+		opt(serverOptions)
+	}
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(serverOptions.interceptors...))
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	if serverOptions.reflection {
+		reflection.Register(server)
+	}
+
+	return Server{
+		logger:  logger,
+		server:  server,
+		health:  healthServer,
+		options: serverOptions,
+	}
+}
+
+// Register allows services to be registered with the underlying server.
+// The health server is given so that services can report their own serving status.
+func (s *Server) Register(registrar func(server *grpc.Server, health *health.Server)) {
+	registrar(s.server, s.health)
+}
+
+// Start listens on the provided address and serves in the background. Serving errors are sent to errors.
+func (s *Server) Start(address string, errors chan<- error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		errors <- fmt.Errorf("failed to listen on %s: %w", address, err)
+		return
+	}
+	s.Serve(listener, errors)
+}
+
+// Serve serves on the provided listener in the background. Serving errors are sent to errors.
+func (s *Server) Serve(listener net.Listener, errors chan<- error) {
+	s.listener = listener
+	go func() {
+		s.logger.Info("Starting gRPC server at", "addr", listener.Addr().String())
+		if err := s.server.Serve(listener); err != nil && errors != nil {
+			errors <- err
+		}
+	}()
+}
+
+// Address returns the active address of the gRPC server.
+func (s *Server) Address() string {
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+// Stop marks every service as not serving and gracefully shuts down the gRPC server,
+// forcing it to stop if in-flight RPCs do not complete within the shutdown timeout.
+func (s *Server) Stop() {
+	s.health.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		s.logger.Info("gRPC server completed graceful shutdown.")
+	case <-time.After(s.options.shutdownTimeout):
+		s.logger.Info(fmt.Sprintf("Graceful shutdown of the gRPC server did not complete in %s", s.options.shutdownTimeout))
+		s.server.Stop()
+	}
+}
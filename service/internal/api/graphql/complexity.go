@@ -0,0 +1,170 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// defaultListSize is the size assumed for lists whose size the query does not bound.
+const defaultListSize = 10
+
+type (
+	// complexity computes the cost and the depth of an operation before executing it.
+	// Every field costs 1 plus the cost of its selection, multiplied by the expected size for lists:
+	// the limit argument of the list or of its enclosing connection, the length of a list argument,
+	// defaultListSize otherwise.
+	complexity struct {
+		fragments map[string]*ast.FragmentDefinition
+		variables map[string]interface{}
+		visiting  map[string]bool
+	}
+)
+
+// measure returns the cost and the depth of the operation named operationName in doc.
+func measure(schema graphql.Schema, doc *ast.Document, operationName string, variables map[string]interface{}) (int, int, error) {
+	c := complexity{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+		visiting:  make(map[string]bool),
+	}
+	var operation *ast.OperationDefinition
+	for _, definition := range doc.Definitions {
+		switch d := definition.(type) {
+		case *ast.FragmentDefinition:
+			c.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				operation = d
+			}
+		}
+	}
+	if operation == nil {
+		return 0, 0, fmt.Errorf("unknown operation %q", operationName)
+	}
+
+	cost, depth := c.selectionSet(operation.SelectionSet, schema.QueryType(), 0)
+	return cost, depth, nil
+}
+
+// selectionSet returns the cost and the depth of a selection set on the parent type, which is nil when unknown.
+// limit is the limit argument of the enclosing field, 0 if none.
+func (c complexity) selectionSet(set *ast.SelectionSet, parent graphql.Type, limit int) (int, int) {
+	if set == nil {
+		return 0, 0
+	}
+	var cost, depth int
+	for _, selection := range set.Selections {
+		var selectionCost, selectionDepth int
+		switch s := selection.(type) {
+		case *ast.Field:
+			selectionCost, selectionDepth = c.field(s, parent, limit)
+		case *ast.InlineFragment:
+			selectionCost, selectionDepth = c.selectionSet(s.SelectionSet, parent, limit)
+		case *ast.FragmentSpread:
+			name := s.Name.Value
+			fragment, ok := c.fragments[name]
+			// fragment cycles are rejected by validation, guard anyway
+			if !ok || c.visiting[name] {
+				continue
+			}
+			c.visiting[name] = true
+			selectionCost, selectionDepth = c.selectionSet(fragment.SelectionSet, parent, limit)
+			delete(c.visiting, name)
+		}
+		cost += selectionCost
+		if selectionDepth > depth {
+			depth = selectionDepth
+		}
+	}
+	return cost, depth
+}
+
+func (c complexity) field(field *ast.Field, parent graphql.Type, parentLimit int) (int, int) {
+	var fieldType graphql.Type
+	if object, ok := parent.(*graphql.Object); ok {
+		if definition, ok := object.Fields()[field.Name.Value]; ok {
+			fieldType = definition.Type
+		}
+	}
+
+	isList := false
+	for unwrapped := false; !unwrapped; {
+		switch t := fieldType.(type) {
+		case *graphql.NonNull:
+			fieldType = t.OfType
+		case *graphql.List:
+			isList = true
+			fieldType = t.OfType
+		default:
+			unwrapped = true
+		}
+	}
+
+	limit := c.limit(field)
+	multiplier := 1
+	if isList {
+		multiplier = c.listSize(field, limit, parentLimit)
+		limit = 0
+	}
+	childCost, childDepth := c.selectionSet(field.SelectionSet, fieldType, limit)
+	return 1 + multiplier*childCost, 1 + childDepth
+}
+
+// limit returns the limit argument of field, 0 if none.
+func (c complexity) limit(field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value == "limit" {
+			if limit, ok := c.intValue(argument.Value); ok && limit > 0 {
+				return limit
+			}
+		}
+	}
+	return 0
+}
+
+// listSize returns the expected size of the list returned by field.
+func (c complexity) listSize(field *ast.Field, limit, parentLimit int) int {
+	if limit > 0 {
+		return limit
+	}
+	if parentLimit > 0 {
+		return parentLimit
+	}
+	for _, argument := range field.Arguments {
+		if size, ok := c.listLength(argument.Value); ok {
+			return size
+		}
+	}
+	return defaultListSize
+}
+
+func (c complexity) intValue(value ast.Value) (int, bool) {
+	switch v := value.(type) {
+	case *ast.IntValue:
+		i, err := strconv.Atoi(v.Value)
+		return i, err == nil
+	case *ast.Variable:
+		switch i := c.variables[v.Name.Value].(type) {
+		case int:
+			return i, true
+		case float64:
+			return int(i), true
+		}
+	}
+	return 0, false
+}
+
+func (c complexity) listLength(value ast.Value) (int, bool) {
+	switch v := value.(type) {
+	case *ast.ListValue:
+		return len(v.Values), true
+	case *ast.Variable:
+		if list, ok := c.variables[v.Name.Value].([]interface{}); ok {
+			return len(list), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,163 @@
+// Package graphql is the GraphQL transport of the grading use cases.
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	defaultMaxComplexity = 1000
+	defaultMaxDepth      = 10
+	maxBodySize          = 1 << 20
+)
+
+type (
+	handler struct {
+		resolver      resolver
+		schema        graphql.Schema
+		maxComplexity int
+		maxDepth      int
+	}
+
+	// Option configures the GraphQL handler.
+	Option func(*handler)
+
+	request struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+)
+
+// WithMaxComplexity sets the maximum cost of a query, see complexity for how it is computed.
+func WithMaxComplexity(maxComplexity int) Option {
+	return func(h *handler) {
+		h.maxComplexity = maxComplexity
+	}
+}
+
+// WithMaxDepth sets the maximum nesting of the fields of a query.
+func WithMaxDepth(maxDepth int) Option {
+	return func(h *handler) {
+		h.maxDepth = maxDepth
+	}
+}
+
+// NewHandler returns the handler serving GraphQL queries over the use cases.
+func NewHandler(logic usecase.Logic, logger *slog.Logger, opts ...Option) (http.Handler, error) {
+	h := &handler{
+		resolver: resolver{
+			usecase: logic,
+			logger:  logger,
+		},
+		maxComplexity: defaultMaxComplexity,
+		maxDepth:      defaultMaxDepth,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	schema, err := h.resolver.newSchema()
+	if err != nil {
+		return nil, fmt.Errorf("building schema: %w", err)
+	}
+	h.schema = schema
+	return h, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if variables := r.URL.Query().Get("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				h.respondErrors(w, http.StatusBadRequest, gqlerrors.NewFormattedError("variables must be a JSON object"))
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&req); err != nil {
+			h.respondErrors(w, http.StatusBadRequest, gqlerrors.NewFormattedError("body must be a JSON object"))
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		h.respondErrors(w, http.StatusMethodNotAllowed, gqlerrors.NewFormattedError("method not allowed"))
+		return
+	}
+	if req.Query == "" {
+		h.respondErrors(w, http.StatusBadRequest, gqlerrors.NewFormattedError("query is required"))
+		return
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		h.respondErrors(w, http.StatusBadRequest, gqlerrors.FormatError(err))
+		return
+	}
+	if validation := graphql.ValidateDocument(&h.schema, doc, nil); !validation.IsValid {
+		h.respondErrors(w, http.StatusBadRequest, validation.Errors...)
+		return
+	}
+	if err := h.checkLimits(doc, req); err != nil {
+		h.respondErrors(w, http.StatusBadRequest, gqlerrors.NewFormattedError(err.Error()))
+		return
+	}
+
+	ctx := withLoaders(r.Context(), h.resolver.newLoaders())
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+	h.respond(w, http.StatusOK, result)
+}
+
+// checkLimits rejects operations that are too costly or too deep.
+func (h *handler) checkLimits(doc *ast.Document, req request) error {
+	cost, depth, err := measure(h.schema, doc, req.OperationName, req.Variables)
+	if err != nil {
+		return err
+	}
+	if depth > h.maxDepth {
+		return fmt.Errorf("query depth %d exceeds the maximum of %d", depth, h.maxDepth)
+	}
+	if cost > h.maxComplexity {
+		return fmt.Errorf("query complexity %d exceeds the maximum of %d", cost, h.maxComplexity)
+	}
+	return nil
+}
+
+func (h *handler) respondErrors(w http.ResponseWriter, statusCode int, errs ...gqlerrors.FormattedError) {
+	h.respond(w, statusCode, &graphql.Result{Errors: errs})
+}
+
+func (h *handler) respond(w http.ResponseWriter, statusCode int, result *graphql.Result) {
+	bytes, err := json.Marshal(result)
+	if err != nil {
+		h.resolver.logger.Error("while marshalling graphql result", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	if _, err := w.Write(bytes); err != nil {
+		h.resolver.logger.Error("while responding", "error", err)
+	}
+}
@@ -0,0 +1,249 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+type response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func newTestHandler(t *testing.T, logic usecase.Logic, opts ...Option) http.Handler {
+	t.Helper()
+	h, err := NewHandler(logic, slog.New(slog.NewJSONHandler(os.Stdout, nil)), opts...)
+	require.NoError(t, err)
+	return h
+}
+
+func post(t *testing.T, h http.Handler, query string, variables map[string]interface{}) (int, response) {
+	t.Helper()
+	body, err := json.Marshal(request{Query: query, Variables: variables})
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+
+	var resp response
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	return rr.Code, resp
+}
+
+func TestHandler_Batching(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logic := usecase.NewMockLogic(ctrl)
+
+	students := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	grades := []domain.GradeWithGPA{
+		{Grade: &domain.Grade{StudentID: students[0], CourseID: uuid.New(), Grade: 90}},
+		{Grade: &domain.Grade{StudentID: students[1], CourseID: uuid.New(), Grade: 40}},
+		{Grade: &domain.Grade{StudentID: students[2], CourseID: uuid.New(), Grade: 70}},
+	}
+	logic.EXPECT().GetGrades(gomock.Any(), domain.ScaleType(""), 3, 0).Return(grades, 3, nil)
+	logic.EXPECT().GetStudentsGrades(gomock.Any(), gomock.Len(3)).Return(map[uuid.UUID][]domain.Grade{
+		students[0]: {*grades[0].Grade},
+		students[1]: {*grades[1].Grade},
+		students[2]: {*grades[2].Grade},
+	}, nil).Times(1)
+	logic.EXPECT().GetScalesByTypes(gomock.Any(), gomock.Len(2)).Return(map[domain.ScaleType]domain.Scales{
+		domain.DefaultScaleType: {{Min: 80, GPA: "A"}, {Min: 50, GPA: "C"}, {Min: 0, GPA: "F"}},
+		domain.ScaleType("4.0"): {{Min: 60, GPA: "4.0"}, {Min: 0, GPA: "0.0"}},
+	}, nil).Times(1)
+
+	code, resp := post(t, newTestHandler(t, logic), `{
+		grades(limit: 3) {
+			total
+			grades {
+				grade
+				letter
+				letters(scaleTypes: ["4.0"]) { scaleType letter }
+				student {
+					id
+					gpa { average letter }
+					summary { count mean }
+				}
+			}
+		}
+	}`, nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, resp.Errors)
+
+	connection := resp.Data["grades"].(map[string]interface{})
+	require.EqualValues(t, 3, connection["total"])
+	first := connection["grades"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "A", first["letter"])
+	require.Equal(t, []interface{}{map[string]interface{}{"scaleType": "4.0", "letter": "4.0"}}, first["letters"])
+	student := first["student"].(map[string]interface{})
+	require.Equal(t, students[0].String(), student["id"])
+	require.Equal(t, map[string]interface{}{"average": 90.0, "letter": "A"}, student["gpa"])
+	require.Equal(t, map[string]interface{}{"count": 1.0, "mean": 90.0}, student["summary"])
+}
+
+func TestHandler_Query(t *testing.T) {
+	studentID := uuid.New()
+
+	testCases := map[string]struct {
+		query          string
+		variables      map[string]interface{}
+		setMock        func(m *usecase.MockLogic)
+		expectedCode   int
+		expectedData   map[string]interface{}
+		expectedErrors []string
+	}{
+		"student": {
+			query:     `query($id: ID!) { student(id: $id) { id grades { grade } } }`,
+			variables: map[string]interface{}{"id": studentID.String()},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStudentsGrades(gomock.Any(), []uuid.UUID{studentID}).Return(map[uuid.UUID][]domain.Grade{
+					studentID: {{StudentID: studentID, Grade: 55}},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: map[string]interface{}{
+				"student": map[string]interface{}{
+					"id":     studentID.String(),
+					"grades": []interface{}{map[string]interface{}{"grade": 55.0}},
+				},
+			},
+		},
+		"grade types": {
+			query:     `query($id: ID!) { student(id: $id) { grades { grade type letter } gpa { average letter } } }`,
+			variables: map[string]interface{}{"id": studentID.String()},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStudentsGrades(gomock.Any(), []uuid.UUID{studentID}).Return(map[uuid.UUID][]domain.Grade{
+					studentID: {{StudentID: studentID, Grade: 90}, {StudentID: studentID, Type: domain.WithdrawnGrade}},
+				}, nil)
+				m.EXPECT().GetScalesByTypes(gomock.Any(), []domain.ScaleType{domain.DefaultScaleType}).Return(map[domain.ScaleType]domain.Scales{
+					domain.DefaultScaleType: {{Min: 80, GPA: "A"}, {Min: 0, GPA: "F"}},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: map[string]interface{}{
+				"student": map[string]interface{}{
+					"grades": []interface{}{
+						map[string]interface{}{"grade": 90.0, "type": nil, "letter": "A"},
+						map[string]interface{}{"grade": 0.0, "type": "withdrawn", "letter": "W"},
+					},
+					"gpa": map[string]interface{}{"average": 90.0, "letter": "A"},
+				},
+			},
+		},
+		"unknown student": {
+			query: `{ student(id: "` + studentID.String() + `") { id } }`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStudentsGrades(gomock.Any(), gomock.Any()).Return(map[uuid.UUID][]domain.Grade{}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: map[string]interface{}{"student": nil},
+		},
+		"scale": {
+			query: `{ scale(type: "4.0") { type bands { min gpa } } }`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetScalesByTypes(gomock.Any(), []domain.ScaleType{"4.0"}).Return(map[domain.ScaleType]domain.Scales{
+					"4.0": {{Min: 60, GPA: "4.0"}},
+				}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedData: map[string]interface{}{
+				"scale": map[string]interface{}{
+					"type":  "4.0",
+					"bands": []interface{}{map[string]interface{}{"min": 60.0, "gpa": "4.0"}},
+				},
+			},
+		},
+		"unknown scale type": {
+			query:          `{ scale(type: "1.0") { type } }`,
+			expectedCode:   http.StatusOK,
+			expectedData:   map[string]interface{}{"scale": nil},
+			expectedErrors: []string{`unknown scale type "1.0"`},
+		},
+		"internal error is masked": {
+			query: `{ grades { total } }`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGrades(gomock.Any(), domain.ScaleType(""), defaultLimit, 0).Return(nil, 0, errors.New("connection refused"))
+			},
+			expectedCode:   http.StatusOK,
+			expectedErrors: []string{errInternal.Error()},
+		},
+		"invalid query": {
+			query:          `{ grades { unknown } }`,
+			expectedCode:   http.StatusBadRequest,
+			expectedErrors: []string{`Cannot query field "unknown" on type "GradeConnection".`},
+		},
+		"too complex": {
+			query:          `{ grades(limit: 100) { grades { letters(scaleTypes: ["4.0", "5.0"]) { letter } student { grades { letter } } } } }`,
+			expectedCode:   http.StatusBadRequest,
+			expectedErrors: []string{"query complexity 1502 exceeds the maximum of 1000"},
+		},
+		"too complex with variables": {
+			query:          `query($limit: Int) { grades(limit: $limit) { grades { student { grades { grade } } } } }`,
+			variables:      map[string]interface{}{"limit": 100},
+			expectedCode:   http.StatusBadRequest,
+			expectedErrors: []string{"query complexity 1202 exceeds the maximum of 1000"},
+		},
+		"too deep": {
+			query:          `{ grades { grades { student { grades { student { grades { student { grades { student { grades { grade } } } } } } } } } } }`,
+			expectedCode:   http.StatusBadRequest,
+			expectedErrors: []string{"query depth 11 exceeds the maximum of 10"},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logic := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(logic)
+			}
+
+			code, resp := post(t, newTestHandler(t, logic), tc.query, tc.variables)
+			require.Equal(t, tc.expectedCode, code)
+			if tc.expectedData != nil {
+				require.Equal(t, tc.expectedData, resp.Data)
+			}
+			messages := make([]string, 0, len(resp.Errors))
+			for _, e := range resp.Errors {
+				messages = append(messages, e.Message)
+			}
+			if tc.expectedErrors == nil {
+				require.Empty(t, messages)
+			} else {
+				require.Equal(t, tc.expectedErrors, messages)
+			}
+		})
+	}
+}
+
+func TestHandler_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logic := usecase.NewMockLogic(ctrl)
+	logic.EXPECT().GetScalesByTypes(gomock.Any(), []domain.ScaleType{domain.DefaultScaleType}).Return(map[domain.ScaleType]domain.Scales{
+		domain.DefaultScaleType: {{Min: 0, GPA: "F"}},
+	}, nil)
+	h := newTestHandler(t, logic, WithMaxComplexity(5))
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape(`{ scale { type } }`), nil))
+	require.Equal(t, http.StatusOK, rr.Code)
+	require.JSONEq(t, `{"data":{"scale":{"type":"default"}}}`, rr.Body.String())
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, "/graphql", nil))
+	require.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+}
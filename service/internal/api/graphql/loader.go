@@ -0,0 +1,75 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+)
+
+type (
+	// loader batches the lookups of a single request, dataloader style.
+	// Resolvers enqueue keys with load and get back a thunk; the executor resolves every field of a level
+	// before calling any thunk, so the first thunk called fetches all the keys enqueued at that level at once.
+	loader[K comparable, V any] struct {
+		mu      sync.Mutex
+		fetch   func(ctx context.Context, keys []K) (map[K]V, error)
+		pending []K
+		queued  map[K]struct{}
+		results map[K]V
+		errs    map[K]error
+		done    map[K]struct{}
+	}
+)
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:   fetch,
+		queued:  make(map[K]struct{}),
+		results: make(map[K]V),
+		errs:    make(map[K]error),
+		done:    make(map[K]struct{}),
+	}
+}
+
+// load enqueues key and returns a thunk resolving to its value, whether it was found, or the fetch error.
+func (l *loader[K, V]) load(ctx context.Context, key K) func() (V, bool, error) {
+	l.mu.Lock()
+	_, isDone := l.done[key]
+	_, isQueued := l.queued[key]
+	if !isDone && !isQueued {
+		l.pending = append(l.pending, key)
+		l.queued[key] = struct{}{}
+	}
+	l.mu.Unlock()
+
+	return func() (V, bool, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if _, ok := l.done[key]; !ok {
+			l.dispatch(ctx)
+		}
+		if err, ok := l.errs[key]; ok {
+			var zero V
+			return zero, false, err
+		}
+		v, ok := l.results[key]
+		return v, ok, nil
+	}
+}
+
+// dispatch fetches every pending key in a single call. It must be called with the lock held.
+func (l *loader[K, V]) dispatch(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+	results, err := l.fetch(ctx, keys)
+	for _, key := range keys {
+		delete(l.queued, key)
+		l.done[key] = struct{}{}
+		if err != nil {
+			l.errs[key] = err
+			continue
+		}
+		if v, ok := results[key]; ok {
+			l.results[key] = v
+		}
+	}
+}
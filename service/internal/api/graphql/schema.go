@@ -0,0 +1,583 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/graphql-go/graphql"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"golang.org/x/exp/slog"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+var errInternal = errors.New("internal error")
+
+type (
+	// resolver resolves the fields of the schema using the use cases.
+	resolver struct {
+		usecase usecase.Logic
+		logger  *slog.Logger
+	}
+
+	// loaders are the batching loaders of a single request.
+	loaders struct {
+		grades *loader[uuid.UUID, []domain.Grade]
+		scales *loader[domain.ScaleType, domain.Scales]
+	}
+
+	loadersKey struct{}
+
+	// student is the source of the Student type.
+	student struct {
+		id uuid.UUID
+	}
+
+	// studentGPA is the source of the StudentGPA type.
+	studentGPA struct {
+		domain.StudentGPA
+		scaleType domain.ScaleType
+	}
+
+	// scale is the source of the Scale type.
+	scale struct {
+		scaleType domain.ScaleType
+		bands     domain.Scales
+	}
+
+	// scaleLetter is the source of the ScaleLetter type.
+	scaleLetter struct {
+		scaleType domain.ScaleType
+		letter    string
+	}
+
+	// gradeConnection is the source of the GradeConnection type.
+	gradeConnection struct {
+		grades []domain.Grade
+		total  int
+		limit  int
+		offset int
+	}
+)
+
+func (r resolver) newLoaders() *loaders {
+	return &loaders{
+		grades: newLoader(func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]domain.Grade, error) {
+			return r.usecase.GetStudentsGrades(ctx, ids)
+		}),
+		scales: newLoader(func(ctx context.Context, types []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error) {
+			return r.usecase.GetScalesByTypes(ctx, types)
+		}),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// newSchema builds the GraphQL schema of the grading API.
+func (r resolver) newSchema() (graphql.Schema, error) {
+	scaleBandType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "ScaleBand",
+		Description: "A band of a scale: grades from min up to the next band get the gpa letter.",
+		Fields: graphql.Fields{
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.Scale).Min, nil
+				},
+			},
+			"gpa": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.Scale).GPA, nil
+				},
+			},
+		},
+	})
+
+	scaleType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Scale",
+		Fields: graphql.Fields{
+			"type": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(scale).scaleType), nil
+				},
+			},
+			"bands": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(scaleBandType))),
+				Description: "Bands sorted by min in descending order.",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return []domain.Scale(p.Source.(scale).bands), nil
+				},
+			},
+		},
+	})
+
+	scaleLetterType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ScaleLetter",
+		Fields: graphql.Fields{
+			"scaleType": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(scaleLetter).scaleType), nil
+				},
+			},
+			"letter": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(scaleLetter).letter, nil
+				},
+			},
+		},
+	})
+
+	summaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "GradeSummary",
+		Description: "Aggregates of a set of grades.",
+		Fields: graphql.Fields{
+			"count": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.GradeSummary).Count, nil
+				},
+			},
+			"mean": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Float),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.GradeSummary).Mean, nil
+				},
+			},
+			"min": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.GradeSummary).Min, nil
+				},
+			},
+			"max": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.GradeSummary).Max, nil
+				},
+			},
+		},
+	})
+
+	studentGPAType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "StudentGPA",
+		Description: "The GPA of a student under a scale: the letter of the average of their numeric grades.",
+		Fields: graphql.Fields{
+			"scaleType": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return string(p.Source.(studentGPA).scaleType), nil
+				},
+			},
+			"average": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Float),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(studentGPA).Average, nil
+				},
+			},
+			"letter": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(studentGPA).GPA, nil
+				},
+			},
+		},
+	})
+
+	studentType := graphql.NewObject(graphql.ObjectConfig{
+		Name:   "Student",
+		Fields: graphql.Fields{},
+	})
+
+	gradeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Grade",
+		Fields: graphql.Fields{
+			"studentId": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.Grade).StudentID.String(), nil
+				},
+			},
+			"courseId": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.Grade).CourseID.String(), nil
+				},
+			},
+			"grade": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(domain.Grade).Grade, nil
+				},
+			},
+			"type": &graphql.Field{
+				Type: graphql.String,
+				Description: "The outcome of a grade without a numeric value: pass, fail, incomplete, withdrawn or audit. " +
+					"Null for numeric grades.",
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					if gradeType := p.Source.(domain.Grade).Type; !gradeType.Counted() {
+						return string(gradeType), nil
+					}
+					return nil, nil
+				},
+			},
+			"letter": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Description: "The GPA letter of the grade under a scale, the default one if not given, " +
+					"the label of its type for the grades without a numeric value.",
+				Args: graphql.FieldConfigArgument{
+					"scaleType": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.resolveGradeLetter,
+			},
+			"letters": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(scaleLetterType))),
+				Description: "The GPA letters of the grade under several scales.",
+				Args: graphql.FieldConfigArgument{
+					"scaleTypes": &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+					},
+				},
+				Resolve: r.resolveGradeLetters,
+			},
+			"student": &graphql.Field{
+				Type: graphql.NewNonNull(studentType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return student{id: p.Source.(domain.Grade).StudentID}, nil
+				},
+			},
+		},
+	})
+
+	studentType.AddFieldConfig("id", &graphql.Field{
+		Type: graphql.NewNonNull(graphql.ID),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return p.Source.(student).id.String(), nil
+		},
+	})
+	studentType.AddFieldConfig("grades", &graphql.Field{
+		Type:    graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(gradeType))),
+		Resolve: r.resolveStudentGrades,
+	})
+	studentType.AddFieldConfig("gpa", &graphql.Field{
+		Type: graphql.NewNonNull(studentGPAType),
+		Args: graphql.FieldConfigArgument{
+			"scaleType": &graphql.ArgumentConfig{Type: graphql.String},
+		},
+		Resolve: r.resolveStudentGPA,
+	})
+	studentType.AddFieldConfig("summary", &graphql.Field{
+		Type:    graphql.NewNonNull(summaryType),
+		Resolve: r.resolveStudentSummary,
+	})
+
+	gradeConnectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "GradeConnection",
+		Fields: graphql.Fields{
+			"grades": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(gradeType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(gradeConnection).grades, nil
+				},
+			},
+			"total": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(gradeConnection).total, nil
+				},
+			},
+			"limit": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(gradeConnection).limit, nil
+				},
+			},
+			"offset": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return p.Source.(gradeConnection).offset, nil
+				},
+			},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"grades": &graphql.Field{
+				Type:        graphql.NewNonNull(gradeConnectionType),
+				Description: "A page of the grades of all students.",
+				Args: graphql.FieldConfigArgument{
+					"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultLimit},
+					"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				},
+				Resolve: r.resolveGrades,
+			},
+			"student": &graphql.Field{
+				Type:        studentType,
+				Description: "A student by id, null if the student has no grades.",
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: r.resolveStudent,
+			},
+			"students": &graphql.Field{
+				Type:        graphql.NewNonNull(graphql.NewList(studentType)),
+				Description: "Students by id, in the same order; null for students without grades.",
+				Args: graphql.FieldConfigArgument{
+					"ids": &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.ID))),
+					},
+				},
+				Resolve: r.resolveStudents,
+			},
+			"scale": &graphql.Field{
+				Type:        scaleType,
+				Description: "A scale by type, the default one if not given; null if it has no band.",
+				Args: graphql.FieldConfigArgument{
+					"type": &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.resolveScale,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+}
+
+func (r resolver) resolveGrades(p graphql.ResolveParams) (interface{}, error) {
+	limit, _ := p.Args["limit"].(int)
+	offset, _ := p.Args["offset"].(int)
+	if limit < 1 || limit > maxLimit {
+		return nil, fmt.Errorf("limit must be between 1 and %d", maxLimit)
+	}
+	if offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+
+	grades, total, err := r.usecase.GetGrades(p.Context, "", limit, offset)
+	if err != nil {
+		return nil, r.internal("grades", err)
+	}
+	connection := gradeConnection{
+		grades: make([]domain.Grade, 0, len(grades)),
+		total:  total,
+		limit:  limit,
+		offset: offset,
+	}
+	for _, grade := range grades {
+		connection.grades = append(connection.grades, *grade.Grade)
+	}
+	return connection, nil
+}
+
+func (r resolver) resolveStudent(p graphql.ResolveParams) (interface{}, error) {
+	id, err := uuid.Parse(p.Args["id"].(string))
+	if err != nil {
+		return nil, errors.New("id must be a uuid")
+	}
+	thunk := loadersFrom(p.Context).grades.load(p.Context, id)
+	return func() (interface{}, error) {
+		_, found, err := thunk()
+		if err != nil {
+			return nil, r.internal("student", err)
+		}
+		if !found {
+			return nil, nil
+		}
+		return student{id: id}, nil
+	}, nil
+}
+
+func (r resolver) resolveStudents(p graphql.ResolveParams) (interface{}, error) {
+	rawIDs := p.Args["ids"].([]interface{})
+	ids := make([]uuid.UUID, len(rawIDs))
+	thunks := make([]func() ([]domain.Grade, bool, error), len(rawIDs))
+	for i, raw := range rawIDs {
+		id, err := uuid.Parse(raw.(string))
+		if err != nil {
+			return nil, fmt.Errorf("ids[%d] must be a uuid", i)
+		}
+		ids[i] = id
+		thunks[i] = loadersFrom(p.Context).grades.load(p.Context, id)
+	}
+	return func() (interface{}, error) {
+		students := make([]interface{}, len(ids))
+		for i, thunk := range thunks {
+			_, found, err := thunk()
+			if err != nil {
+				return nil, r.internal("students", err)
+			}
+			if found {
+				students[i] = student{id: ids[i]}
+			}
+		}
+		return students, nil
+	}, nil
+}
+
+func (r resolver) resolveScale(p graphql.ResolveParams) (interface{}, error) {
+	scaleType, err := scaleTypeArg(p.Args, "type")
+	if err != nil {
+		return nil, err
+	}
+	thunk := loadersFrom(p.Context).scales.load(p.Context, scaleType)
+	return func() (interface{}, error) {
+		bands, found, err := thunk()
+		if err != nil {
+			return nil, r.internal("scale", err)
+		}
+		if !found {
+			return nil, nil
+		}
+		return scale{scaleType: scaleType, bands: bands}, nil
+	}, nil
+}
+
+func (r resolver) resolveGradeLetter(p graphql.ResolveParams) (interface{}, error) {
+	grade := p.Source.(domain.Grade)
+	scaleType, err := scaleTypeArg(p.Args, "scaleType")
+	if err != nil {
+		return nil, err
+	}
+	thunk := loadersFrom(p.Context).scales.load(p.Context, scaleType)
+	return func() (interface{}, error) {
+		scales, err := r.loadedScales(scaleType, thunk)
+		if err != nil {
+			return nil, err
+		}
+		return scales.GetGradeGPA(grade)
+	}, nil
+}
+
+func (r resolver) resolveGradeLetters(p graphql.ResolveParams) (interface{}, error) {
+	grade := p.Source.(domain.Grade)
+	rawTypes := p.Args["scaleTypes"].([]interface{})
+	scaleTypes := make([]domain.ScaleType, len(rawTypes))
+	thunks := make([]func() (domain.Scales, bool, error), len(rawTypes))
+	for i, raw := range rawTypes {
+		scaleType := domain.ScaleType(raw.(string))
+		if !scaleType.Valid() {
+			return nil, fmt.Errorf("unknown scale type %q", scaleType)
+		}
+		scaleTypes[i] = scaleType
+		thunks[i] = loadersFrom(p.Context).scales.load(p.Context, scaleType)
+	}
+	return func() (interface{}, error) {
+		letters := make([]scaleLetter, len(scaleTypes))
+		for i, thunk := range thunks {
+			scales, err := r.loadedScales(scaleTypes[i], thunk)
+			if err != nil {
+				return nil, err
+			}
+			letter, err := scales.GetGradeGPA(grade)
+			if err != nil {
+				return nil, fmt.Errorf("scale %s: %w", scaleTypes[i], err)
+			}
+			letters[i] = scaleLetter{scaleType: scaleTypes[i], letter: letter}
+		}
+		return letters, nil
+	}, nil
+}
+
+func (r resolver) resolveStudentGrades(p graphql.ResolveParams) (interface{}, error) {
+	thunk := loadersFrom(p.Context).grades.load(p.Context, p.Source.(student).id)
+	return func() (interface{}, error) {
+		grades, _, err := thunk()
+		if err != nil {
+			return nil, r.internal("grades", err)
+		}
+		if grades == nil {
+			grades = []domain.Grade{}
+		}
+		return grades, nil
+	}, nil
+}
+
+func (r resolver) resolveStudentSummary(p graphql.ResolveParams) (interface{}, error) {
+	thunk := loadersFrom(p.Context).grades.load(p.Context, p.Source.(student).id)
+	return func() (interface{}, error) {
+		grades, _, err := thunk()
+		if err != nil {
+			return nil, r.internal("summary", err)
+		}
+		return domain.SummarizeGrades(grades), nil
+	}, nil
+}
+
+func (r resolver) resolveStudentGPA(p graphql.ResolveParams) (interface{}, error) {
+	id := p.Source.(student).id
+	scaleType, err := scaleTypeArg(p.Args, "scaleType")
+	if err != nil {
+		return nil, err
+	}
+	l := loadersFrom(p.Context)
+	gradesThunk := l.grades.load(p.Context, id)
+	scalesThunk := l.scales.load(p.Context, scaleType)
+	return func() (interface{}, error) {
+		grades, _, err := gradesThunk()
+		if err != nil {
+			return nil, r.internal("gpa", err)
+		}
+		scales, err := r.loadedScales(scaleType, scalesThunk)
+		if err != nil {
+			return nil, err
+		}
+		gpa, err := domain.NewStudentGPA(id, grades, scales)
+		if err != nil {
+			return nil, err
+		}
+		return studentGPA{
+			StudentGPA: gpa,
+			scaleType:  scaleType,
+		}, nil
+	}, nil
+}
+
+// loadedScales resolves a scales thunk, failing if the scale has no band.
+func (r resolver) loadedScales(scaleType domain.ScaleType, thunk func() (domain.Scales, bool, error)) (domain.Scales, error) {
+	scales, found, err := thunk()
+	if err != nil {
+		return nil, r.internal("scales", err)
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: scale type %q", domain.ErrScaleNotFound, scaleType)
+	}
+	return scales, nil
+}
+
+// internal logs an unexpected error and hides its details from the client.
+func (r resolver) internal(field string, err error) error {
+	r.logger.Error("while resolving field", "field", field, "error", err)
+	return errInternal
+}
+
+// scaleTypeArg reads an optional scale type argument, defaulting to the default scale type.
+func scaleTypeArg(args map[string]interface{}, name string) (domain.ScaleType, error) {
+	raw, _ := args[name].(string)
+	if raw == "" {
+		return domain.DefaultScaleType, nil
+	}
+	scaleType := domain.ScaleType(raw)
+	if !scaleType.Valid() {
+		return "", fmt.Errorf("unknown scale type %q", raw)
+	}
+	return scaleType, nil
+}
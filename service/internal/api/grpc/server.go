@@ -0,0 +1,229 @@
+// Package grpc is the gRPC transport of the grading use cases.
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	gradingGRPC "github.com/mnabbasabadi/grading/api/grpc/v1"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 100
+)
+
+var _ gradingGRPC.GradingServiceServer = new(server)
+
+type (
+	server struct {
+		gradingGRPC.UnimplementedGradingServiceServer
+		usecase usecase.Logic
+		logger  *slog.Logger
+	}
+)
+
+// Register registers the grading service on the given gRPC server and marks it as serving.
+func Register(logic usecase.Logic, logger *slog.Logger) func(*grpc.Server, *health.Server) {
+	return func(s *grpc.Server, h *health.Server) {
+		gradingGRPC.RegisterGradingServiceServer(s, &server{
+			usecase: logic,
+			logger:  logger,
+		})
+		h.SetServingStatus(gradingGRPC.GradingService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	}
+}
+
+// ListGrades lists the grades of all students with their GPA letter under one or several scales.
+func (s *server) ListGrades(ctx context.Context, req *gradingGRPC.ListGradesRequest) (*gradingGRPC.ListGradesResponse, error) {
+	limit, offset := int(req.GetLimit()), int(req.GetOffset())
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit < 1 || limit > maxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxLimit)
+	}
+	if offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	var scaleTypes []domain.ScaleType
+	if req.GetScaleType() != "" {
+		scaleTypes = append(scaleTypes, domain.ScaleType(req.GetScaleType()))
+	}
+	for _, scaleType := range req.GetScaleTypes() {
+		scaleTypes = append(scaleTypes, domain.ScaleType(scaleType))
+	}
+	for _, scaleType := range scaleTypes {
+		if _, err := parseScaleType(string(scaleType)); err != nil {
+			return nil, err
+		}
+	}
+
+	grades, total, err := s.usecase.GetGradesByScales(ctx, scaleTypes, limit, offset)
+	if err != nil {
+		return nil, s.toStatus("ListGrades", err)
+	}
+
+	return &gradingGRPC.ListGradesResponse{
+		Grades: toGrades(grades),
+		Pagination: &gradingGRPC.Pagination{
+			Limit:  int32(limit),
+			Offset: int32(offset),
+			Total:  int32(total),
+		},
+	}, nil
+}
+
+// GetStudentGPA returns the grades of a student and the GPA of their average grade under a scale.
+func (s *server) GetStudentGPA(ctx context.Context, req *gradingGRPC.GetStudentGPARequest) (*gradingGRPC.GetStudentGPAResponse, error) {
+	studentID, err := uuid.Parse(req.GetStudentId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "student_id must be a uuid")
+	}
+	scaleType, err := parseScaleType(req.GetScaleType())
+	if err != nil {
+		return nil, err
+	}
+
+	gpa, err := s.usecase.GetStudentGPA(ctx, studentID, scaleType)
+	if err != nil {
+		return nil, s.toStatus("GetStudentGPA", err)
+	}
+
+	return &gradingGRPC.GetStudentGPAResponse{
+		StudentId: gpa.StudentID.String(),
+		Average:   gpa.Average,
+		Gpa:       gpa.GPA,
+		Grades:    toGrades(gpa.Grades),
+	}, nil
+}
+
+// GetScale returns the bands of a scale.
+func (s *server) GetScale(ctx context.Context, req *gradingGRPC.GetScaleRequest) (*gradingGRPC.GetScaleResponse, error) {
+	scaleType, err := parseScaleType(req.GetScaleType())
+	if err != nil {
+		return nil, err
+	}
+
+	scales, err := s.usecase.GetScales(ctx, scaleType)
+	if err != nil {
+		return nil, s.toStatus("GetScale", err)
+	}
+
+	return &gradingGRPC.GetScaleResponse{
+		Scale: toScale(scaleType, scales),
+	}, nil
+}
+
+// SetScale replaces all the bands of a scale.
+func (s *server) SetScale(ctx context.Context, req *gradingGRPC.SetScaleRequest) (*gradingGRPC.SetScaleResponse, error) {
+	if req.GetScaleType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "scale_type is required")
+	}
+	scaleType := domain.ScaleType(req.GetScaleType())
+	scales := make(domain.Scales, 0, len(req.GetBands()))
+	for _, band := range req.GetBands() {
+		scales = append(scales, domain.Scale{
+			Min: int(band.GetMin()),
+			GPA: band.GetGpa(),
+		})
+	}
+
+	stored, err := s.usecase.SetScales(ctx, scaleType, scales)
+	if err != nil {
+		return nil, s.toStatus("SetScale", err)
+	}
+
+	return &gradingGRPC.SetScaleResponse{
+		Scale: toScale(scaleType, stored),
+	}, nil
+}
+
+// DeleteScale removes all the bands of a scale.
+func (s *server) DeleteScale(ctx context.Context, req *gradingGRPC.DeleteScaleRequest) (*gradingGRPC.DeleteScaleResponse, error) {
+	if req.GetScaleType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "scale_type is required")
+	}
+
+	if err := s.usecase.DeleteScales(ctx, domain.ScaleType(req.GetScaleType())); err != nil {
+		return nil, s.toStatus("DeleteScale", err)
+	}
+
+	return &gradingGRPC.DeleteScaleResponse{}, nil
+}
+
+// toStatus maps use case errors to gRPC status errors, hiding the details of unexpected ones.
+func (s *server) toStatus(method string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrScaleNotFound), errors.Is(err, domain.ErrStudentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidScales):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrGradeOutOfScale):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	s.logger.Error("while handling rpc", "method", method, "error", err)
+	return status.Error(codes.Internal, codes.Internal.String())
+}
+
+func parseScaleType(scaleType string) (domain.ScaleType, error) {
+	if scaleType == "" {
+		return "", nil
+	}
+	if !domain.ScaleType(scaleType).Valid() {
+		return "", status.Errorf(codes.InvalidArgument, "unknown scale_type %q", scaleType)
+	}
+	return domain.ScaleType(scaleType), nil
+}
+
+func toGrades(grades []domain.GradeWithGPA) []*gradingGRPC.Grade {
+	out := make([]*gradingGRPC.Grade, 0, len(grades))
+	for _, grade := range grades {
+		g := &gradingGRPC.Grade{
+			CourseId:  grade.CourseID.String(),
+			StudentId: grade.StudentID.String(),
+			Grade:     int32(grade.Grade.Grade),
+			Gpa:       grade.GPA,
+			Type:      string(grade.Type),
+			Label:     grade.Type.Label(),
+		}
+		if len(grade.GPAs) > 1 {
+			g.Gpas = make(map[string]string, len(grade.GPAs))
+			for scaleType, gpa := range grade.GPAs {
+				g.Gpas[string(scaleType)] = gpa
+			}
+		}
+		out = append(out, g)
+	}
+	return out
+}
+
+func toScale(scaleType domain.ScaleType, scales domain.Scales) *gradingGRPC.Scale {
+	if scaleType == "" {
+		scaleType = domain.DefaultScaleType
+	}
+	bands := make([]*gradingGRPC.ScaleBand, 0, len(scales))
+	for _, scale := range scales {
+		bands = append(bands, &gradingGRPC.ScaleBand{
+			Min: int32(scale.Min),
+			Gpa: scale.GPA,
+		})
+	}
+	return &gradingGRPC.Scale{
+		ScaleType: string(scaleType),
+		Bands:     bands,
+	}
+}
@@ -0,0 +1,340 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	gradingGRPC "github.com/mnabbasabadi/grading/api/grpc/v1"
+	kitGRPC "github.com/mnabbasabadi/grading/service/foundation/grpc"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dial starts a gRPC server backed by the given logic on an in-memory listener and returns a connection to it.
+func dial(t *testing.T, logic usecase.Logic) *grpc.ClientConn {
+	t.Helper()
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	listener := bufconn.Listen(1024 * 1024)
+	srv := kitGRPC.NewServer(*logger)
+	srv.Register(Register(logic, logger))
+	srv.Serve(listener, nil)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return conn
+}
+
+func TestServer_ListGrades(t *testing.T) {
+	testCases := map[string]struct {
+		req            *gradingGRPC.ListGradesRequest
+		setMock        func(m *usecase.MockLogic)
+		expectedCode   codes.Code
+		expectedGrades int
+		check          func(t *testing.T, grades []*gradingGRPC.Grade)
+	}{
+		"success": {
+			req: &gradingGRPC.ListGradesRequest{ScaleType: "4.0", Limit: 2},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), []domain.ScaleType{"4.0"}, 2, 0).Return([]domain.GradeWithGPA{
+					{Grade: &domain.Grade{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 25}, GPA: "F"},
+					{Grade: &domain.Grade{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 43}, GPA: "C"},
+				}, 100, nil)
+			},
+			expectedCode:   codes.OK,
+			expectedGrades: 2,
+		},
+		"several scales and grade types": {
+			req: &gradingGRPC.ListGradesRequest{ScaleType: "4.0", ScaleTypes: []string{"ECTS"}},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), []domain.ScaleType{"4.0", "ECTS"}, defaultLimit, 0).Return([]domain.GradeWithGPA{
+					{Grade: &domain.Grade{Grade: 43}, GPA: "C", GPAs: map[domain.ScaleType]string{"4.0": "C", "ECTS": "D"}},
+					{Grade: &domain.Grade{Type: domain.WithdrawnGrade}, GPA: "W", GPAs: map[domain.ScaleType]string{"4.0": "W", "ECTS": "W"}},
+				}, 2, nil)
+			},
+			expectedCode:   codes.OK,
+			expectedGrades: 2,
+			check: func(t *testing.T, grades []*gradingGRPC.Grade) {
+				require.Equal(t, map[string]string{"4.0": "C", "ECTS": "D"}, grades[0].GetGpas())
+				require.Empty(t, grades[0].GetType())
+				require.Equal(t, "withdrawn", grades[1].GetType())
+				require.Equal(t, "W", grades[1].GetLabel())
+				require.Equal(t, "W", grades[1].GetGpas()["ECTS"])
+			},
+		},
+		"default limit": {
+			req: &gradingGRPC.ListGradesRequest{},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), []domain.ScaleType(nil), defaultLimit, 0).Return([]domain.GradeWithGPA{}, 0, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		"limit above maximum": {
+			req:          &gradingGRPC.ListGradesRequest{Limit: 1000},
+			expectedCode: codes.InvalidArgument,
+		},
+		"negative offset": {
+			req:          &gradingGRPC.ListGradesRequest{Offset: -1},
+			expectedCode: codes.InvalidArgument,
+		},
+		"unknown scale type": {
+			req:          &gradingGRPC.ListGradesRequest{ScaleType: "wrong"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"unknown scale type among several": {
+			req:          &gradingGRPC.ListGradesRequest{ScaleTypes: []string{"4.0", "wrong"}},
+			expectedCode: codes.InvalidArgument,
+		},
+		"scale not found": {
+			req: &gradingGRPC.ListGradesRequest{ScaleType: "ECTS"},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, 0, domain.ErrScaleNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		"internal error": {
+			req: &gradingGRPC.ListGradesRequest{},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, 0, errors.New("error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			client := gradingGRPC.NewGradingServiceClient(dial(t, mock))
+
+			resp, err := client.ListGrades(context.Background(), tc.req)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			if err != nil {
+				return
+			}
+			require.Len(t, resp.GetGrades(), tc.expectedGrades)
+			if tc.check != nil {
+				tc.check(t, resp.GetGrades())
+			}
+		})
+	}
+}
+
+func TestServer_GetStudentGPA(t *testing.T) {
+	studentID := uuid.New()
+	testCases := map[string]struct {
+		req          *gradingGRPC.GetStudentGPARequest
+		setMock      func(m *usecase.MockLogic)
+		expectedCode codes.Code
+		expectedGPA  string
+	}{
+		"success": {
+			req: &gradingGRPC.GetStudentGPARequest{StudentId: studentID.String()},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStudentGPA(gomock.Any(), studentID, domain.ScaleType("")).Return(domain.StudentGPA{
+					StudentID: studentID,
+					Average:   2.5,
+					GPA:       "C",
+					Grades: []domain.GradeWithGPA{
+						{Grade: &domain.Grade{StudentID: studentID, CourseID: uuid.New(), Grade: 2}, GPA: "C"},
+						{Grade: &domain.Grade{StudentID: studentID, CourseID: uuid.New(), Grade: 3}, GPA: "B"},
+					},
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedGPA:  "C",
+		},
+		"invalid student id": {
+			req:          &gradingGRPC.GetStudentGPARequest{StudentId: "123"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"student not found": {
+			req: &gradingGRPC.GetStudentGPARequest{StudentId: studentID.String()},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStudentGPA(gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.StudentGPA{}, domain.ErrStudentNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			client := gradingGRPC.NewGradingServiceClient(dial(t, mock))
+
+			resp, err := client.GetStudentGPA(context.Background(), tc.req)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			if err != nil {
+				return
+			}
+			require.Equal(t, tc.expectedGPA, resp.GetGpa())
+			require.Equal(t, studentID.String(), resp.GetStudentId())
+			require.Len(t, resp.GetGrades(), 2)
+		})
+	}
+}
+
+func TestServer_Scales(t *testing.T) {
+	testCases := map[string]struct {
+		call         func(client gradingGRPC.GradingServiceClient) (*gradingGRPC.Scale, error)
+		setMock      func(m *usecase.MockLogic)
+		expectedCode codes.Code
+		expectedMins []int32
+	}{
+		"get scale": {
+			call: func(client gradingGRPC.GradingServiceClient) (*gradingGRPC.Scale, error) {
+				resp, err := client.GetScale(context.Background(), &gradingGRPC.GetScaleRequest{ScaleType: "4.0"})
+				return resp.GetScale(), err
+			},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("4.0")).Return(domain.Scales{
+					{Min: 90, GPA: "A"},
+					{Min: 80, GPA: "B"},
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedMins: []int32{90, 80},
+		},
+		"set scale": {
+			call: func(client gradingGRPC.GradingServiceClient) (*gradingGRPC.Scale, error) {
+				resp, err := client.SetScale(context.Background(), &gradingGRPC.SetScaleRequest{
+					ScaleType: "4.0",
+					Bands: []*gradingGRPC.ScaleBand{
+						{Min: 80, Gpa: "B"},
+						{Min: 90, Gpa: "A"},
+					},
+				})
+				return resp.GetScale(), err
+			},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().SetScales(gomock.Any(), domain.ScaleType("4.0"), domain.Scales{
+					{Min: 80, GPA: "B"},
+					{Min: 90, GPA: "A"},
+				}).Return(domain.Scales{
+					{Min: 90, GPA: "A"},
+					{Min: 80, GPA: "B"},
+				}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedMins: []int32{90, 80},
+		},
+		"set invalid scale": {
+			call: func(client gradingGRPC.GradingServiceClient) (*gradingGRPC.Scale, error) {
+				resp, err := client.SetScale(context.Background(), &gradingGRPC.SetScaleRequest{ScaleType: "4.0"})
+				return resp.GetScale(), err
+			},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().SetScales(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.ErrInvalidScales)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		"delete scale": {
+			call: func(client gradingGRPC.GradingServiceClient) (*gradingGRPC.Scale, error) {
+				_, err := client.DeleteScale(context.Background(), &gradingGRPC.DeleteScaleRequest{ScaleType: "ECTS"})
+				return nil, err
+			},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().DeleteScales(gomock.Any(), domain.ScaleType("ECTS")).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		"delete missing scale": {
+			call: func(client gradingGRPC.GradingServiceClient) (*gradingGRPC.Scale, error) {
+				_, err := client.DeleteScale(context.Background(), &gradingGRPC.DeleteScaleRequest{ScaleType: "ECTS"})
+				return nil, err
+			},
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().DeleteScales(gomock.Any(), gomock.Any()).Return(domain.ErrScaleNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			client := gradingGRPC.NewGradingServiceClient(dial(t, mock))
+
+			scale, err := tc.call(client)
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			if err != nil || tc.expectedMins == nil {
+				return
+			}
+			var mins []int32
+			for _, band := range scale.GetBands() {
+				mins = append(mins, band.GetMin())
+			}
+			require.Equal(t, tc.expectedMins, mins)
+		})
+	}
+}
+
+func TestServer_HealthAndReflection(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	conn := dial(t, usecase.NewMockLogic(ctrl))
+
+	health := healthpb.NewHealthClient(conn)
+	resp, err := health.Check(context.Background(), &healthpb.HealthCheckRequest{
+		Service: gradingGRPC.GradingService_ServiceDesc.ServiceName,
+	})
+	require.NoError(t, err)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	reflected, err := stream.Recv()
+	require.NoError(t, err)
+	var services []string
+	for _, service := range reflected.GetListServicesResponse().GetService() {
+		services = append(services, service.GetName())
+	}
+	require.Contains(t, services, gradingGRPC.GradingService_ServiceDesc.ServiceName)
+	require.NoError(t, stream.CloseSend())
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// ConvertGrade handles HTTP requests to convert a grade or a letter from a scale to another.
+func (s server) ConvertGrade(w http.ResponseWriter, r *http.Request) {
+	var input gradingAPI.ConversionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		s.respondError(w, fmt.Errorf("decoding conversion: %w", err), http.StatusBadRequest)
+		return
+	}
+	conversion := domain.Conversion{
+		From:  domain.ScaleType(input.From),
+		To:    domain.ScaleType(input.To),
+		Grade: input.Grade,
+	}
+	if input.Letter != nil {
+		conversion.Letter = *input.Letter
+	}
+	if input.Method != nil {
+		conversion.Method = domain.ConversionMethod(*input.Method)
+	}
+
+	converted, err := s.usecase.ConvertGrade(r.Context(), conversion)
+	if err != nil {
+		s.logger.Error("while converting grade", "error", err)
+		switch {
+		case errors.Is(err, domain.ErrInvalidConversion):
+			s.respondError(w, err, http.StatusBadRequest)
+		case errors.Is(err, domain.ErrScaleNotFound):
+			s.respondError(w, errors.New("scale not found"), http.StatusBadRequest)
+		default:
+			s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+		}
+		return
+	}
+	s.respond(w, gradingAPI.Conversion{
+		From:       string(converted.From),
+		To:         string(converted.To),
+		Method:     gradingAPI.ConversionMethod(converted.Method),
+		FromGrade:  converted.FromGrade,
+		FromLetter: converted.FromLetter,
+		Grade:      converted.Grade,
+		Letter:     converted.Letter,
+	}, http.StatusOK)
+}
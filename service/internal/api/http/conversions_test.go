@@ -0,0 +1,97 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestNewHandler_ConvertGrade(t *testing.T) {
+	converted := domain.ConvertedGrade{From: "ECTS", To: "4.0", Method: domain.ConvertByTable, FromGrade: 80, FromLetter: "B", Grade: 3, Letter: "B"}
+	testCases := map[string]struct {
+		body               string
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+	}{
+		"letter": {
+			body: `{"from": "ECTS", "to": "4.0", "letter": "B"}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ConvertGrade(gomock.Any(), domain.Conversion{From: "ECTS", To: "4.0", Letter: "B"}).Return(converted, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"grade with method": {
+			body: `{"from": "ECTS", "to": "4.0", "grade": 85, "method": "table"}`,
+			setMock: func(m *usecase.MockLogic) {
+				grade := 85
+				m.EXPECT().ConvertGrade(gomock.Any(), domain.Conversion{From: "ECTS", To: "4.0", Grade: &grade, Method: domain.ConvertByTable}).
+					Return(converted, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"unknown method": {
+			body:               `{"from": "ECTS", "to": "4.0", "grade": 85, "method": "zscore"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"invalid conversion": {
+			body: `{"from": "ECTS", "to": "4.0", "letter": "FX"}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ConvertGrade(gomock.Any(), gomock.Any()).
+					Return(domain.ConvertedGrade{}, fmt.Errorf("%w: no letter %q in scale ECTS", domain.ErrInvalidConversion, "FX"))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"scale not found": {
+			body: `{"from": "ECTS", "to": "4.0", "letter": "B"}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ConvertGrade(gomock.Any(), gomock.Any()).Return(domain.ConvertedGrade{}, fmt.Errorf("fetching scales failed: %w", domain.ErrScaleNotFound))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"internal error": {
+			body: `{"from": "ECTS", "to": "4.0", "letter": "B"}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ConvertGrade(gomock.Any(), gomock.Any()).Return(domain.ConvertedGrade{}, errors.New("error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			h := NewHandler(mock, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+			req := httptest.NewRequest(http.MethodPost, "/conversions", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code, w.Body.String())
+
+			if w.Code == http.StatusOK {
+				var response gradingAPI.Conversion
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, gradingAPI.Table, response.Method)
+				require.Equal(t, 3, response.Grade)
+				require.Equal(t, "B", response.Letter)
+			}
+		})
+	}
+}
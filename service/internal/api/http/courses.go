@@ -0,0 +1,52 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// GetCourseStats handles HTTP requests to get how a course went.
+func (s server) GetCourseStats(w http.ResponseWriter, r *http.Request, courseID gradingAPI.CourseID, params gradingAPI.GetCourseStatsParams) {
+	var scaleType domain.ScaleType
+	if params.ScaleType != nil {
+		scaleType = domain.ScaleType(*params.ScaleType)
+	}
+	stats, err := s.usecase.GetCourseStats(r.Context(), courseID, scaleType)
+	if err != nil {
+		s.logger.Error("while getting course stats", "error", err)
+		switch {
+		case errors.Is(err, domain.ErrScaleNotFound):
+			s.respondError(w, errors.New("scale not found"), http.StatusBadRequest)
+		case errors.Is(err, domain.ErrCourseNotFound):
+			s.respondError(w, err, http.StatusNotFound)
+		case errors.Is(err, domain.ErrGradeOutOfScale):
+			s.respondError(w, err, http.StatusUnprocessableEntity)
+		default:
+			s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := gradingAPI.CourseStats{
+		CourseId:  stats.CourseID,
+		ScaleType: string(stats.ScaleType),
+		Count:     stats.Count,
+		Mean:      stats.Mean,
+		Median:    stats.Median,
+		StdDev:    stats.StdDev,
+		Min:       stats.Min,
+		Max:       stats.Max,
+		Histogram: make([]gradingAPI.LetterCount, len(stats.Histogram)),
+	}
+	response.Percentiles.P10 = stats.Percentiles.P10
+	response.Percentiles.P25 = stats.Percentiles.P25
+	response.Percentiles.P75 = stats.Percentiles.P75
+	response.Percentiles.P90 = stats.Percentiles.P90
+	for i, bar := range stats.Histogram {
+		response.Histogram[i] = gradingAPI.LetterCount{Gpa: bar.GPA, Count: bar.Count}
+	}
+	s.respond(w, response, http.StatusOK)
+}
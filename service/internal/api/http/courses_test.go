@@ -0,0 +1,97 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestNewHandler_GetCourseStats(t *testing.T) {
+	courseID := uuid.New()
+	path := "/courses/" + courseID.String() + "/stats"
+	stats, err := domain.NewCourseStats(courseID, "4.0",
+		domain.NewGradeStats([]domain.GradeCount{{Grade: 1, Count: 1}, {Grade: 4, Count: 3}}),
+		domain.Scales{{Min: 3, GPA: "B"}, {Min: 0, GPA: "F"}})
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		path               string
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+	}{
+		"success": {
+			path: path + "?scale_type=4.0",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID, domain.ScaleType("4.0")).Return(stats, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"scale not found": {
+			path: path + "?scale_type=100",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID, domain.ScaleType("100")).
+					Return(domain.CourseStats{}, fmt.Errorf("fetching scales failed: %w", domain.ErrScaleNotFound))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"course not found": {
+			path: path,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID, domain.ScaleType("")).Return(domain.CourseStats{}, domain.ErrCourseNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"grade out of scale": {
+			path: path,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID, domain.ScaleType("")).
+					Return(domain.CourseStats{}, fmt.Errorf("scale default: %w: grade 9", domain.ErrGradeOutOfScale))
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
+		"internal error": {
+			path: path,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID, domain.ScaleType("")).Return(domain.CourseStats{}, errors.New("error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			h := NewHandler(mock, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code, w.Body.String())
+
+			if w.Code == http.StatusOK {
+				var response gradingAPI.CourseStats
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, 4, response.Count)
+				require.Equal(t, 3.25, response.Mean)
+				require.Equal(t, 4.0, response.Median)
+				require.Equal(t, []gradingAPI.LetterCount{{Gpa: "B", Count: 3}, {Gpa: "F", Count: 1}}, response.Histogram)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/invopop/yaml"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+)
+
+// docsAssets holds the API explorer page. Everything it needs is embedded, so it works without access to a CDN.
+//
+//go:embed docs
+var docsAssets embed.FS
+
+type (
+	// specDocument is the embedded OpenAPI document, rendered once in both formats it is served in.
+	specDocument struct {
+		json []byte
+		yaml []byte
+	}
+)
+
+func newSpecDocument() (specDocument, error) {
+	swagger, err := gradingAPI.GetSwagger()
+	if err != nil {
+		return specDocument{}, fmt.Errorf("loading embedded OpenAPI spec: %w", err)
+	}
+	jsonDoc, err := json.Marshal(swagger)
+	if err != nil {
+		return specDocument{}, fmt.Errorf("marshalling OpenAPI spec to json: %w", err)
+	}
+	yamlDoc, err := yaml.JSONToYAML(jsonDoc)
+	if err != nil {
+		return specDocument{}, fmt.Errorf("marshalling OpenAPI spec to yaml: %w", err)
+	}
+	return specDocument{
+		json: jsonDoc,
+		yaml: yamlDoc,
+	}, nil
+}
+
+// mountDocs registers the OpenAPI document and the API explorer on the given router.
+// These routes are not part of the spec itself, so they are not subject to request validation.
+func (s server) mountDocs(r chi.Router) {
+	doc, err := newSpecDocument()
+	if err != nil {
+		panic(err)
+	}
+	assets, err := fs.Sub(docsAssets, "docs")
+	if err != nil {
+		panic(fmt.Errorf("loading API explorer assets: %w", err))
+	}
+
+	r.Get("/openapi.json", s.serveSpec(doc.json, "application/json; charset=utf-8"))
+	r.Get("/openapi.yaml", s.serveSpec(doc.yaml, "application/yaml; charset=utf-8"))
+	r.Get("/docs", http.RedirectHandler("/docs/", http.StatusMovedPermanently).ServeHTTP)
+	r.Handle("/docs/*", http.StripPrefix("/docs/", http.FileServer(http.FS(assets))))
+}
+
+func (s server) serveSpec(body []byte, contentType string) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(http.StatusOK)
+		if _, err := w.Write(body); err != nil {
+			s.logger.With(err).Error("while responding")
+		}
+	}
+}
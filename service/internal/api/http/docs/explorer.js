@@ -0,0 +1,209 @@
+// A minimal, dependency free explorer for the service's OpenAPI document.
+// It lists every operation, describes its parameters and lets the reader send requests to the running service.
+(function () {
+  "use strict";
+
+  var specURL = "../openapi.json";
+  var methods = ["get", "post", "put", "patch", "delete"];
+
+  function el(tag, attrs, children) {
+    var node = document.createElement(tag);
+    Object.keys(attrs || {}).forEach(function (key) {
+      if (key === "text") {
+        node.textContent = attrs[key];
+      } else {
+        node.setAttribute(key, attrs[key]);
+      }
+    });
+    (children || []).forEach(function (child) {
+      if (child) {
+        node.appendChild(child);
+      }
+    });
+    return node;
+  }
+
+  // resolve follows a local "#/components/..." reference.
+  function resolve(spec, obj) {
+    if (!obj || !obj.$ref) {
+      return obj;
+    }
+    return obj.$ref.replace(/^#\//, "").split("/").reduce(function (acc, part) {
+      return acc ? acc[part] : undefined;
+    }, spec);
+  }
+
+  function parameterInput(param) {
+    var schema = param.schema || {};
+    if (schema.enum) {
+      var select = el("select", {name: param.name});
+      select.appendChild(el("option", {value: "", text: ""}));
+      schema.enum.forEach(function (value) {
+        select.appendChild(el("option", {value: String(value), text: String(value)}));
+      });
+      return select;
+    }
+    var input = el("input", {name: param.name, type: "text"});
+    if (schema.example !== undefined) {
+      input.placeholder = String(schema.example);
+    }
+    return input;
+  }
+
+  function describeSchema(schema) {
+    if (!schema) {
+      return "";
+    }
+    var parts = [schema.type || "object"];
+    if (schema.minimum !== undefined) {
+      parts.push("min " + schema.minimum);
+    }
+    if (schema.maximum !== undefined) {
+      parts.push("max " + schema.maximum);
+    }
+    if (schema.default !== undefined) {
+      parts.push("default " + schema.default);
+    }
+    return parts.join(", ");
+  }
+
+  function requestBodyExample(spec, operation) {
+    var body = resolve(spec, operation.requestBody);
+    if (!body || !body.content || !body.content["application/json"]) {
+      return null;
+    }
+    var schema = resolve(spec, body.content["application/json"].schema) || {};
+    return JSON.stringify(schema.example || {}, null, 2);
+  }
+
+  function send(path, method, form, output) {
+    var query = new URLSearchParams();
+    var headers = {};
+    var url = path;
+    form.querySelectorAll("[data-in]").forEach(function (field) {
+      if (field.value === "") {
+        return;
+      }
+      switch (field.getAttribute("data-in")) {
+        case "path":
+          url = url.replace("{" + field.name + "}", encodeURIComponent(field.value));
+          break;
+        case "query":
+          query.append(field.name, field.value);
+          break;
+        case "header":
+          headers[field.name] = field.value;
+          break;
+      }
+    });
+    var qs = query.toString();
+    var init = {method: method.toUpperCase(), headers: headers};
+    var body = form.querySelector("textarea[name=body]");
+    if (body && body.value.trim() !== "") {
+      init.body = body.value;
+      init.headers["Content-Type"] = "application/json";
+    }
+
+    output.textContent = "…";
+    fetch(".." + url + (qs ? "?" + qs : ""), init).then(function (resp) {
+      return resp.text().then(function (text) {
+        var pretty = text;
+        try {
+          pretty = JSON.stringify(JSON.parse(text), null, 2);
+        } catch (e) {
+          // not json, show as is
+        }
+        output.textContent = resp.status + " " + resp.statusText + "\n\n" + pretty;
+      });
+    }).catch(function (err) {
+      output.textContent = String(err);
+    });
+  }
+
+  function renderOperation(spec, path, method, operation) {
+    var params = (operation.parameters || []).map(function (p) {
+      return resolve(spec, p);
+    });
+    var form = el("form");
+    var rows = params.map(function (param) {
+      var input = parameterInput(param);
+      input.setAttribute("data-in", param.in);
+      return el("tr", {}, [
+        el("td", {}, [el("code", {text: param.name + (param.required ? " *" : "")})]),
+        el("td", {class: "muted", text: param.in + " · " + describeSchema(param.schema)}),
+        el("td", {text: param.description || ""}),
+        el("td", {}, [input])
+      ]);
+    });
+    if (rows.length) {
+      form.appendChild(el("table", {}, rows));
+    }
+    var example = requestBodyExample(spec, operation);
+    if (example !== null) {
+      var textarea = el("textarea", {name: "body"});
+      textarea.value = example;
+      form.appendChild(textarea);
+    }
+    var output = el("pre", {class: "response", hidden: "hidden"});
+    var button = el("button", {type: "submit", text: "Send request"});
+    form.appendChild(button);
+    form.addEventListener("submit", function (event) {
+      event.preventDefault();
+      output.removeAttribute("hidden");
+      send(path, method, form, output);
+    });
+
+    var responses = Object.keys(operation.responses || {}).map(function (code) {
+      var response = resolve(spec, operation.responses[code]) || {};
+      return el("tr", {}, [
+        el("td", {}, [el("code", {text: code})]),
+        el("td", {text: response.description || ""})
+      ]);
+    });
+
+    return el("details", {class: "operation"}, [
+      el("summary", {}, [
+        el("span", {class: "method " + method, text: method}),
+        el("span", {text: path + "  "}),
+        el("span", {class: "muted", text: operation.summary || ""})
+      ]),
+      el("div", {class: "body"}, [
+        el("p", {text: operation.description || ""}),
+        form,
+        el("table", {}, responses),
+        output
+      ])
+    ]);
+  }
+
+  function render(spec) {
+    document.getElementById("title").textContent = spec.info.title + " " + spec.info.version;
+    document.getElementById("description").textContent = spec.info.description || "";
+    var main = document.getElementById("operations");
+    main.textContent = "";
+
+    var byTag = {};
+    Object.keys(spec.paths).sort().forEach(function (path) {
+      methods.forEach(function (method) {
+        var operation = spec.paths[path][method];
+        if (!operation) {
+          return;
+        }
+        var tag = (operation.tags && operation.tags[0]) || "default";
+        (byTag[tag] = byTag[tag] || []).push(renderOperation(spec, path, method, operation));
+      });
+    });
+    Object.keys(byTag).sort().forEach(function (tag) {
+      main.appendChild(el("h2", {class: "tag", text: tag}));
+      byTag[tag].forEach(function (node) {
+        main.appendChild(node);
+      });
+    });
+  }
+
+  fetch(specURL).then(function (resp) {
+    return resp.json();
+  }).then(render).catch(function (err) {
+    document.getElementById("operations").textContent = "failed to load specification: " + err;
+  });
+})();
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/invopop/yaml"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestNewHandler_Docs(t *testing.T) {
+	testCases := map[string]struct {
+		path                string
+		expectedStatusCode  int
+		expectedContentType string
+		check               func(t *testing.T, body []byte)
+	}{
+		"openapi json": {
+			path:                "/openapi.json",
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "application/json",
+			check: func(t *testing.T, body []byte) {
+				var doc map[string]any
+				require.NoError(t, json.Unmarshal(body, &doc))
+				require.Equal(t, "3.0.3", doc["openapi"])
+				require.Contains(t, doc["paths"], "/students/gpa")
+			},
+		},
+		"openapi yaml": {
+			path:                "/openapi.yaml",
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "application/yaml",
+			check: func(t *testing.T, body []byte) {
+				var doc map[string]any
+				require.NoError(t, yaml.Unmarshal(body, &doc))
+				require.Contains(t, doc["paths"], "/students/gpa")
+			},
+		},
+		"docs redirect": {
+			path:               "/docs",
+			expectedStatusCode: http.StatusMovedPermanently,
+		},
+		"docs page": {
+			path:                "/docs/",
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "text/html",
+			check: func(t *testing.T, body []byte) {
+				require.Contains(t, string(body), "explorer.js")
+				require.NotContains(t, string(body), "https://")
+			},
+		},
+		"docs asset": {
+			path:                "/docs/explorer.js",
+			expectedStatusCode:  http.StatusOK,
+			expectedContentType: "javascript",
+		},
+		"missing asset": {
+			path:               "/docs/missing.js",
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			h := NewHandler(usecase.NewMockLogic(ctrl), logger)
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+			if tc.expectedContentType != "" {
+				require.True(t, strings.Contains(w.Header().Get("Content-Type"), tc.expectedContentType), w.Header().Get("Content-Type"))
+			}
+			if tc.check != nil {
+				tc.check(t, w.Body.Bytes())
+			}
+		})
+	}
+}
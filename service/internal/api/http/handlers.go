@@ -2,10 +2,12 @@
 package http
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 
+	oapiMiddleware "github.com/deepmap/oapi-codegen/pkg/chi-middleware"
 	"github.com/go-chi/chi/v5"
 	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
 	"github.com/mnabbasabadi/grading/service/shared/domain"
@@ -44,27 +46,29 @@ func (s server) GetReadiness(w http.ResponseWriter, _ *http.Request) {
 
 // GetGPA handles HTTP requests to get grades and calculate GPAs.
 func (s server) GetGPA(w http.ResponseWriter, r *http.Request, params gradingAPI.GetGPAParams) {
-	scaleType, limit, offset := s.parseParams(params)
+	scaleTypes, limit, offset := s.parseParams(params)
 
-	grades, total, err := s.usecase.GetGrades(r.Context(), scaleType, limit, offset)
+	grades, total, err := s.usecase.GetGradesByScales(r.Context(), scaleTypes, limit, offset)
 	if err != nil {
 		s.handleGradesError(w, err)
 		return
 	}
 
-	response := s.prepareGradeResponse(grades, total, limit, offset)
+	response := s.prepareGradeResponse(grades, len(scaleTypes) > 0, total, limit, offset)
 
 	s.respond(w, response, http.StatusOK)
 }
 
-func (s server) parseParams(params gradingAPI.GetGPAParams) (domain.ScaleType, int, int) {
+func (s server) parseParams(params gradingAPI.GetGPAParams) ([]domain.ScaleType, int, int) {
 	var (
-		scaleType domain.ScaleType
-		limit     int
-		offset    int
+		scaleTypes []domain.ScaleType
+		limit      int
+		offset     int
 	)
 	if params.ScaleType != nil {
-		scaleType = domain.ScaleType(*params.ScaleType)
+		for _, scaleType := range *params.ScaleType {
+			scaleTypes = append(scaleTypes, domain.ScaleType(scaleType))
+		}
 	}
 	if params.Limit != nil {
 		limit = *params.Limit
@@ -75,27 +79,48 @@ func (s server) parseParams(params gradingAPI.GetGPAParams) (domain.ScaleType, i
 	if params.Offset != nil {
 		offset = *params.Offset
 	}
-	return scaleType, limit, offset
+	return scaleTypes, limit, offset
 }
 
 func (s server) handleGradesError(w http.ResponseWriter, err error) {
 	s.logger.Error("while getting grades", "error", err)
-	if err == domain.ErrScaleNotFound {
+	switch {
+	case errors.Is(err, domain.ErrScaleNotFound):
 		s.respondError(w, err, http.StatusBadRequest)
-	} else {
+	case errors.Is(err, domain.ErrGradeOutOfScale):
+		s.respondError(w, err, http.StatusUnprocessableEntity)
+	default:
 		s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
 	}
 }
 
-func (s server) prepareGradeResponse(grades []domain.GradeWithGPA, total, limit, offset int) gradingAPI.GradeList {
+// prepareGradeResponse lists the grades, with their GPA under every scale type asked for when any was.
+func (s server) prepareGradeResponse(grades []domain.GradeWithGPA, withGPAs bool, total, limit,
+	offset int) gradingAPI.GradeList {
 	var response gradingAPI.GradeList
 	for _, grade := range grades {
-		response.Grades = append(response.Grades, gradingAPI.Grade{
+		g := gradingAPI.Grade{
 			CourseId:  grade.CourseID.String(),
 			StudentId: grade.StudentID.String(),
 			Grade:     fmt.Sprintf("%d", grade.Grade.Grade),
 			Gpa:       grade.GPA,
-		})
+		}
+		if !grade.Type.Counted() {
+			gradeType := gradingAPI.GradeType(grade.Type)
+			g.Grade, g.Type = grade.Type.Label(), &gradeType
+		}
+		if withGPAs {
+			gpas := make(map[string]gradingAPI.ScaleGPA, len(grade.GPAs))
+			for scaleType, gpa := range grade.GPAs {
+				scaleGPA := gradingAPI.ScaleGPA{Letter: gpa}
+				if points, ok := domain.GPAPoints(gpa); ok && grade.Type.Counted() {
+					scaleGPA.Points = &points
+				}
+				gpas[string(scaleType)] = scaleGPA
+			}
+			g.Gpas = &gpas
+		}
+		response.Grades = append(response.Grades, g)
 	}
 	response.Pagination = &gradingAPI.Pagination{
 		Total:  total,
@@ -105,6 +130,47 @@ func (s server) prepareGradeResponse(grades []domain.GradeWithGPA, total, limit,
 	return response
 }
 
+// PutGrade handles HTTP requests to record the grade of a student in a course.
+func (s server) PutGrade(w http.ResponseWriter, r *http.Request, studentID gradingAPI.StudentID, courseID gradingAPI.CourseID) {
+	var input gradingAPI.GradeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		s.respondError(w, fmt.Errorf("decoding grade: %w", err), http.StatusBadRequest)
+		return
+	}
+	grade := domain.Grade{StudentID: studentID, CourseID: courseID, Grade: input.Grade}
+	if input.Term != nil {
+		grade.Term = *input.Term
+	}
+	if input.Credits != nil {
+		grade.Credits = *input.Credits
+	}
+	if input.Type != nil {
+		grade.Type = domain.GradeType(*input.Type)
+	}
+	event, err := s.usecase.PostGrade(r.Context(), grade)
+	if err != nil {
+		s.logger.Error("while posting grade", "error", err)
+		if errors.Is(err, domain.ErrInvalidGrade) {
+			s.respondError(w, err, http.StatusBadRequest)
+		} else {
+			s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := gradingAPI.Event{
+		Id:         event.ID,
+		Type:       gradingAPI.EventType(event.Type),
+		OccurredAt: event.OccurredAt,
+	}
+	if err := json.Unmarshal(event.Payload, &response.Payload); err != nil {
+		s.logger.Error("while decoding event payload", "error", err)
+		s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+		return
+	}
+	s.respond(w, response, http.StatusOK)
+}
+
 // NewHandler returns a new http.Handler that implements the ServerInterface
 func NewHandler(logic usecase.Logic, logger *slog.Logger) http.Handler {
 	s := server{
@@ -112,8 +178,14 @@ func NewHandler(logic usecase.Logic, logger *slog.Logger) http.Handler {
 		logger:  logger,
 	}
 
+	r := chi.NewRouter()
+	s.mountDocs(r)
+
 	options := gradingAPI.ChiServerOptions{
-		BaseRouter: chi.NewRouter(),
+		BaseRouter: r,
+		Middlewares: []gradingAPI.MiddlewareFunc{
+			s.requestValidator(),
+		},
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 			logger.With(err).Error("error")
 			s.respondError(w, err, http.StatusBadRequest)
@@ -123,6 +195,26 @@ func NewHandler(logic usecase.Logic, logger *slog.Logger) http.Handler {
 	return gradingAPI.HandlerWithOptions(s, options)
 
 }
+
+// requestValidator returns a middleware that validates every request against the embedded OpenAPI spec,
+// so that the constraints declared there (ranges, enums, required fields) are enforced before reaching a handler.
+func (s server) requestValidator() gradingAPI.MiddlewareFunc {
+	swagger, err := gradingAPI.GetSwagger()
+	if err != nil {
+		panic(fmt.Errorf("loading embedded OpenAPI spec: %w", err))
+	}
+	// the servers block describes where the API is hosted, not what requests look like;
+	// keeping it would make the validator match on the Host header.
+	swagger.Servers = nil
+
+	return oapiMiddleware.OapiRequestValidatorWithOptions(swagger, &oapiMiddleware.Options{
+		ErrorHandler: func(w http.ResponseWriter, message string, statusCode int) {
+			s.logger.Debug("request validation failed", "error", message)
+			s.respondError(w, errors.New(message), statusCode)
+		},
+	})
+}
+
 func (s server) respond(w http.ResponseWriter, data any, statusCode int) {
 	err := respond(w, data, statusCode)
 	if err != nil {
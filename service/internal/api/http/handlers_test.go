@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -23,12 +24,15 @@ func TestServer_GetGPA(t *testing.T) {
 		scaleType          domain.ScaleType
 		setMock            func(m *usecase.MockLogic)
 		expectedStatusCode int
+		withoutScaleTypes  bool
 		expectedGPAs       int
+		expectedScaleGPAs  map[string]gradingAPI.ScaleGPA
+		expectedType       gradingAPI.GradeType
 	}{
 		"success": {
 			scaleType: domain.ScaleType("4.0"),
 			setMock: func(m *usecase.MockLogic) {
-				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{
 					{
 						Grade: &domain.Grade{
 							StudentID: uuid.New(),
@@ -58,17 +62,96 @@ func TestServer_GetGPA(t *testing.T) {
 			expectedGPAs:       3,
 			expectedStatusCode: http.StatusOK,
 		},
+		"several scales": {
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{
+					{
+						Grade: &domain.Grade{
+							StudentID: uuid.New(),
+							CourseID:  uuid.New(),
+							Grade:     85,
+						},
+						GPA:  "A",
+						GPAs: map[domain.ScaleType]string{"4.0": "A", "ECTS": "B"},
+					},
+				}, 100, nil)
+			},
+			expectedGPAs:       1,
+			expectedScaleGPAs:  map[string]gradingAPI.ScaleGPA{"4.0": {Letter: "A"}, "ECTS": {Letter: "B"}},
+			expectedStatusCode: http.StatusOK,
+		},
+		"single scale of points": {
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{
+					{
+						Grade: &domain.Grade{
+							StudentID: uuid.New(),
+							CourseID:  uuid.New(),
+							Grade:     85,
+						},
+						GPA:  "3.7",
+						GPAs: map[domain.ScaleType]string{"ECTS": "3.7"},
+					},
+				}, 100, nil)
+			},
+			expectedGPAs:       1,
+			expectedScaleGPAs:  map[string]gradingAPI.ScaleGPA{"ECTS": {Letter: "3.7", Points: ptr(3.7)}},
+			expectedStatusCode: http.StatusOK,
+		},
+		"no scale asked for": {
+			withoutScaleTypes: true,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{
+					{
+						Grade: &domain.Grade{
+							StudentID: uuid.New(),
+							CourseID:  uuid.New(),
+							Grade:     85,
+						},
+						GPA:  "A",
+						GPAs: map[domain.ScaleType]string{domain.DefaultScaleType: "A"},
+					},
+				}, 100, nil)
+			},
+			expectedGPAs:       1,
+			expectedStatusCode: http.StatusOK,
+		},
+		"grade type": {
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{
+					{
+						Grade: &domain.Grade{
+							StudentID: uuid.New(),
+							CourseID:  uuid.New(),
+							Type:      domain.WithdrawnGrade,
+						},
+						GPA:  "W",
+						GPAs: map[domain.ScaleType]string{"ECTS": "W"},
+					},
+				}, 100, nil)
+			},
+			expectedGPAs:       1,
+			expectedScaleGPAs:  map[string]gradingAPI.ScaleGPA{"ECTS": {Letter: "W"}},
+			expectedType:       gradingAPI.Withdrawn,
+			expectedStatusCode: http.StatusOK,
+		},
 		"failed to return logic- wrong scale type": {
 			scaleType: domain.ScaleType("wrong"),
 			setMock: func(m *usecase.MockLogic) {
-				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{}, 0, domain.ErrScaleNotFound)
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{}, 0, domain.ErrScaleNotFound)
 			},
 			expectedStatusCode: http.StatusBadRequest,
 		},
+		"failed to return logic- grade out of scale": {
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, 0, domain.ErrGradeOutOfScale)
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+		},
 		"failed to return logic- internal error": {
 			scaleType: domain.ScaleType("wrong"),
 			setMock: func(m *usecase.MockLogic) {
-				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{}, 0, errors.New("error"))
+				m.EXPECT().GetGradesByScales(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.GradeWithGPA{}, 0, errors.New("error"))
 			},
 			expectedStatusCode: http.StatusInternalServerError,
 		},
@@ -89,11 +172,14 @@ func TestServer_GetGPA(t *testing.T) {
 			}
 			req := httptest.NewRequest(http.MethodGet, "/students/gpa", nil)
 			w := httptest.NewRecorder()
-			ects := gradingAPI.GetGPAParamsScaleTypeECTS
+			ects := &gradingAPI.ScaleTypes{gradingAPI.ECTS}
+			if tc.withoutScaleTypes {
+				ects = nil
+			}
 			limit := 10
 			offset := 0
 			s.GetGPA(w, req, gradingAPI.GetGPAParams{
-				ScaleType: &ects,
+				ScaleType: ects,
 				Limit:     &limit,
 				Offset:    &offset,
 			})
@@ -113,7 +199,193 @@ func TestServer_GetGPA(t *testing.T) {
 				require.Equal(t, 10, responseBody.Pagination.Limit)
 				require.Equal(t, 0, responseBody.Pagination.Offset)
 				require.Equal(t, 100, responseBody.Pagination.Total)
+				if tc.expectedScaleGPAs != nil {
+					require.Equal(t, tc.expectedScaleGPAs, *responseBody.Grades[0].Gpas)
+				}
+				if tc.withoutScaleTypes {
+					require.Nil(t, responseBody.Grades[0].Gpas)
+				} else {
+					require.NotNil(t, responseBody.Grades[0].Gpas, "the GPAs must be given for a single scale too")
+				}
+				if tc.expectedType != "" {
+					require.Equal(t, tc.expectedType, *responseBody.Grades[0].Type)
+					require.Equal(t, "W", responseBody.Grades[0].Grade, "a grade with a type must be shown with its label")
+					require.Equal(t, "W", responseBody.Grades[0].Gpa)
+				} else {
+					require.Nil(t, responseBody.Grades[0].Type)
+				}
+
+			}
+		})
+	}
+}
 
+func TestNewHandler_RequestValidation(t *testing.T) {
+	testCases := map[string]struct {
+		query              string
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+	}{
+		"valid params": {
+			query: "scale_type=4.0&limit=100&offset=0",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), []domain.ScaleType{"4.0"}, 100, 0).Return([]domain.GradeWithGPA{}, 0, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"repeated scale type": {
+			query: "scale_type=4.0&scale_type=ECTS",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetGradesByScales(gomock.Any(), []domain.ScaleType{"4.0", "ECTS"}, defaultLimit, 0).Return([]domain.GradeWithGPA{}, 0, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"limit above maximum": {
+			query:              "limit=1000000",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"limit below minimum": {
+			query:              "limit=0",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"negative offset": {
+			query:              "offset=-1",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"unknown scale type": {
+			query:              "scale_type=invalid",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			h := NewHandler(mock, logger)
+
+			req := httptest.NewRequest(http.MethodGet, "/students/gpa?"+tc.query, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+
+			if w.Code != http.StatusOK {
+				var responseBody gradingAPI.ResponseError
+				err := json.Unmarshal(w.Body.Bytes(), &responseBody)
+				require.NoError(t, err)
+				require.NotNil(t, responseBody.Error)
+			}
+		})
+	}
+}
+
+func TestNewHandler_PutGrade(t *testing.T) {
+	studentID, courseID := uuid.New(), uuid.New()
+	testCases := map[string]struct {
+		path               string
+		body               string
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+		expectedType       string
+	}{
+		"success": {
+			path: "/students/" + studentID.String() + "/courses/" + courseID.String() + "/grade",
+			body: `{"grade": 3}`,
+			setMock: func(m *usecase.MockLogic) {
+				grade := domain.Grade{StudentID: studentID, CourseID: courseID, Grade: 3}
+				event, err := domain.NewGradeChanged(domain.Grade{Grade: 2}, grade)
+				require.NoError(t, err)
+				m.EXPECT().PostGrade(gomock.Any(), grade).Return(event, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"with term and credits": {
+			path: "/students/" + studentID.String() + "/courses/" + courseID.String() + "/grade",
+			body: `{"grade": 3, "term": "2024-2", "credits": 4}`,
+			setMock: func(m *usecase.MockLogic) {
+				grade := domain.Grade{StudentID: studentID, CourseID: courseID, Grade: 3, Term: "2024-2", Credits: 4}
+				event, err := domain.NewGradeChanged(domain.Grade{Grade: 2}, grade)
+				require.NoError(t, err)
+				m.EXPECT().PostGrade(gomock.Any(), grade).Return(event, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"with a type": {
+			path: "/students/" + studentID.String() + "/courses/" + courseID.String() + "/grade",
+			body: `{"grade": 0, "type": "withdrawn"}`,
+			setMock: func(m *usecase.MockLogic) {
+				grade := domain.Grade{StudentID: studentID, CourseID: courseID, Type: domain.WithdrawnGrade}
+				event, err := domain.NewGradeChanged(domain.Grade{Grade: 2}, grade)
+				require.NoError(t, err)
+				m.EXPECT().PostGrade(gomock.Any(), grade).Return(event, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedType:       "withdrawn",
+		},
+		"unknown type": {
+			path:               "/students/" + studentID.String() + "/courses/" + courseID.String() + "/grade",
+			body:               `{"grade": 0, "type": "expelled"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"negative credits": {
+			path:               "/students/" + studentID.String() + "/courses/" + courseID.String() + "/grade",
+			body:               `{"grade": 3, "credits": -1}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"negative grade": {
+			path:               "/students/" + studentID.String() + "/courses/" + courseID.String() + "/grade",
+			body:               `{"grade": -1}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"invalid student id": {
+			path:               "/students/alice/courses/" + courseID.String() + "/grade",
+			body:               `{"grade": 3}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"internal error": {
+			path: "/students/" + studentID.String() + "/courses/" + courseID.String() + "/grade",
+			body: `{"grade": 3}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().PostGrade(gomock.Any(), gomock.Any()).Return(domain.Event{}, errors.New("error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			h := NewHandler(mock, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+			req := httptest.NewRequest(http.MethodPut, tc.path, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code)
+
+			if w.Code == http.StatusOK {
+				var event gradingAPI.Event
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &event))
+				require.Equal(t, gradingAPI.GradeChanged, event.Type)
+				require.EqualValues(t, 2, event.Payload["previous"])
+				if tc.expectedType != "" {
+					require.EqualValues(t, 0, event.Payload["grade"])
+					require.Equal(t, tc.expectedType, event.Payload["type"])
+					return
+				}
+				require.EqualValues(t, 3, event.Payload["grade"])
 			}
 		})
 	}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// GetClassRank handles HTTP requests to rank a student among every graded student.
+func (s server) GetClassRank(w http.ResponseWriter, r *http.Request, studentID gradingAPI.StudentID, params gradingAPI.GetClassRankParams) {
+	s.getRank(w, r, studentID, uuid.Nil, params.Ties)
+}
+
+// GetCourseRank handles HTTP requests to rank a student among the students graded in a course.
+func (s server) GetCourseRank(w http.ResponseWriter, r *http.Request, courseID gradingAPI.CourseID, studentID gradingAPI.StudentID, params gradingAPI.GetCourseRankParams) {
+	s.getRank(w, r, studentID, courseID, params.Ties)
+}
+
+func (s server) getRank(w http.ResponseWriter, r *http.Request, studentID, courseID uuid.UUID, ties *gradingAPI.Ties) {
+	var method domain.TieMethod
+	if ties != nil {
+		method = domain.TieMethod(*ties)
+	}
+	rank, err := s.usecase.GetClassRank(r.Context(), studentID, courseID, method)
+	if err != nil {
+		s.logger.Error("while ranking student", "error", err)
+		switch {
+		case errors.Is(err, domain.ErrInvalidRank):
+			s.respondError(w, err, http.StatusBadRequest)
+		case errors.Is(err, domain.ErrForbidden):
+			s.respondError(w, err, http.StatusForbidden)
+		case errors.Is(err, domain.ErrStudentNotFound):
+			s.respondError(w, domain.ErrStudentNotFound, http.StatusNotFound)
+		default:
+			s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	response := gradingAPI.ClassRank{
+		StudentId:  rank.StudentID,
+		Ties:       gradingAPI.TieMethod(rank.Ties),
+		Average:    rank.Average,
+		Rank:       rank.Rank,
+		CohortSize: rank.CohortSize,
+		Percentile: rank.Percentile,
+	}
+	if rank.CourseID != uuid.Nil {
+		response.CourseId = &rank.CourseID
+	}
+	s.respond(w, response, http.StatusOK)
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestNewHandler_GetRank(t *testing.T) {
+	studentID, courseID := uuid.New(), uuid.New()
+	testCases := map[string]struct {
+		path               string
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+		expectedCourse     bool
+	}{
+		"class rank": {
+			path: "/students/" + studentID.String() + "/rank",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetClassRank(gomock.Any(), studentID, uuid.Nil, domain.TieMethod("")).Return(domain.ClassRank{
+					StudentID: studentID, Ties: domain.TiesCompetition, Average: 3, Rank: 2, CohortSize: 4, Percentile: 75,
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"course rank with dense ranking": {
+			path: "/courses/" + courseID.String() + "/students/" + studentID.String() + "/rank?ties=dense",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetClassRank(gomock.Any(), studentID, courseID, domain.TiesDense).Return(domain.ClassRank{
+					StudentID: studentID, CourseID: courseID, Ties: domain.TiesDense, Average: 3, Rank: 2, CohortSize: 4, Percentile: 75,
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedCourse:     true,
+		},
+		"unknown tie method": {
+			path:               "/students/" + studentID.String() + "/rank?ties=fractional",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"forbidden": {
+			path: "/students/" + studentID.String() + "/rank",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetClassRank(gomock.Any(), studentID, uuid.Nil, domain.TieMethod("")).
+					Return(domain.ClassRank{}, fmt.Errorf("%w: only the student may see their rank", domain.ErrForbidden))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		"not ranked": {
+			path: "/courses/" + courseID.String() + "/students/" + studentID.String() + "/rank",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetClassRank(gomock.Any(), studentID, courseID, domain.TieMethod("")).
+					Return(domain.ClassRank{}, fmt.Errorf("fetching rank position failed: %w", domain.ErrStudentNotFound))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"internal error": {
+			path: "/students/" + studentID.String() + "/rank",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetClassRank(gomock.Any(), studentID, uuid.Nil, domain.TieMethod("")).Return(domain.ClassRank{}, errors.New("error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			h := NewHandler(mock, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code, w.Body.String())
+
+			if w.Code == http.StatusOK {
+				var response gradingAPI.ClassRank
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, 2, response.Rank)
+				require.Equal(t, 75.0, response.Percentile)
+				require.Equal(t, tc.expectedCourse, response.CourseId != nil)
+			}
+		})
+	}
+}
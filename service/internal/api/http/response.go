@@ -31,8 +31,13 @@ func respond(w http.ResponseWriter, data interface{}, statusCode int) error {
 	return nil
 }
 
+// RespondError writes the body of every error response of the API: the message of err, as a JSON object.
+func RespondError(w http.ResponseWriter, err error, code int) error {
+	return respond(w, map[string]string{"error": err.Error()}, code)
+}
+
 func (s server) respondError(w http.ResponseWriter, err error, code int) {
-	if err := respond(w, map[string]string{"error": err.Error()}, code); err != nil {
+	if err := RespondError(w, err, code); err != nil {
 		s.logger.With(err).Error("error responding to request")
 
 	}
@@ -0,0 +1,66 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// GetStanding handles HTTP requests to evaluate the standing of a student in a term.
+func (s server) GetStanding(w http.ResponseWriter, r *http.Request, studentID gradingAPI.StudentID, params gradingAPI.GetStandingParams) {
+	var term string
+	if params.Term != nil {
+		term = *params.Term
+	}
+	standing, err := s.usecase.GetStanding(r.Context(), studentID, term)
+	if err != nil {
+		s.logger.Error("while evaluating standing", "error", err)
+		if errors.Is(err, domain.ErrStudentNotFound) {
+			s.respondError(w, err, http.StatusNotFound)
+		} else {
+			s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+		}
+		return
+	}
+	s.respond(w, toStanding(standing), http.StatusOK)
+}
+
+// RunStanding handles HTTP requests to evaluate the standing of every student graded in a term.
+// The response lists the students meeting a rule only.
+func (s server) RunStanding(w http.ResponseWriter, r *http.Request, term string) {
+	standings, err := s.usecase.RunStanding(r.Context(), term)
+	if err != nil {
+		s.logger.Error("while running standing", "error", err)
+		if errors.Is(err, domain.ErrForbidden) {
+			s.respondError(w, err, http.StatusForbidden)
+			return
+		}
+		s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+		return
+	}
+	response := gradingAPI.StandingRun{Term: term, Evaluated: len(standings), Flagged: []gradingAPI.Standing{}}
+	for _, standing := range standings {
+		if len(standing.Standings) > 0 {
+			response.Flagged = append(response.Flagged, toStanding(standing))
+		}
+	}
+	s.respond(w, response, http.StatusOK)
+}
+
+func toStanding(standing domain.Standing) gradingAPI.Standing {
+	response := gradingAPI.Standing{
+		StudentId:         standing.StudentID,
+		Term:              standing.Term,
+		TermGpa:           standing.TermGPA,
+		TermCredits:       standing.TermCredits,
+		CumulativeGpa:     standing.CumulativeGPA,
+		CumulativeCredits: standing.CumulativeCredits,
+		Standings:         standing.Standings,
+	}
+	if response.Standings == nil {
+		response.Standings = []string{}
+	}
+	return response
+}
@@ -0,0 +1,151 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestNewHandler_GetStanding(t *testing.T) {
+	studentID := uuid.New()
+	standing := domain.Standing{
+		StudentID:       studentID,
+		StandingMetrics: domain.StandingMetrics{Term: "2024-2", TermGPA: ptr(3.75), TermCredits: 12, CumulativeGPA: ptr(3), CumulativeCredits: 48},
+		Standings:       []string{"Dean's List"},
+	}
+	testCases := map[string]struct {
+		path               string
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+	}{
+		"latest term": {
+			path: "/students/" + studentID.String() + "/standing",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStanding(gomock.Any(), studentID, "").Return(standing, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"given term": {
+			path: "/students/" + studentID.String() + "/standing?term=2024-2",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStanding(gomock.Any(), studentID, "2024-2").Return(standing, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"not graded in the term": {
+			path: "/students/" + studentID.String() + "/standing?term=2023-1",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStanding(gomock.Any(), studentID, "2023-1").
+					Return(domain.Standing{}, fmt.Errorf("%w: no grades in term %q", domain.ErrStudentNotFound, "2023-1"))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"invalid student id": {
+			path:               "/students/alice/standing",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"internal error": {
+			path: "/students/" + studentID.String() + "/standing",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetStanding(gomock.Any(), studentID, "").Return(domain.Standing{}, errors.New("error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			h := NewHandler(mock, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code, w.Body.String())
+
+			if w.Code == http.StatusOK {
+				var response gradingAPI.Standing
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, "2024-2", response.Term)
+				require.Equal(t, ptr(3.75), response.TermGpa)
+				require.Equal(t, []string{"Dean's List"}, response.Standings)
+			}
+		})
+	}
+}
+
+func TestNewHandler_RunStanding(t *testing.T) {
+	flagged := uuid.New()
+	testCases := map[string]struct {
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+	}{
+		"success": {
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().RunStanding(gomock.Any(), "2024-2").Return([]domain.Standing{
+					{StudentID: flagged, StandingMetrics: domain.StandingMetrics{Term: "2024-2", CumulativeGPA: ptr(1.5)}, Standings: []string{"Probation"}},
+					{StudentID: uuid.New(), StandingMetrics: domain.StandingMetrics{Term: "2024-2", CumulativeGPA: ptr(3)}},
+				}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"forbidden": {
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().RunStanding(gomock.Any(), "2024-2").
+					Return(nil, fmt.Errorf("%w: only the admins may do this", domain.ErrForbidden))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		"internal error": {
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().RunStanding(gomock.Any(), "2024-2").Return(nil, errors.New("error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := usecase.NewMockLogic(ctrl)
+			tc.setMock(mock)
+			h := NewHandler(mock, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+			req := httptest.NewRequest(http.MethodPost, "/terms/2024-2/standings", nil)
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code, w.Body.String())
+
+			if w.Code == http.StatusOK {
+				var response gradingAPI.StandingRun
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, 2, response.Evaluated)
+				require.Len(t, response.Flagged, 1)
+				require.Equal(t, flagged, response.Flagged[0].StudentId)
+				require.Equal(t, []string{"Probation"}, response.Flagged[0].Standings)
+			}
+		})
+	}
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
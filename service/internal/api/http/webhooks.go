@@ -0,0 +1,182 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// ListWebhooks handles HTTP requests to list the webhook subscriptions.
+func (s server) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := s.usecase.ListWebhooks(r.Context())
+	if err != nil {
+		s.handleWebhooksError(w, err)
+		return
+	}
+	response := gradingAPI.WebhookList{Webhooks: make([]gradingAPI.Webhook, len(webhooks))}
+	for i, webhook := range webhooks {
+		response.Webhooks[i] = toWebhook(webhook, false)
+	}
+	s.respond(w, response, http.StatusOK)
+}
+
+// CreateWebhook handles HTTP requests to subscribe a url to the events. Only its response holds the secret.
+func (s server) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	webhook, ok := s.decodeWebhook(w, r)
+	if !ok {
+		return
+	}
+	created, err := s.usecase.CreateWebhook(r.Context(), webhook)
+	if err != nil {
+		s.handleWebhooksError(w, err)
+		return
+	}
+	s.respond(w, toWebhook(created, true), http.StatusCreated)
+}
+
+// GetWebhook handles HTTP requests to get a webhook subscription.
+func (s server) GetWebhook(w http.ResponseWriter, r *http.Request, webhookID gradingAPI.WebhookID) {
+	webhook, err := s.usecase.GetWebhook(r.Context(), webhookID)
+	if err != nil {
+		s.handleWebhooksError(w, err)
+		return
+	}
+	s.respond(w, toWebhook(webhook, false), http.StatusOK)
+}
+
+// UpdateWebhook handles HTTP requests to replace a webhook subscription.
+func (s server) UpdateWebhook(w http.ResponseWriter, r *http.Request, webhookID gradingAPI.WebhookID) {
+	webhook, ok := s.decodeWebhook(w, r)
+	if !ok {
+		return
+	}
+	webhook.ID = webhookID
+	updated, err := s.usecase.UpdateWebhook(r.Context(), webhook)
+	if err != nil {
+		s.handleWebhooksError(w, err)
+		return
+	}
+	s.respond(w, toWebhook(updated, false), http.StatusOK)
+}
+
+// DeleteWebhook handles HTTP requests to delete a webhook subscription.
+func (s server) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookID gradingAPI.WebhookID) {
+	if err := s.usecase.DeleteWebhook(r.Context(), webhookID); err != nil {
+		s.handleWebhooksError(w, err)
+		return
+	}
+	s.respond(w, nil, http.StatusNoContent)
+}
+
+// ListDeliveries handles HTTP requests to list the deliveries of a webhook.
+func (s server) ListDeliveries(w http.ResponseWriter, r *http.Request, webhookID gradingAPI.WebhookID, params gradingAPI.ListDeliveriesParams) {
+	limit, offset := defaultLimit, 0
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	deliveries, total, err := s.usecase.ListDeliveries(r.Context(), webhookID, limit, offset)
+	if err != nil {
+		s.handleWebhooksError(w, err)
+		return
+	}
+	response := gradingAPI.DeliveryList{
+		Deliveries: make([]gradingAPI.Delivery, len(deliveries)),
+		Pagination: gradingAPI.Pagination{Limit: limit, Offset: offset, Total: total},
+	}
+	for i, delivery := range deliveries {
+		response.Deliveries[i] = toDelivery(delivery)
+	}
+	s.respond(w, response, http.StatusOK)
+}
+
+// Redeliver handles HTTP requests to attempt a delivery again.
+func (s server) Redeliver(w http.ResponseWriter, r *http.Request, webhookID gradingAPI.WebhookID, deliveryID gradingAPI.DeliveryID) {
+	delivery, err := s.usecase.Redeliver(r.Context(), webhookID, deliveryID)
+	if err != nil {
+		s.handleWebhooksError(w, err)
+		return
+	}
+	s.respond(w, toDelivery(delivery), http.StatusAccepted)
+}
+
+// decodeWebhook decodes a webhook input, active unless told otherwise, responding 400 when it cannot.
+func (s server) decodeWebhook(w http.ResponseWriter, r *http.Request) (domain.Webhook, bool) {
+	var input gradingAPI.WebhookInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		s.respondError(w, fmt.Errorf("decoding webhook: %w", err), http.StatusBadRequest)
+		return domain.Webhook{}, false
+	}
+	webhook := domain.Webhook{URL: input.Url, Active: true}
+	if input.EventTypes != nil {
+		for _, eventType := range *input.EventTypes {
+			webhook.EventTypes = append(webhook.EventTypes, domain.EventType(eventType))
+		}
+	}
+	if input.Active != nil {
+		webhook.Active = *input.Active
+	}
+	if input.Secret != nil {
+		webhook.Secret = *input.Secret
+	}
+	return webhook, true
+}
+
+func (s server) handleWebhooksError(w http.ResponseWriter, err error) {
+	s.logger.Error("while managing webhooks", "error", err)
+	switch {
+	case errors.Is(err, domain.ErrInvalidWebhook):
+		s.respondError(w, err, http.StatusBadRequest)
+	case errors.Is(err, domain.ErrForbidden):
+		s.respondError(w, err, http.StatusForbidden)
+	case errors.Is(err, domain.ErrWebhookNotFound), errors.Is(err, domain.ErrDeliveryNotFound):
+		s.respondError(w, errors.Unwrap(err), http.StatusNotFound)
+	default:
+		s.respondError(w, errors.New(http.StatusText(http.StatusInternalServerError)), http.StatusInternalServerError)
+	}
+}
+
+func toWebhook(webhook domain.Webhook, withSecret bool) gradingAPI.Webhook {
+	response := gradingAPI.Webhook{
+		Id:         webhook.ID,
+		Url:        webhook.URL,
+		EventTypes: make([]gradingAPI.EventType, len(webhook.EventTypes)),
+		Active:     webhook.Active,
+		CreatedAt:  webhook.CreatedAt,
+		UpdatedAt:  webhook.UpdatedAt,
+	}
+	for i, eventType := range webhook.EventTypes {
+		response.EventTypes[i] = gradingAPI.EventType(eventType)
+	}
+	if withSecret {
+		response.Secret = &webhook.Secret
+	}
+	return response
+}
+
+func toDelivery(delivery domain.Delivery) gradingAPI.Delivery {
+	response := gradingAPI.Delivery{
+		Id:            delivery.ID,
+		WebhookId:     delivery.WebhookID,
+		EventId:       delivery.EventID,
+		EventType:     gradingAPI.EventType(delivery.EventType),
+		Status:        gradingAPI.DeliveryStatus(delivery.Status),
+		Attempts:      delivery.Attempts,
+		NextAttemptAt: delivery.NextAttemptAt,
+		CreatedAt:     delivery.CreatedAt,
+		UpdatedAt:     delivery.UpdatedAt,
+	}
+	if delivery.LastStatusCode != 0 {
+		response.LastStatusCode = &delivery.LastStatusCode
+	}
+	if delivery.LastError != "" {
+		response.LastError = &delivery.LastError
+	}
+	return response
+}
@@ -0,0 +1,190 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestNewHandler_Webhooks(t *testing.T) {
+	now := time.Now().UTC()
+	webhook := domain.Webhook{
+		ID: uuid.New(), URL: "https://partner.example/hooks", Secret: "whsec_test",
+		EventTypes: domain.EventTypeSet{domain.GPAChanged}, Active: true, CreatedAt: now, UpdatedAt: now,
+	}
+	delivery := domain.Delivery{
+		ID: uuid.New(), WebhookID: webhook.ID, EventID: uuid.New(), EventType: domain.GPAChanged,
+		Status: domain.DeliveryDead, Attempts: 8, NextAttemptAt: now, LastStatusCode: 503,
+		LastError: "503 Service Unavailable", CreatedAt: now, UpdatedAt: now,
+	}
+	webhookPath := "/webhooks/" + webhook.ID.String()
+	testCases := map[string]struct {
+		method             string
+		path               string
+		body               string
+		setMock            func(m *usecase.MockLogic)
+		expectedStatusCode int
+		expectedSecret     bool
+	}{
+		"create": {
+			method: http.MethodPost,
+			path:   "/webhooks",
+			body:   `{"url": "https://partner.example/hooks", "event_types": ["student.gpa_changed"]}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().CreateWebhook(gomock.Any(), domain.Webhook{
+					URL: webhook.URL, EventTypes: webhook.EventTypes, Active: true,
+				}).Return(webhook, nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedSecret:     true,
+		},
+		"create with unknown event type": {
+			method:             http.MethodPost,
+			path:               "/webhooks",
+			body:               `{"url": "https://partner.example/hooks", "event_types": ["student.enrolled"]}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"create with invalid url": {
+			method: http.MethodPost,
+			path:   "/webhooks",
+			body:   `{"url": "partner"}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).
+					Return(domain.Webhook{}, fmt.Errorf("%w: url must be an absolute http or https url", domain.ErrInvalidWebhook))
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		"list forbidden": {
+			method: http.MethodGet,
+			path:   "/webhooks",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ListWebhooks(gomock.Any()).
+					Return(nil, fmt.Errorf("%w: only the admins may do this", domain.ErrForbidden))
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		"list": {
+			method: http.MethodGet,
+			path:   "/webhooks",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ListWebhooks(gomock.Any()).Return([]domain.Webhook{webhook}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"get": {
+			method: http.MethodGet,
+			path:   webhookPath,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetWebhook(gomock.Any(), webhook.ID).Return(webhook, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"get unknown": {
+			method: http.MethodGet,
+			path:   webhookPath,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().GetWebhook(gomock.Any(), webhook.ID).
+					Return(domain.Webhook{}, fmt.Errorf("fetching webhook failed: %w", domain.ErrWebhookNotFound))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		"update": {
+			method: http.MethodPut,
+			path:   webhookPath,
+			body:   `{"url": "https://partner.example/hooks", "active": false}`,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().UpdateWebhook(gomock.Any(), domain.Webhook{ID: webhook.ID, URL: webhook.URL}).Return(webhook, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"delete": {
+			method: http.MethodDelete,
+			path:   webhookPath,
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().DeleteWebhook(gomock.Any(), webhook.ID).Return(nil)
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		"list deliveries": {
+			method: http.MethodGet,
+			path:   webhookPath + "/deliveries?limit=5",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ListDeliveries(gomock.Any(), webhook.ID, 5, 0).Return([]domain.Delivery{delivery}, 1, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		"list deliveries failure": {
+			method: http.MethodGet,
+			path:   webhookPath + "/deliveries",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().ListDeliveries(gomock.Any(), webhook.ID, defaultLimit, 0).Return(nil, 0, errors.New("error"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+		"redeliver": {
+			method: http.MethodPost,
+			path:   webhookPath + "/deliveries/" + delivery.ID.String() + "/redeliver",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().Redeliver(gomock.Any(), webhook.ID, delivery.ID).Return(delivery, nil)
+			},
+			expectedStatusCode: http.StatusAccepted,
+		},
+		"redeliver unknown": {
+			method: http.MethodPost,
+			path:   webhookPath + "/deliveries/" + delivery.ID.String() + "/redeliver",
+			setMock: func(m *usecase.MockLogic) {
+				m.EXPECT().Redeliver(gomock.Any(), webhook.ID, delivery.ID).
+					Return(domain.Delivery{}, fmt.Errorf("redelivering failed: %w", domain.ErrDeliveryNotFound))
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mock := usecase.NewMockLogic(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(mock)
+			}
+			h := NewHandler(mock, slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			if tc.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, req)
+			require.Equal(t, tc.expectedStatusCode, w.Code, w.Body.String())
+
+			switch {
+			case w.Code == http.StatusCreated || (w.Code == http.StatusOK && tc.method != http.MethodGet):
+				var response gradingAPI.Webhook
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, webhook.ID, response.Id)
+				require.Equal(t, []gradingAPI.EventType{gradingAPI.StudentGpaChanged}, response.EventTypes)
+				require.Equal(t, tc.expectedSecret, response.Secret != nil)
+			case w.Code == http.StatusAccepted:
+				var response gradingAPI.Delivery
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				require.Equal(t, gradingAPI.Dead, response.Status)
+				require.Equal(t, 503, *response.LastStatusCode)
+			}
+		})
+	}
+}
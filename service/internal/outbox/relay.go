@@ -0,0 +1,216 @@
+// Package outbox relays the events of the outbox to sinks, see domain.Event.
+//
+// Events are written to the outbox in the same unit of work as the change they record, and only marked
+// delivered once a sink took them: delivery is at-least-once, so sinks may see an event again after a
+// failure and consumers deduplicate on the event ID.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	// Sink receives the events of the outbox. Deliver returns an error unless all the events were taken,
+	// in which case they are delivered again later.
+	Sink interface {
+		Deliver(ctx context.Context, events []domain.Event) error
+	}
+
+	// TxSink is a sink storing the events in the repository. The relay gives it the repository of the unit of
+	// work marking the events delivered, so the events are stored exactly once.
+	TxSink interface {
+		Sink
+		DeliverTx(ctx context.Context, repo rdbms.Repository, events []domain.Event) error
+	}
+
+	// Relay moves the pending events of the outbox to a sink.
+	Relay struct {
+		repo     rdbms.Repository
+		sink     Sink
+		logger   *slog.Logger
+		options  relayOptions
+		cancel   context.CancelFunc
+		stopOnce sync.Once
+		done     sync.WaitGroup
+	}
+
+	relayOptions struct {
+		interval  time.Duration
+		batchSize int
+		lease     time.Duration
+	}
+
+	// RelayOption is used to provide overrides to the Relay implementation.
+	RelayOption func(*relayOptions)
+)
+
+// Interval sets how often the outbox is polled when it was found empty. The default is 1 second.
+func Interval(interval time.Duration) RelayOption {
+	return func(o *relayOptions) {
+		o.interval = interval
+	}
+}
+
+// BatchSize sets the maximum number of events delivered at once. The default is 100.
+func BatchSize(size int) RelayOption {
+	return func(o *relayOptions) {
+		o.batchSize = size
+	}
+}
+
+// Lease sets how long a batch is left alone by the other relays while it is delivered, after which it is
+// relayed again if it was not marked delivered. It must be longer than the sinks take. The default is 1 minute.
+func Lease(lease time.Duration) RelayOption {
+	return func(o *relayOptions) {
+		o.lease = lease
+	}
+}
+
+// NewRelay returns a relay of the outbox of repo to sink.
+func NewRelay(repo rdbms.Repository, sink Sink, logger *slog.Logger, options ...RelayOption) *Relay {
+	r := &Relay{
+		repo:   repo,
+		sink:   sink,
+		logger: logger,
+		options: relayOptions{
+			interval:  time.Second,
+			batchSize: 100,
+			lease:     time.Minute,
+		},
+	}
+	for _, opt := range options {
+		opt(&r.options)
+	}
+	if r.options.batchSize < 1 {
+		r.options.batchSize = 1
+	}
+	return r
+}
+
+// RelayOnce delivers a batch of pending events and marks them delivered, returning how many were.
+// The batch is claimed in a unit of work of its own, so concurrent relays deliver different events and no
+// transaction is held while the sinks are called. The TxSinks then store the events in the unit of work marking
+// them delivered. A batch failing to be delivered is released, to be relayed again. The events of every tenant
+// are relayed, see auth.AllTenants.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	ctx = auth.WithTenant(ctx, auth.AllTenants)
+	var events []domain.Event
+	err := r.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		var err error
+		events, err = repo.ClaimEvents(ctx, time.Now().UTC(), r.options.lease, r.options.batchSize)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("claiming pending events: %w", err)
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+	ids := make([]uuid.UUID, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+
+	sinks, txSinks := split(r.sink)
+	if err := sinks.Deliver(ctx, events); err != nil {
+		r.release(ctx, ids)
+		return 0, fmt.Errorf("delivering events: %w", err)
+	}
+	err = r.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		for _, sink := range txSinks {
+			if err := sink.DeliverTx(ctx, repo, events); err != nil {
+				return fmt.Errorf("delivering events: %w", err)
+			}
+		}
+		if err := repo.MarkEventsDelivered(ctx, ids); err != nil {
+			return fmt.Errorf("marking events delivered: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		r.release(ctx, ids)
+		return 0, err
+	}
+	return len(events), nil
+}
+
+// release gives up the claim on the events, which are otherwise relayed again once their lease ended.
+func (r *Relay) release(ctx context.Context, ids []uuid.UUID) {
+	if err := r.repo.ReleaseEvents(context.WithoutCancel(ctx), ids); err != nil {
+		r.logger.Warn("releasing events failed", "err", err)
+	}
+}
+
+// split returns the sinks delivering on their own, and the TxSinks storing the events in the repository.
+func split(sink Sink) (MultiSink, []TxSink) {
+	if txSink, ok := sink.(TxSink); ok {
+		return nil, []TxSink{txSink}
+	}
+	multi, ok := sink.(MultiSink)
+	if !ok {
+		return MultiSink{sink}, nil
+	}
+	var (
+		sinks   MultiSink
+		txSinks []TxSink
+	)
+	for _, s := range multi {
+		plain, stored := split(s)
+		sinks, txSinks = append(sinks, plain...), append(txSinks, stored...)
+	}
+	return sinks, txSinks
+}
+
+// Start relays the outbox in the background until Stop is called: batch after batch while there are
+// pending events, then every interval. Failed deliveries are retried at the next interval.
+func (r *Relay) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done.Add(1)
+	go func() {
+		defer r.done.Done()
+		ticker := time.NewTicker(r.options.interval)
+		defer ticker.Stop()
+		for {
+			r.drain(ctx)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		delivered, err := r.RelayOnce(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.Warn("relaying outbox failed", "err", err)
+			}
+			return
+		}
+		if delivered < r.options.batchSize {
+			return
+		}
+	}
+}
+
+// Stop stops relaying, abandoning the delivery in progress, and waits for the relay to return.
+func (r *Relay) Stop() {
+	r.stopOnce.Do(func() {
+		if r.cancel != nil {
+			r.cancel()
+		}
+	})
+	r.done.Wait()
+}
@@ -0,0 +1,252 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/internal/storage/memory"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+// recorder is a sink recording the events it was given, failing while err is set.
+type recorder struct {
+	mu     sync.Mutex
+	err    error
+	events []domain.Event
+}
+
+func (r *recorder) Deliver(_ context.Context, events []domain.Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *recorder) ids() []uuid.UUID {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ids := make([]uuid.UUID, len(r.events))
+	for i, event := range r.events {
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+func appendEvents(t *testing.T, store *memory.Store, n int) []uuid.UUID {
+	t.Helper()
+	ids := make([]uuid.UUID, n)
+	for i := range ids {
+		event, err := domain.NewGradePosted(domain.Grade{StudentID: uuid.New(), CourseID: uuid.New(), Grade: i})
+		require.NoError(t, err)
+		require.NoError(t, store.AppendEvents(context.Background(), []domain.Event{event}))
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+func newLogger() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(io.Discard, nil))
+}
+
+func TestRelay_RelayOnce(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	ids := appendEvents(t, store, 3)
+	sink := &recorder{}
+	relay := NewRelay(store, sink, newLogger(), BatchSize(2))
+
+	delivered, err := relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, delivered)
+	delivered, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered)
+	delivered, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Zero(t, delivered)
+	require.Equal(t, ids, sink.ids())
+}
+
+func TestRelay_AtLeastOnce(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	ids := appendEvents(t, store, 2)
+	sink := &recorder{err: errors.New("unavailable")}
+	relay := NewRelay(store, sink, newLogger())
+
+	_, err := relay.RelayOnce(ctx)
+	require.Error(t, err)
+
+	sink.err = nil
+	delivered, err := relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, delivered, "undelivered events must be released")
+	require.Equal(t, ids, sink.ids())
+}
+
+// txSink is a sink running a unit of work of the store while it delivers.
+type txSink struct {
+	recorder
+	store *memory.Store
+}
+
+func (s *txSink) Deliver(ctx context.Context, events []domain.Event) error {
+	if err := s.store.WithTx(ctx, func(rdbms.Repository) error { return nil }); err != nil {
+		return err
+	}
+	return s.recorder.Deliver(ctx, events)
+}
+
+func TestRelay_NoTxWhileDelivering(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	store := memory.New()
+	ids := appendEvents(t, store, 2)
+	sink := &txSink{store: store}
+	relay := NewRelay(store, sink, newLogger())
+
+	// the memory store runs one unit of work at a time, the sink would wait for the relay otherwise
+	delivered, err := relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, delivered)
+	require.Equal(t, ids, sink.ids())
+}
+
+func TestRelay_Lease(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	appendEvents(t, store, 2)
+	claimed, err := store.ClaimEvents(ctx, time.Now().UTC(), time.Minute, 1)
+	require.NoError(t, err)
+	sink := &recorder{}
+	relay := NewRelay(store, sink, newLogger())
+
+	delivered, err := relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, delivered, "events claimed by another relay must be skipped")
+	require.NotContains(t, sink.ids(), claimed[0].ID)
+}
+
+func TestRelay_Webhook(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received []uuid.UUID
+		failures = 1
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		var events []domain.Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&events))
+		mu.Lock()
+		defer mu.Unlock()
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		for _, event := range events {
+			received = append(received, event.ID)
+		}
+	}))
+	defer server.Close()
+
+	store := memory.New()
+	ids := appendEvents(t, store, 5)
+	relay := NewRelay(store, NewWebhookSink(server.URL, server.Client()), newLogger(),
+		Interval(10*time.Millisecond), BatchSize(2))
+	relay.Start(context.Background())
+	defer relay.Stop()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == len(ids)
+	}, 5*time.Second, 10*time.Millisecond)
+	relay.Stop()
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, ids, received)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := OpenFileSink(path)
+	require.NoError(t, err)
+	event, err := domain.NewScaleUpdated("4.0", domain.Scales{{Min: 3, GPA: "A"}})
+	require.NoError(t, err)
+	require.NoError(t, sink.Deliver(context.Background(), []domain.Event{event, event}))
+	require.NoError(t, sink.Close())
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+	var got domain.Event
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	require.Equal(t, event.ID, got.ID)
+	require.JSONEq(t, `{"scale_type":"4.0","bands":[{"min":3,"gpa":"A"}]}`, string(got.Payload))
+}
+
+func TestParseSinks(t *testing.T) {
+	dir := t.TempDir()
+	testCases := map[string]struct {
+		specs       []string
+		expected    Sink
+		expectedErr bool
+	}{
+		"stdout": {
+			specs:    []string{"stdout"},
+			expected: &WriterSink{},
+		},
+		"webhook": {
+			specs:    []string{"https://example.com/events"},
+			expected: &WebhookSink{},
+		},
+		"several": {
+			specs:    []string{"stdout", "file:" + filepath.Join(dir, "events.jsonl"), "http://localhost/events"},
+			expected: MultiSink{},
+		},
+		"unsupported": {
+			specs:       []string{"kafka://localhost"},
+			expectedErr: true,
+		},
+		"missing directory": {
+			specs:       []string{"file:" + filepath.Join(dir, "missing", "events.jsonl")},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			sink, closeSinks, err := ParseSinks(tc.specs)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			defer func() {
+				require.NoError(t, closeSinks())
+			}()
+			require.IsType(t, tc.expected, sink)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+var (
+	_ Sink = new(WriterSink)
+	_ Sink = new(FileSink)
+	_ Sink = new(WebhookSink)
+	_ Sink = MultiSink(nil)
+)
+
+type (
+	// WriterSink writes the events to a writer as JSON lines.
+	WriterSink struct {
+		mu sync.Mutex
+		w  io.Writer
+	}
+
+	// FileSink appends the events to a file as JSON lines, synced before they are marked delivered.
+	FileSink struct {
+		WriterSink
+		file *os.File
+	}
+
+	// WebhookSink posts the events to a URL as a JSON array, delivered when it answers with a 2xx status.
+	WebhookSink struct {
+		url    string
+		client *http.Client
+	}
+
+	// MultiSink delivers the events to every sink. A failing sink gets the events again, and so do the others.
+	// The relay calls the TxSinks among them in the unit of work marking the events delivered.
+	MultiSink []Sink
+)
+
+// NewWriterSink returns a sink writing to w.
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// NewStdoutSink returns a sink writing to the standard output.
+func NewStdoutSink() *WriterSink {
+	return NewWriterSink(os.Stdout)
+}
+
+// Deliver ...
+func (s *WriterSink) Deliver(_ context.Context, events []domain.Event) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return fmt.Errorf("encoding event %s: %w", event.ID, err)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.w.Write(buf.Bytes())
+	return err
+}
+
+// OpenFileSink returns a sink appending to the file at path, created if needed.
+func OpenFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("opening outbox file: %w", err)
+	}
+	return &FileSink{WriterSink: WriterSink{w: file}, file: file}, nil
+}
+
+// Deliver ...
+func (s *FileSink) Deliver(ctx context.Context, events []domain.Event) error {
+	if err := s.WriterSink.Deliver(ctx, events); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// NewWebhookSink returns a sink posting to url with client, a client timing out after 10 seconds if nil.
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookSink{url: url, client: client}
+}
+
+// Deliver ...
+func (s *WebhookSink) Deliver(ctx context.Context, events []domain.Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return fmt.Errorf("encoding events: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook %s answered %s", s.url, resp.Status)
+	}
+	return nil
+}
+
+// Deliver ...
+func (s MultiSink) Deliver(ctx context.Context, events []domain.Event) error {
+	var errs []error
+	for _, sink := range s {
+		if err := sink.Deliver(ctx, events); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ParseSinks returns the sink of the specifications: "stdout", "file:PATH", or an http(s) URL to post to.
+// Several specifications make a MultiSink. The returned function closes the opened files.
+func ParseSinks(specs []string) (Sink, func() error, error) {
+	var (
+		sinks MultiSink
+		files []*FileSink
+	)
+	closeFiles := func() error {
+		var errs []error
+		for _, f := range files {
+			errs = append(errs, f.Close())
+		}
+		return errors.Join(errs...)
+	}
+	for _, spec := range specs {
+		switch {
+		case spec == "stdout":
+			sinks = append(sinks, NewStdoutSink())
+		case strings.HasPrefix(spec, "file:"):
+			f, err := OpenFileSink(strings.TrimPrefix(spec, "file:"))
+			if err != nil {
+				_ = closeFiles()
+				return nil, nil, err
+			}
+			files = append(files, f)
+			sinks = append(sinks, f)
+		case strings.HasPrefix(spec, "http://"), strings.HasPrefix(spec, "https://"):
+			sinks = append(sinks, NewWebhookSink(spec, nil))
+		default:
+			_ = closeFiles()
+			return nil, nil, fmt.Errorf("unsupported outbox sink %q", spec)
+		}
+	}
+	if len(sinks) == 1 {
+		return sinks[0], closeFiles, nil
+	}
+	return sinks, closeFiles, nil
+}
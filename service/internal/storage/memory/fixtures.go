@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+//go:embed testdata/demo.json
+var demoFixtures embed.FS
+
+type (
+	// Fixtures are the grades and scales to load into a store.
+	Fixtures struct {
+		Grades []domain.Grade
+		Scales map[domain.ScaleType]domain.Scales
+	}
+
+	// fixturesFile is the JSON layout of fixtures, see testdata/demo.json.
+	fixturesFile struct {
+		Grades []struct {
+			StudentID uuid.UUID        `json:"student_id"`
+			CourseID  uuid.UUID        `json:"course_id"`
+			Grade     int              `json:"grade"`
+			Term      string           `json:"term"`
+			Credits   int              `json:"credits"`
+			Type      domain.GradeType `json:"type"`
+		} `json:"grades"`
+		Scales map[domain.ScaleType][]struct {
+			Min          int    `json:"min"`
+			GPA          string `json:"gpa"`
+			Max          *int   `json:"max"`
+			MinExclusive bool   `json:"min_exclusive"`
+			MaxExclusive bool   `json:"max_exclusive"`
+		} `json:"scales"`
+	}
+)
+
+// LoadFixtures decodes and validates JSON fixtures.
+func LoadFixtures(r io.Reader) (Fixtures, error) {
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	var file fixturesFile
+	if err := decoder.Decode(&file); err != nil {
+		return Fixtures{}, fmt.Errorf("decoding fixtures: %w", err)
+	}
+
+	fixtures := Fixtures{
+		Grades: make([]domain.Grade, 0, len(file.Grades)),
+		Scales: make(map[domain.ScaleType]domain.Scales, len(file.Scales)),
+	}
+	for _, grade := range file.Grades {
+		if !grade.Type.Valid() {
+			return Fixtures{}, fmt.Errorf("%w: unknown type %q", domain.ErrInvalidGrade, grade.Type)
+		}
+		fixtures.Grades = append(fixtures.Grades, domain.Grade{
+			StudentID: grade.StudentID,
+			CourseID:  grade.CourseID,
+			Grade:     grade.Grade,
+			Term:      grade.Term,
+			Credits:   grade.Credits,
+			Type:      grade.Type,
+		})
+	}
+	for scaleType, bands := range file.Scales {
+		if !scaleType.Valid() {
+			return Fixtures{}, fmt.Errorf("%w: unknown scale type %q", domain.ErrInvalidScales, scaleType)
+		}
+		scales := make(domain.Scales, 0, len(bands))
+		for _, band := range bands {
+			scales = append(scales, domain.Scale{
+				Min:          band.Min,
+				GPA:          band.GPA,
+				Max:          band.Max,
+				MinExclusive: band.MinExclusive,
+				MaxExclusive: band.MaxExclusive,
+			})
+		}
+		if err := scales.Validate(); err != nil {
+			return Fixtures{}, fmt.Errorf("scale type %q: %w", scaleType, err)
+		}
+		fixtures.Scales[scaleType] = scales
+	}
+	return fixtures, nil
+}
+
+// LoadFixturesFile loads the JSON fixtures of the file at path.
+func LoadFixturesFile(path string) (Fixtures, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Fixtures{}, fmt.Errorf("opening fixtures: %w", err)
+	}
+	defer f.Close()
+	return LoadFixtures(f)
+}
+
+// DemoFixtures returns the small data set served in demo mode.
+func DemoFixtures() (Fixtures, error) {
+	f, err := demoFixtures.Open("testdata/demo.json")
+	if err != nil {
+		return Fixtures{}, err
+	}
+	defer f.Close()
+	return LoadFixtures(f)
+}
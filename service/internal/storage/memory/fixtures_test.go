@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFixtures(t *testing.T) {
+	testCases := map[string]struct {
+		json           string
+		expectedGrades int
+		expectedScales map[domain.ScaleType]domain.Scales
+		expectedErr    error
+		expectErr      bool
+	}{
+		"valid": {
+			json: `{
+				"grades": [{"student_id": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "course_id": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a12", "grade": 3}],
+				"scales": {"4.0": [{"min": 0, "gpa": "0.0"}, {"min": 3, "gpa": "4.0"}]}
+			}`,
+			expectedGrades: 1,
+			expectedScales: map[domain.ScaleType]domain.Scales{"4.0": {{Min: 0, GPA: "0.0"}, {Min: 3, GPA: "4.0"}}},
+		},
+		"bounded bands": {
+			json: `{"scales": {"ECTS": [{"min": 90, "gpa": "A", "max": 100}, {"min": 80, "gpa": "B", "max": 90, "max_exclusive": true}]}}`,
+			expectedScales: map[domain.ScaleType]domain.Scales{"ECTS": {
+				{Min: 90, GPA: "A", Max: intPtr(100)},
+				{Min: 80, GPA: "B", Max: intPtr(90), MaxExclusive: true},
+			}},
+		},
+		"overlapping bands": {
+			json:        `{"scales": {"4.0": [{"min": 3, "gpa": "A"}, {"min": 0, "gpa": "F", "max": 3}]}}`,
+			expectedErr: domain.ErrInvalidScales,
+		},
+		"unknown field": {
+			json:      `{"students": []}`,
+			expectErr: true,
+		},
+		"invalid student id": {
+			json:      `{"grades": [{"student_id": "alice", "course_id": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a12", "grade": 3}]}`,
+			expectErr: true,
+		},
+		"grade type": {
+			json:           `{"grades": [{"student_id": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "course_id": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a12", "grade": 0, "type": "withdrawn"}]}`,
+			expectedGrades: 1,
+			expectedScales: map[domain.ScaleType]domain.Scales{},
+		},
+		"unknown grade type": {
+			json:        `{"grades": [{"student_id": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", "course_id": "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a12", "grade": 0, "type": "dropped"}]}`,
+			expectedErr: domain.ErrInvalidGrade,
+		},
+		"unknown scale type": {
+			json:        `{"scales": {"3.0": [{"min": 0, "gpa": "F"}]}}`,
+			expectedErr: domain.ErrInvalidScales,
+		},
+		"invalid bands": {
+			json:        `{"scales": {"4.0": [{"min": 0, "gpa": "F"}, {"min": 0, "gpa": "E"}]}}`,
+			expectedErr: domain.ErrInvalidScales,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			fixtures, err := LoadFixtures(strings.NewReader(tc.json))
+			switch {
+			case tc.expectedErr != nil:
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			case tc.expectErr:
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, fixtures.Grades, tc.expectedGrades)
+			require.Equal(t, tc.expectedScales, fixtures.Scales)
+		})
+	}
+}
+
+func TestDemoFixtures(t *testing.T) {
+	fixtures, err := DemoFixtures()
+	require.NoError(t, err)
+	require.NotEmpty(t, fixtures.Grades)
+
+	s := New(WithFixtures(fixtures))
+	scales, err := s.GetScales(context.Background(), "4.0")
+	require.NoError(t, err)
+	require.Equal(t, "4.0", scales[0].GPA)
+}
+
+func intPtr(i int) *int { return &i }
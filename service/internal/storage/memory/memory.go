@@ -0,0 +1,480 @@
+// Package memory is a thread-safe in-memory implementation of the storage layer, for tests and demos.
+// It is the reference implementation of the rdbms.Repository contract.
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+var _ rdbms.Repository = new(Store)
+
+// DefaultScales are the bands of the default scale every store starts with, as seeded by the SQL migrations.
+var DefaultScales = domain.Scales{
+	{Min: 4, GPA: "A"},
+	{Min: 3, GPA: "B"},
+	{Min: 2, GPA: "C"},
+	{Min: 1, GPA: "D"},
+	{Min: 0, GPA: "F"},
+}
+
+type (
+	// Store keeps the grades and scales of every tenant apart, see auth.TenantFrom.
+	Store struct {
+		mu      sync.RWMutex
+		tenants map[string]*tenantData
+		// outbox holds the undelivered events
+		outbox []outboxEvent
+		// webhooks and deliveries are kept in creation order
+		webhooks   []domain.Webhook
+		deliveries []domain.Delivery
+		// txMu runs the units of work one at a time
+		txMu sync.Mutex
+	}
+
+	// tenantData are the grades of a tenant in insertion order, its scales by type and the keys of the events
+	// appended to the outbox, delivered or not.
+	tenantData struct {
+		grades    []domain.Grade
+		scales    map[domain.ScaleType]domain.Scales
+		eventKeys map[string]bool
+	}
+
+	// outboxEvent is an undelivered event, claimed by a relay until claimedUntil.
+	outboxEvent struct {
+		domain.Event
+		claimedUntil time.Time
+	}
+
+	// txStore is a store within a unit of work, joining it rather than starting another one.
+	txStore struct {
+		*Store
+	}
+
+	// Option configures a Store.
+	Option func(*Store)
+)
+
+// WithFixtures loads the given fixtures into the store.
+func WithFixtures(fixtures Fixtures) Option {
+	return func(s *Store) {
+		s.load(fixtures)
+	}
+}
+
+// New returns a store holding the default scale and the given fixtures, both of the default tenant. The other
+// tenants start without any scale.
+func New(opts ...Option) *Store {
+	s := &Store{
+		tenants: map[string]*tenantData{
+			auth.DefaultTenant: {
+				scales: map[domain.ScaleType]domain.Scales{
+					domain.DefaultScaleType: DefaultScales.Sorted(),
+				},
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Load appends the grades of the fixtures to the default tenant and replaces the scales they define.
+func (s *Store) Load(fixtures Fixtures) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.load(fixtures)
+}
+
+func (s *Store) load(fixtures Fixtures) {
+	data := s.tenants[auth.DefaultTenant]
+	data.grades = append(data.grades, fixtures.Grades...)
+	for scaleType, scales := range fixtures.Scales {
+		data.scales[scaleType] = scales.Sorted()
+	}
+}
+
+// tenant returns the data of the tenant of ctx, empty when it has none. Callers hold the lock.
+func (s *Store) tenant(ctx context.Context) *tenantData {
+	if data, ok := s.tenants[auth.TenantFrom(ctx)]; ok {
+		return data
+	}
+	return &tenantData{}
+}
+
+// writableTenant returns the data of the tenant of ctx, adding it when it has none. Callers hold the write lock.
+func (s *Store) writableTenant(ctx context.Context) *tenantData {
+	tenant := auth.TenantFrom(ctx)
+	data, ok := s.tenants[tenant]
+	if !ok {
+		data = &tenantData{scales: make(map[domain.ScaleType]domain.Scales)}
+		s.tenants[tenant] = data
+	}
+	return data
+}
+
+// clone returns a deep copy of the data.
+func (d *tenantData) clone() *tenantData {
+	scales := make(map[domain.ScaleType]domain.Scales, len(d.scales))
+	for scaleType, bands := range d.scales {
+		scales[scaleType] = append(domain.Scales(nil), bands...)
+	}
+	eventKeys := make(map[string]bool, len(d.eventKeys))
+	for key := range d.eventKeys {
+		eventKeys[key] = true
+	}
+	return &tenantData{grades: append([]domain.Grade(nil), d.grades...), scales: scales, eventKeys: eventKeys}
+}
+
+// GetGrades ...
+func (s *Store) GetGrades(ctx context.Context, limit, offset int) ([]domain.Grade, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := s.tenant(ctx)
+	total := len(data.grades)
+	if offset >= total {
+		return nil, total, nil
+	}
+	end := offset + limit
+	if end > total {
+		end = total
+	}
+	grades := make([]domain.Grade, end-offset)
+	copy(grades, data.grades[offset:end])
+	return grades, total, nil
+}
+
+// GetStudentGrades ...
+func (s *Store) GetStudentGrades(ctx context.Context, studentID uuid.UUID) ([]domain.Grade, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := s.tenant(ctx)
+	var grades []domain.Grade
+	for _, grade := range data.grades {
+		if grade.StudentID == studentID {
+			grades = append(grades, grade)
+		}
+	}
+	return grades, nil
+}
+
+// GetGradesByStudents fetches the grades of several students at once, keyed by student.
+func (s *Store) GetGradesByStudents(ctx context.Context, studentIDs []uuid.UUID) (map[uuid.UUID][]domain.Grade, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := s.tenant(ctx)
+	wanted := make(map[uuid.UUID]struct{}, len(studentIDs))
+	for _, id := range studentIDs {
+		wanted[id] = struct{}{}
+	}
+	byStudent := make(map[uuid.UUID][]domain.Grade, len(studentIDs))
+	for _, grade := range data.grades {
+		if _, ok := wanted[grade.StudentID]; ok {
+			byStudent[grade.StudentID] = append(byStudent[grade.StudentID], grade)
+		}
+	}
+	return byStudent, nil
+}
+
+// GetTermStudents ...
+func (s *Store) GetTermStudents(ctx context.Context, term string) ([]uuid.UUID, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := s.tenant(ctx)
+	seen := make(map[uuid.UUID]bool)
+	var studentIDs []uuid.UUID
+	for _, grade := range data.grades {
+		if grade.Term == term && !seen[grade.StudentID] {
+			seen[grade.StudentID] = true
+			studentIDs = append(studentIDs, grade.StudentID)
+		}
+	}
+	sort.Slice(studentIDs, func(i, j int) bool {
+		return studentIDs[i].String() < studentIDs[j].String()
+	})
+	return studentIDs, nil
+}
+
+// GetRankPosition ...
+func (s *Store) GetRankPosition(ctx context.Context, studentID, courseID uuid.UUID) (domain.RankPosition, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	grades := domain.CountedGrades(s.tenant(ctx).grades)
+	inCohort := make(map[uuid.UUID]bool)
+	for _, grade := range grades {
+		if courseID == uuid.Nil || grade.CourseID == courseID {
+			inCohort[grade.StudentID] = true
+		}
+	}
+	var (
+		order   []uuid.UUID
+		sums    = make(map[uuid.UUID]int)
+		counts  = make(map[uuid.UUID]int)
+		points  = make(map[uuid.UUID]int)
+		credits = make(map[uuid.UUID]int)
+	)
+	for _, grade := range grades {
+		if !inCohort[grade.StudentID] {
+			continue
+		}
+		if counts[grade.StudentID] == 0 {
+			order = append(order, grade.StudentID)
+		}
+		sums[grade.StudentID] += grade.Grade
+		counts[grade.StudentID]++
+		points[grade.StudentID] += grade.Grade * grade.Credits
+		credits[grade.StudentID] += grade.Credits
+	}
+	// the averages are weighted by credits as GPAs are, and plain when no grade has credits
+	averages := make([]domain.StudentAverage, len(order))
+	for i, id := range order {
+		average := float64(sums[id]) / float64(counts[id])
+		if credits[id] > 0 {
+			average = float64(points[id]) / float64(credits[id])
+		}
+		averages[i] = domain.StudentAverage{StudentID: id, Average: average}
+	}
+	for _, position := range domain.RankAverages(averages) {
+		if position.StudentID == studentID {
+			return position, nil
+		}
+	}
+	return domain.RankPosition{}, domain.ErrStudentNotFound
+}
+
+// GetCourseStats ...
+func (s *Store) GetCourseStats(ctx context.Context, courseID uuid.UUID) (domain.GradeStats, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := s.tenant(ctx)
+	counts := make(map[int]int)
+	for _, grade := range data.grades {
+		if grade.CourseID == courseID && grade.Type.Counted() {
+			counts[grade.Grade]++
+		}
+	}
+	gradeCounts := make([]domain.GradeCount, 0, len(counts))
+	for grade, count := range counts {
+		gradeCounts = append(gradeCounts, domain.GradeCount{Grade: grade, Count: count})
+	}
+	return domain.NewGradeStats(gradeCounts), nil
+}
+
+// GetGradeCounts ...
+func (s *Store) GetGradeCounts(ctx context.Context) ([]domain.GradeCount, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	counts := make(map[int]int)
+	for _, grade := range domain.CountedGrades(s.tenant(ctx).grades) {
+		counts[grade.Grade]++
+	}
+	gradeCounts := make([]domain.GradeCount, 0, len(counts))
+	for grade, count := range counts {
+		gradeCounts = append(gradeCounts, domain.GradeCount{Grade: grade, Count: count})
+	}
+	sort.Slice(gradeCounts, func(i, j int) bool {
+		return gradeCounts[i].Grade < gradeCounts[j].Grade
+	})
+	return gradeCounts, nil
+}
+
+// GetScales ...
+func (s *Store) GetScales(ctx context.Context, scaleType domain.ScaleType) (domain.Scales, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := s.tenant(ctx)
+	scales, ok := data.scales[scaleType]
+	if !ok {
+		return nil, domain.ErrScaleNotFound
+	}
+	return append(domain.Scales(nil), scales...), nil
+}
+
+// GetScalesByTypes fetches the scales of several scale types at once, keyed by scale type.
+// Scale types without any band are left out of the result.
+func (s *Store) GetScalesByTypes(ctx context.Context, scaleTypes []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := s.tenant(ctx)
+	byType := make(map[domain.ScaleType]domain.Scales, len(scaleTypes))
+	for _, scaleType := range scaleTypes {
+		if scales, ok := data.scales[scaleType]; ok {
+			byType[scaleType] = append(domain.Scales(nil), scales...)
+		}
+	}
+	return byType, nil
+}
+
+// SetScales replaces all the bands of the given scale type.
+func (s *Store) SetScales(ctx context.Context, scaleType domain.ScaleType, scales domain.Scales) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := s.writableTenant(ctx)
+	if len(scales) == 0 {
+		delete(data.scales, scaleType)
+		return nil
+	}
+	data.scales[scaleType] = scales.Sorted()
+	return nil
+}
+
+// DeleteScales removes all the bands of the given scale type.
+func (s *Store) DeleteScales(ctx context.Context, scaleType domain.ScaleType) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := s.tenant(ctx)
+	if _, ok := data.scales[scaleType]; !ok {
+		return domain.ErrScaleNotFound
+	}
+	delete(data.scales, scaleType)
+	return nil
+}
+
+// WithTx runs fn as a unit of work: units of work run one at a time, and the store is restored to its state
+// before fn when fn fails. Operations outside of units of work are not isolated from them. Options are ignored.
+func (s *Store) WithTx(_ context.Context, fn func(rdbms.Repository) error, _ ...rdbms.TxOption) error {
+	s.txMu.Lock()
+	defer s.txMu.Unlock()
+
+	s.mu.RLock()
+	tenants := make(map[string]*tenantData, len(s.tenants))
+	for tenant, data := range s.tenants {
+		tenants[tenant] = data.clone()
+	}
+	outbox := append([]outboxEvent(nil), s.outbox...)
+	webhooks := append([]domain.Webhook(nil), s.webhooks...)
+	deliveries := append([]domain.Delivery(nil), s.deliveries...)
+	s.mu.RUnlock()
+
+	if err := fn(txStore{Store: s}); err != nil {
+		s.mu.Lock()
+		s.tenants, s.outbox = tenants, outbox
+		s.webhooks, s.deliveries = webhooks, deliveries
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// WithTx joins the unit of work of the store.
+func (t txStore) WithTx(_ context.Context, fn func(rdbms.Repository) error, _ ...rdbms.TxOption) error {
+	return fn(t)
+}
+
+// InsertGrade records the grade of a student in a course.
+func (s *Store) InsertGrade(ctx context.Context, grade domain.Grade) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := s.writableTenant(ctx)
+	data.grades = append(data.grades, grade)
+	return nil
+}
+
+// UpdateGrade replaces the grade of a student in a course.
+func (s *Store) UpdateGrade(ctx context.Context, grade domain.Grade) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := s.tenant(ctx)
+	found := false
+	for i := range data.grades {
+		if data.grades[i].StudentID == grade.StudentID && data.grades[i].CourseID == grade.CourseID {
+			data.grades[i].Grade, data.grades[i].Term, data.grades[i].Credits = grade.Grade, grade.Term, grade.Credits
+			data.grades[i].Type = grade.Type
+			found = true
+		}
+	}
+	if !found {
+		return domain.ErrGradeNotFound
+	}
+	return nil
+}
+
+// AppendEvents writes the events to the outbox, in the tenant of ctx, dropping those with the key of one already
+// appended.
+func (s *Store) AppendEvents(ctx context.Context, events []domain.Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := s.writableTenant(ctx)
+	for _, event := range events {
+		if event.Key != "" {
+			if data.eventKeys[event.Key] {
+				continue
+			}
+			if data.eventKeys == nil {
+				data.eventKeys = make(map[string]bool)
+			}
+			data.eventKeys[event.Key] = true
+		}
+		event.TenantID = auth.TenantFrom(ctx)
+		s.outbox = append(s.outbox, outboxEvent{Event: event})
+	}
+	return nil
+}
+
+// ClaimEvents returns the oldest undelivered events not claimed by now, and claims them until now + lease.
+// Only the events of the tenant of ctx are seen, those of every tenant with auth.AllTenants.
+func (s *Store) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []domain.Event
+	for i := range s.outbox {
+		if len(events) == limit {
+			break
+		}
+		if s.outbox[i].claimedUntil.After(now) || !sees(ctx, s.outbox[i].TenantID) {
+			continue
+		}
+		s.outbox[i].claimedUntil = now.Add(lease)
+		events = append(events, s.outbox[i].Event)
+	}
+	return events, nil
+}
+
+// MarkEventsDelivered removes the delivered events from the outbox.
+func (s *Store) MarkEventsDelivered(ctx context.Context, ids []uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delivered := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		delivered[id] = struct{}{}
+	}
+	pending := s.outbox[:0:0]
+	for _, event := range s.outbox {
+		if _, ok := delivered[event.ID]; !ok || !sees(ctx, event.TenantID) {
+			pending = append(pending, event)
+		}
+	}
+	s.outbox = pending
+	return nil
+}
+
+// ReleaseEvents gives up the claim on the events.
+func (s *Store) ReleaseEvents(ctx context.Context, ids []uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	released := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		released[id] = struct{}{}
+	}
+	for i := range s.outbox {
+		if _, ok := released[s.outbox[i].ID]; ok && sees(ctx, s.outbox[i].TenantID) {
+			s.outbox[i].claimedUntil = time.Time{}
+		}
+	}
+	return nil
+}
+
+// sees reports whether ctx sees the events, webhooks and deliveries of the tenant: those of its own tenant, or
+// of every tenant with auth.AllTenants.
+func sees(ctx context.Context, tenant string) bool {
+	scope := auth.TenantFrom(ctx)
+	return scope == auth.AllTenants || scope == tenant
+}
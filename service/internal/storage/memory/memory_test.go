@@ -0,0 +1,57 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/rdbmstest"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository(t *testing.T) {
+	rdbmstest.Run(t, func(t *testing.T, grades []domain.Grade) rdbms.Repository {
+		return New(WithFixtures(Fixtures{Grades: grades}))
+	})
+}
+
+func TestStore_Concurrency(t *testing.T) {
+	ctx := context.Background()
+	s := New()
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			s.Load(Fixtures{Grades: []domain.Grade{{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 3}}})
+			require.NoError(t, s.SetScales(ctx, "4.0", domain.Scales{{Min: 0, GPA: "0.0"}}))
+		}()
+		go func() {
+			defer wg.Done()
+			_, _, err := s.GetGrades(ctx, 10, 0)
+			require.NoError(t, err)
+			_, err = s.GetScalesByTypes(ctx, []domain.ScaleType{domain.DefaultScaleType, "4.0"})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	_, total, err := s.GetGrades(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, 50, total)
+}
+
+func TestStore_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	s := New()
+	scales, err := s.GetScales(ctx, domain.DefaultScaleType)
+	require.NoError(t, err)
+	scales[0].GPA = "changed"
+
+	scales, err = s.GetScales(ctx, domain.DefaultScaleType)
+	require.NoError(t, err)
+	require.Equal(t, DefaultScales, scales)
+}
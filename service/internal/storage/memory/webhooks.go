@@ -0,0 +1,167 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// CreateWebhook stores a new webhook, in the tenant of ctx.
+func (s *Store) CreateWebhook(ctx context.Context, webhook domain.Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	webhook.TenantID = auth.TenantFrom(ctx)
+	s.webhooks = append(s.webhooks, webhook)
+	return nil
+}
+
+// GetWebhook ...
+func (s *Store) GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, webhook := range s.webhooks {
+		if webhook.ID == id && webhook.TenantID == auth.TenantFrom(ctx) {
+			return webhook, nil
+		}
+	}
+	return domain.Webhook{}, domain.ErrWebhookNotFound
+}
+
+// ListWebhooks ...
+func (s *Store) ListWebhooks(ctx context.Context) ([]domain.Webhook, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var webhooks []domain.Webhook
+	for _, webhook := range s.webhooks {
+		if webhook.TenantID == auth.TenantFrom(ctx) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	return webhooks, nil
+}
+
+// UpdateWebhook replaces the webhook.
+func (s *Store) UpdateWebhook(ctx context.Context, webhook domain.Webhook) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.webhooks {
+		if s.webhooks[i].ID == webhook.ID && s.webhooks[i].TenantID == auth.TenantFrom(ctx) {
+			webhook.CreatedAt, webhook.TenantID = s.webhooks[i].CreatedAt, s.webhooks[i].TenantID
+			s.webhooks[i] = webhook
+			return nil
+		}
+	}
+	return domain.ErrWebhookNotFound
+}
+
+// DeleteWebhook deletes the webhook and its deliveries.
+func (s *Store) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	webhooks := s.webhooks[:0:0]
+	for _, webhook := range s.webhooks {
+		if webhook.ID != id || webhook.TenantID != auth.TenantFrom(ctx) {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+	if len(webhooks) == len(s.webhooks) {
+		return domain.ErrWebhookNotFound
+	}
+	deliveries := s.deliveries[:0:0]
+	for _, delivery := range s.deliveries {
+		if delivery.WebhookID != id {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	s.webhooks, s.deliveries = webhooks, deliveries
+	return nil
+}
+
+// EnqueueDeliveries ... The deliveries are stored in the tenant of ctx.
+func (s *Store) EnqueueDeliveries(ctx context.Context, deliveries []domain.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	type key struct{ webhookID, eventID uuid.UUID }
+	enqueued := make(map[key]struct{}, len(s.deliveries))
+	for _, delivery := range s.deliveries {
+		enqueued[key{delivery.WebhookID, delivery.EventID}] = struct{}{}
+	}
+	for _, delivery := range deliveries {
+		k := key{delivery.WebhookID, delivery.EventID}
+		if _, ok := enqueued[k]; ok {
+			continue
+		}
+		enqueued[k] = struct{}{}
+		delivery.TenantID = auth.TenantFrom(ctx)
+		s.deliveries = append(s.deliveries, delivery)
+	}
+	return nil
+}
+
+// DueDeliveries ... Only the deliveries of the tenant of ctx are seen, those of every tenant with
+// auth.AllTenants.
+func (s *Store) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var due []domain.Delivery
+	for _, delivery := range s.deliveries {
+		if delivery.Status == domain.DeliveryPending && !delivery.NextAttemptAt.After(now) && sees(ctx, delivery.TenantID) {
+			due = append(due, delivery)
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+	if limit < len(due) {
+		due = due[:limit]
+	}
+	return due, nil
+}
+
+// GetDelivery ...
+func (s *Store) GetDelivery(ctx context.Context, id uuid.UUID) (domain.Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, delivery := range s.deliveries {
+		if delivery.ID == id && delivery.TenantID == auth.TenantFrom(ctx) {
+			return delivery, nil
+		}
+	}
+	return domain.Delivery{}, domain.ErrDeliveryNotFound
+}
+
+// UpdateDelivery records an attempt of the delivery, or its rescheduling.
+func (s *Store) UpdateDelivery(ctx context.Context, delivery domain.Delivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.deliveries {
+		if s.deliveries[i].ID == delivery.ID && s.deliveries[i].TenantID == auth.TenantFrom(ctx) {
+			d := &s.deliveries[i]
+			d.Status, d.Attempts, d.NextAttemptAt = delivery.Status, delivery.Attempts, delivery.NextAttemptAt
+			d.LastStatusCode, d.LastError, d.UpdatedAt = delivery.LastStatusCode, delivery.LastError, delivery.UpdatedAt
+			return nil
+		}
+	}
+	return domain.ErrDeliveryNotFound
+}
+
+// ListDeliveries ...
+func (s *Store) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]domain.Delivery, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var deliveries []domain.Delivery
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		if s.deliveries[i].WebhookID == webhookID && s.deliveries[i].TenantID == auth.TenantFrom(ctx) {
+			deliveries = append(deliveries, s.deliveries[i])
+		}
+	}
+	total := len(deliveries)
+	if offset >= total {
+		return nil, total, nil
+	}
+	return deliveries[offset:min(offset+limit, total)], total, nil
+}
@@ -0,0 +1,123 @@
+// Package migration applies the migrations of the configured database driver.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sync"
+
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/internal/storage/migration/mysql"
+	"github.com/mnabbasabadi/grading/service/internal/storage/migration/postgres"
+	"github.com/mnabbasabadi/grading/service/internal/storage/migration/sqlite"
+	"github.com/pressly/goose/v3"
+)
+
+// lockID identifies the postgres advisory lock serializing the migrations of concurrent instances.
+const lockID int64 = 0x67726164696e67 // "grading"
+
+// Commands are the goose commands Run supports.
+var Commands = []string{"up", "up-by-one", "up-to", "down", "down-to", "redo", "reset", "status", "version"}
+
+// drivers are the backends having migrations, keyed by their directory name.
+var drivers = map[db.Driver]string{
+	db.Postgres: "postgres",
+	db.MySQL:    "mysql",
+	db.SQLite:   "sqlite",
+}
+
+// goose is configured through package state.
+var gooseMu sync.Mutex
+
+// Up applies all the pending migrations of the given driver.
+func Up(ctx context.Context, driver db.Driver, conn *sql.DB) error {
+	return Run(ctx, driver, conn, "up")
+}
+
+// Run runs a goose command, see Commands, on the migrations of the given driver. On postgres, it holds an
+// advisory lock while running, so instances starting together apply the migrations once. The lock takes a
+// connection of conn, which must allow at least two.
+func Run(ctx context.Context, driver db.Driver, conn *sql.DB, command string, args ...string) error {
+	if !supported(command) {
+		return fmt.Errorf("unsupported migration command %q", command)
+	}
+	if driver == "" {
+		driver = db.Postgres
+	}
+	migrations, dialect, err := source(driver)
+	if err != nil {
+		return err
+	}
+
+	gooseMu.Lock()
+	defer gooseMu.Unlock()
+	goose.SetBaseFS(migrations)
+	if err := goose.SetDialect(dialect); err != nil {
+		return err
+	}
+	return withLock(ctx, driver, conn, func() error {
+		return goose.RunContext(ctx, command, conn, ".", args...)
+	})
+}
+
+// Create writes a blank SQL migration named name for every driver, in the driver directories of dir.
+// The migrations are numbered sequentially, the drivers keeping the same versions.
+func Create(dir, name string) error {
+	gooseMu.Lock()
+	defer gooseMu.Unlock()
+	goose.SetBaseFS(nil)
+	goose.SetSequential(true)
+	for _, driver := range []db.Driver{db.Postgres, db.MySQL, db.SQLite} {
+		if err := goose.Create(nil, filepath.Join(dir, drivers[driver]), name, "sql"); err != nil {
+			return fmt.Errorf("%s: %w", driver, err)
+		}
+	}
+	return nil
+}
+
+func source(driver db.Driver) (fs.FS, string, error) {
+	switch driver {
+	case db.Postgres:
+		return postgres.Migrations, postgres.Dialect, nil
+	case db.MySQL:
+		return mysql.Migrations, mysql.Dialect, nil
+	case db.SQLite:
+		return sqlite.Migrations, sqlite.Dialect, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported database driver %q", driver)
+	}
+}
+
+func supported(command string) bool {
+	for _, c := range Commands {
+		if c == command {
+			return true
+		}
+	}
+	return false
+}
+
+// withLock runs fn holding the migration lock of the database, when it has one.
+func withLock(ctx context.Context, driver db.Driver, conn *sql.DB, fn func() error) error {
+	if driver != db.Postgres {
+		return fn()
+	}
+	// advisory locks belong to a session, so lock and unlock on the same connection
+	c, err := conn.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring a connection for the migration lock: %w", err)
+	}
+	defer func() {
+		_ = c.Close()
+	}()
+	if _, err := c.ExecContext(ctx, "select pg_advisory_lock($1)", lockID); err != nil {
+		return fmt.Errorf("acquiring the migration lock: %w", err)
+	}
+	defer func() {
+		_, _ = c.ExecContext(context.Background(), "select pg_advisory_unlock($1)", lockID)
+	}()
+	return fn()
+}
@@ -0,0 +1,150 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	testCases := map[string]struct {
+		commands [][]string
+		version  int64
+		err      bool
+	}{
+		"up": {
+			commands: [][]string{{"up"}},
+			version:  12,
+		},
+		"up by one": {
+			commands: [][]string{{"up-by-one"}},
+			version:  1,
+		},
+		"down": {
+			commands: [][]string{{"up"}, {"down"}},
+			version:  11,
+		},
+		"down to": {
+			commands: [][]string{{"up"}, {"down-to", "0"}},
+			version:  0,
+		},
+		"redo": {
+			commands: [][]string{{"up"}, {"redo"}},
+			version:  12,
+		},
+		"status and version": {
+			commands: [][]string{{"up-to", "1"}, {"status"}, {"version"}},
+			version:  1,
+		},
+		"unsupported command": {
+			commands: [][]string{{"create", "foo"}},
+			err:      true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			conn, err := db.ConnectToSQLite(db.WithDatabase(":memory:"))
+			require.NoError(t, err)
+			defer func() {
+				_ = conn.Close()
+			}()
+
+			for _, command := range tc.commands {
+				err = Run(context.Background(), db.SQLite, conn.DB, command[0], command[1:]...)
+				if tc.err {
+					require.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+			}
+			version, err := goose.GetDBVersion(conn.DB)
+			require.NoError(t, err)
+			require.Equal(t, tc.version, version)
+		})
+	}
+}
+
+func TestRun_UnsupportedDriver(t *testing.T) {
+	require.Error(t, Run(context.Background(), db.Memory, nil, "up"))
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	for _, driver := range drivers {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, driver), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, driver, "00001_init.sql"), []byte("-- +goose Up\n"), 0o600))
+	}
+	require.NoError(t, Create(dir, "add course"))
+
+	for _, driver := range drivers {
+		_, err := os.Stat(filepath.Join(dir, driver, "00002_add_course.sql"))
+		require.NoError(t, err, driver)
+	}
+}
+
+func TestReversible_SQLite(t *testing.T) {
+	conn, err := db.ConnectToSQLite(db.WithDatabase(":memory:"))
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	testReversible(t, db.SQLite, conn.DB)
+}
+
+// language=sql
+var tablesQueries = map[db.Driver]string{
+	db.Postgres: `select table_name from information_schema.tables where table_schema = current_schema()`,
+	db.MySQL:    `select table_name from information_schema.tables where table_schema = database()`,
+	db.SQLite:   `select name from sqlite_master where type = 'table' and name not like 'sqlite_%'`,
+}
+
+// testReversible applies all the migrations of the driver, rolls them all back and applies them again,
+// checking the rollback leaves no table behind but the goose one.
+func testReversible(t *testing.T, driver db.Driver, conn *sql.DB) {
+	t.Helper()
+	ctx := context.Background()
+
+	require.NoError(t, Up(ctx, driver, conn))
+	latest, err := goose.GetDBVersion(conn)
+	require.NoError(t, err)
+	schema := tables(t, driver, conn)
+	require.Contains(t, schema, "grade")
+	require.Contains(t, schema, "scale")
+
+	require.NoError(t, Run(ctx, driver, conn, "reset"))
+	version, err := goose.GetDBVersion(conn)
+	require.NoError(t, err)
+	require.Zero(t, version)
+	require.Equal(t, []string{goose.TableName()}, tables(t, driver, conn))
+
+	require.NoError(t, Up(ctx, driver, conn))
+	version, err = goose.GetDBVersion(conn)
+	require.NoError(t, err)
+	require.Equal(t, latest, version)
+	require.ElementsMatch(t, schema, tables(t, driver, conn))
+}
+
+func tables(t *testing.T, driver db.Driver, conn *sql.DB) []string {
+	t.Helper()
+	rows, err := conn.Query(tablesQueries[driver])
+	require.NoError(t, err)
+	defer func() {
+		_ = rows.Close()
+	}()
+	var names []string
+	for rows.Next() {
+		var name string
+		require.NoError(t, rows.Scan(&name))
+		names = append(names, name)
+	}
+	require.NoError(t, rows.Err())
+	return names
+}
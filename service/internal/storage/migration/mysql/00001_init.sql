@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE grade
+(
+    id                    INT AUTO_INCREMENT PRIMARY KEY,
+    student_id            CHAR(36)            NOT NULL,
+    course_id             CHAR(36)            NOT NULL,
+    grade                 INTEGER             NOT NULL,
+    created_at            DATETIME(6)         NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    updated_at            DATETIME(6)         NOT NULL DEFAULT CURRENT_TIMESTAMP(6)
+);
+
+CREATE INDEX grade_student_id_idx ON grade (student_id);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS grade;
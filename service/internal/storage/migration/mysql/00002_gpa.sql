@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE scale
+(
+    id INT AUTO_INCREMENT PRIMARY KEY,
+    min INT NOT NULL,
+    type ENUM('default','4.0','4.3','5.0','7.0','10.0','ECTS') NOT NULL,
+    gpa VARCHAR(10) NOT NULL
+);
+
+CREATE INDEX scale_type_idx ON scale (type);
+
+INSERT INTO scale (min, gpa, type) VALUES (0, 'F', 'default');
+INSERT INTO scale (min, gpa, type) VALUES (1, 'D', 'default');
+INSERT INTO scale (min, gpa, type) VALUES (2, 'C', 'default');
+INSERT INTO scale (min, gpa, type) VALUES (3, 'B', 'default');
+INSERT INTO scale (min, gpa, type) VALUES (4, 'A', 'default');
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS scale;
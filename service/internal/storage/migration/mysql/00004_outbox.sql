@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- events are written in the transaction of the change they record, and relayed downstream in seq order
+CREATE TABLE outbox
+(
+    seq                   BIGINT AUTO_INCREMENT PRIMARY KEY,
+    id                    CHAR(36)            NOT NULL UNIQUE,
+    type                  VARCHAR(64)         NOT NULL,
+    payload               JSON                NOT NULL,
+    occurred_at           DATETIME(6)         NOT NULL,
+    delivered_at          DATETIME(6)         NULL,
+    INDEX outbox_pending_idx (delivered_at, seq)
+);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS outbox;
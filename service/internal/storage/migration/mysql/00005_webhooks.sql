@@ -0,0 +1,39 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE webhook
+(
+    id                    CHAR(36) PRIMARY KEY,
+    url                   TEXT                NOT NULL,
+    secret                VARCHAR(255)        NOT NULL,
+    event_types           VARCHAR(255)        NOT NULL DEFAULT '',
+    active                BOOLEAN             NOT NULL DEFAULT TRUE,
+    created_at            DATETIME(6)         NOT NULL,
+    updated_at            DATETIME(6)         NOT NULL
+);
+
+-- an event is delivered once per webhook, however many times the relay hands it over
+CREATE TABLE webhook_delivery
+(
+    id                    CHAR(36) PRIMARY KEY,
+    webhook_id            CHAR(36)            NOT NULL,
+    event_id              CHAR(36)            NOT NULL,
+    event_type            VARCHAR(64)         NOT NULL,
+    body                  MEDIUMTEXT          NOT NULL,
+    status                VARCHAR(16)         NOT NULL,
+    attempts              INTEGER             NOT NULL DEFAULT 0,
+    next_attempt_at       DATETIME(6)         NOT NULL,
+    last_status_code      INTEGER             NOT NULL DEFAULT 0,
+    last_error            TEXT                NOT NULL,
+    created_at            DATETIME(6)         NOT NULL,
+    updated_at            DATETIME(6)         NOT NULL,
+    UNIQUE (webhook_id, event_id),
+    INDEX webhook_delivery_due_idx (status, next_attempt_at),
+    INDEX webhook_delivery_log_idx (webhook_id, created_at),
+    FOREIGN KEY (webhook_id) REFERENCES webhook (id) ON DELETE CASCADE
+);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook;
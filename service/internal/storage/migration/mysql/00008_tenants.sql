@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the institution every grade and scale belongs to, the rows from before tenants belonging to the default one
+ALTER TABLE grade ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default';
+ALTER TABLE scale ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT 'default';
+
+CREATE INDEX grade_tenant_idx ON grade (tenant_id, created_at, id);
+CREATE INDEX scale_tenant_type_idx ON scale (tenant_id, type);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX scale_tenant_type_idx ON scale;
+DROP INDEX grade_tenant_idx ON grade;
+ALTER TABLE scale DROP COLUMN tenant_id;
+ALTER TABLE grade DROP COLUMN tenant_id;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the outcome a grade records, empty for a numeric grade, the only ones counted in GPAs
+ALTER TABLE grade ADD COLUMN type VARCHAR(16) NOT NULL DEFAULT '';
+ALTER TABLE grade ADD CONSTRAINT grade_type_check CHECK (type IN ('', 'pass', 'fail', 'incomplete', 'withdrawn', 'audit'));
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE grade DROP CHECK grade_type_check;
+ALTER TABLE grade DROP COLUMN type;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- drops the account and portfolio tables of an unrelated schema once shipped next to the migrations
+DROP TABLE IF EXISTS portfolio;
+DROP TABLE IF EXISTS account;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+-- the dropped tables are not part of the schema, there is nothing to restore
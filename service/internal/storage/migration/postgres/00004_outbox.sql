@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- events are written in the transaction of the change they record, and relayed downstream in seq order
+CREATE TABLE outbox
+(
+    seq                   BIGSERIAL PRIMARY KEY,
+    id                    UUID                NOT NULL UNIQUE,
+    type                  VARCHAR(64)         NOT NULL,
+    payload               JSONB               NOT NULL,
+    occurred_at           TIMESTAMP           NOT NULL,
+    delivered_at          TIMESTAMP
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (seq) WHERE delivered_at IS NULL;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX IF EXISTS outbox_pending_idx;
+DROP TABLE IF EXISTS outbox;
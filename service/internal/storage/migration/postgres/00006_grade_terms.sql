@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the term a grade was given in, named to sort chronologically, and the credits of the course
+ALTER TABLE grade ADD COLUMN term VARCHAR(32) NOT NULL DEFAULT '';
+ALTER TABLE grade ADD COLUMN credits INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX grade_term_idx ON grade (term);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX IF EXISTS grade_term_idx;
+ALTER TABLE grade DROP COLUMN credits;
+ALTER TABLE grade DROP COLUMN term;
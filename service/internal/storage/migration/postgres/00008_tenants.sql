@@ -0,0 +1,38 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the institution every grade and scale belongs to, the rows from before tenants belonging to the default one
+ALTER TABLE grade ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE scale ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX grade_tenant_idx ON grade (tenant_id, created_at, id);
+CREATE INDEX scale_tenant_type_idx ON scale (tenant_id, type);
+
+-- the service scopes its transactions to a tenant with set_config('grading.tenant_id', ...), and the policies
+-- only let them see and write the rows of that tenant. FORCE subjects the owner of the tables to them too;
+-- superusers and roles with BYPASSRLS are not.
+ALTER TABLE grade ENABLE ROW LEVEL SECURITY;
+ALTER TABLE grade FORCE ROW LEVEL SECURITY;
+CREATE POLICY grade_tenant_isolation ON grade
+    USING (tenant_id = current_setting('grading.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('grading.tenant_id', true));
+
+ALTER TABLE scale ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scale FORCE ROW LEVEL SECURITY;
+CREATE POLICY scale_tenant_isolation ON scale
+    USING (tenant_id = current_setting('grading.tenant_id', true))
+    WITH CHECK (tenant_id = current_setting('grading.tenant_id', true));
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP POLICY IF EXISTS scale_tenant_isolation ON scale;
+ALTER TABLE scale NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE scale DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS grade_tenant_isolation ON grade;
+ALTER TABLE grade NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE grade DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX IF EXISTS scale_tenant_type_idx;
+DROP INDEX IF EXISTS grade_tenant_idx;
+ALTER TABLE scale DROP COLUMN tenant_id;
+ALTER TABLE grade DROP COLUMN tenant_id;
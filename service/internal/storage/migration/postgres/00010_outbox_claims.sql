@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- a relay claims a batch of events until claimed_until, and delivers it outside of the claiming transaction
+ALTER TABLE outbox ADD COLUMN claimed_until TIMESTAMP;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE outbox DROP COLUMN claimed_until;
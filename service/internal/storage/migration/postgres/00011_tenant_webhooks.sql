@@ -0,0 +1,46 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the tenant every event, webhook and delivery belongs to, the rows from before belonging to the default one
+ALTER TABLE outbox ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook_delivery ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX webhook_tenant_idx ON webhook (tenant_id, created_at, id);
+
+-- the policies of the grade and scale, except that the relay and the deliverer scope their transactions to
+-- the '*' tenant to see and write the rows of every tenant; no request can be scoped to it.
+ALTER TABLE outbox ENABLE ROW LEVEL SECURITY;
+ALTER TABLE outbox FORCE ROW LEVEL SECURITY;
+CREATE POLICY outbox_tenant_isolation ON outbox
+    USING (tenant_id = current_setting('grading.tenant_id', true) OR current_setting('grading.tenant_id', true) = '*')
+    WITH CHECK (tenant_id = current_setting('grading.tenant_id', true) OR current_setting('grading.tenant_id', true) = '*');
+
+ALTER TABLE webhook ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook FORCE ROW LEVEL SECURITY;
+CREATE POLICY webhook_tenant_isolation ON webhook
+    USING (tenant_id = current_setting('grading.tenant_id', true) OR current_setting('grading.tenant_id', true) = '*')
+    WITH CHECK (tenant_id = current_setting('grading.tenant_id', true) OR current_setting('grading.tenant_id', true) = '*');
+
+ALTER TABLE webhook_delivery ENABLE ROW LEVEL SECURITY;
+ALTER TABLE webhook_delivery FORCE ROW LEVEL SECURITY;
+CREATE POLICY webhook_delivery_tenant_isolation ON webhook_delivery
+    USING (tenant_id = current_setting('grading.tenant_id', true) OR current_setting('grading.tenant_id', true) = '*')
+    WITH CHECK (tenant_id = current_setting('grading.tenant_id', true) OR current_setting('grading.tenant_id', true) = '*');
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP POLICY IF EXISTS webhook_delivery_tenant_isolation ON webhook_delivery;
+ALTER TABLE webhook_delivery NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE webhook_delivery DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS webhook_tenant_isolation ON webhook;
+ALTER TABLE webhook NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE webhook DISABLE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS outbox_tenant_isolation ON outbox;
+ALTER TABLE outbox NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE outbox DISABLE ROW LEVEL SECURITY;
+
+DROP INDEX IF EXISTS webhook_tenant_idx;
+ALTER TABLE webhook_delivery DROP COLUMN tenant_id;
+ALTER TABLE webhook DROP COLUMN tenant_id;
+ALTER TABLE outbox DROP COLUMN tenant_id;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the key of the change an event records, an event with the key of one already in the outbox of its tenant
+-- being dropped; the events without key are never dropped
+ALTER TABLE outbox ADD COLUMN idempotency_key TEXT NULL;
+
+CREATE UNIQUE INDEX outbox_key_idx ON outbox (tenant_id, idempotency_key);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX IF EXISTS outbox_key_idx;
+ALTER TABLE outbox DROP COLUMN idempotency_key;
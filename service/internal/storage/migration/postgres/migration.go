@@ -8,14 +8,19 @@ import (
 	"github.com/pressly/goose/v3"
 )
 
+// Migrations are the goose migrations of the postgres schema.
+//
 //go:embed *.sql
-var embedMigrations embed.FS
+var Migrations embed.FS
+
+// Dialect is the goose dialect of the migrations.
+const Dialect = "postgres"
 
 // GooseUP ...
 func GooseUP(db *sql.DB) error {
-	goose.SetBaseFS(embedMigrations)
+	goose.SetBaseFS(Migrations)
 
-	if err := goose.SetDialect("postgres"); err != nil {
+	if err := goose.SetDialect(Dialect); err != nil {
 		return err
 	}
 
@@ -0,0 +1,59 @@
+//go:build integration
+// +build integration
+
+package migration
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/orlangure/gnomock"
+	"github.com/orlangure/gnomock/preset/mysql"
+	"github.com/orlangure/gnomock/preset/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReversible(t *testing.T) {
+	testCases := map[string]struct {
+		preset  gnomock.Preset
+		driver  db.Driver
+		options []db.Option
+	}{
+		"postgres": {
+			preset:  postgres.Preset(postgres.WithUser("gnomock", "gnomick"), postgres.WithDatabase("grading")),
+			driver:  db.Postgres,
+			options: []db.Option{db.WithSSlMode(db.Disable)},
+		},
+		"mysql": {
+			preset: mysql.Preset(mysql.WithUser("gnomock", "gnomick"), mysql.WithDatabase("grading")),
+			driver: db.MySQL,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			container, err := gnomock.Start(tc.preset)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				_ = gnomock.Stop(container)
+			})
+
+			options := append([]db.Option{
+				db.WithUser("gnomock"),
+				db.WithPassword("gnomick"),
+				db.WithHost(container.Host),
+				db.WithPort(strconv.Itoa(container.DefaultPort())),
+				db.WithDatabase("grading"),
+			}, tc.options...)
+			conn, err := db.Connect(tc.driver, options...)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				_ = conn.Close()
+			})
+
+			testReversible(t, tc.driver, conn.DB)
+		})
+	}
+}
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE grade
+(
+    id                    INTEGER PRIMARY KEY AUTOINCREMENT,
+    student_id            TEXT                NOT NULL,
+    course_id             TEXT                NOT NULL,
+    grade                 INTEGER             NOT NULL,
+    created_at            TEXT                NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    updated_at            TEXT                NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now'))
+);
+
+CREATE INDEX grade_student_id_idx ON grade (student_id);
+
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP TABLE IF EXISTS grade;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- events are written in the transaction of the change they record, and relayed downstream in seq order
+CREATE TABLE outbox
+(
+    seq                   INTEGER PRIMARY KEY AUTOINCREMENT,
+    id                    TEXT                NOT NULL UNIQUE,
+    type                  TEXT                NOT NULL,
+    payload               BLOB                NOT NULL,
+    occurred_at           DATETIME            NOT NULL,
+    delivered_at          DATETIME
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (seq) WHERE delivered_at IS NULL;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX IF EXISTS outbox_pending_idx;
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+CREATE TABLE webhook
+(
+    id                    TEXT PRIMARY KEY,
+    url                   TEXT                NOT NULL,
+    secret                TEXT                NOT NULL,
+    event_types           TEXT                NOT NULL DEFAULT '',
+    active                BOOLEAN             NOT NULL DEFAULT TRUE,
+    created_at            DATETIME            NOT NULL,
+    updated_at            DATETIME            NOT NULL
+);
+
+-- an event is delivered once per webhook, however many times the relay hands it over
+CREATE TABLE webhook_delivery
+(
+    id                    TEXT PRIMARY KEY,
+    webhook_id            TEXT                NOT NULL REFERENCES webhook (id) ON DELETE CASCADE,
+    event_id              TEXT                NOT NULL,
+    event_type            TEXT                NOT NULL,
+    body                  BLOB                NOT NULL,
+    status                TEXT                NOT NULL,
+    attempts              INTEGER             NOT NULL DEFAULT 0,
+    next_attempt_at       DATETIME            NOT NULL,
+    last_status_code      INTEGER             NOT NULL DEFAULT 0,
+    last_error            TEXT                NOT NULL DEFAULT '',
+    created_at            DATETIME            NOT NULL,
+    updated_at            DATETIME            NOT NULL,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX webhook_delivery_due_idx ON webhook_delivery (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX webhook_delivery_log_idx ON webhook_delivery (webhook_id, created_at);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX IF EXISTS webhook_delivery_log_idx;
+DROP INDEX IF EXISTS webhook_delivery_due_idx;
+DROP TABLE IF EXISTS webhook_delivery;
+DROP TABLE IF EXISTS webhook;
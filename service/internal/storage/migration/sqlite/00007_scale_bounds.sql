@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the optional highest grade of a band, and whether the bounds of the band are exclusive
+ALTER TABLE scale ADD COLUMN max INTEGER;
+ALTER TABLE scale ADD COLUMN min_exclusive BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE scale ADD COLUMN max_exclusive BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE scale DROP COLUMN max_exclusive;
+ALTER TABLE scale DROP COLUMN min_exclusive;
+ALTER TABLE scale DROP COLUMN max;
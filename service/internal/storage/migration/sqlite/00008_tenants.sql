@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the institution every grade and scale belongs to, the rows from before tenants belonging to the default one
+ALTER TABLE grade ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE scale ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX grade_tenant_idx ON grade (tenant_id, created_at, id);
+CREATE INDEX scale_tenant_type_idx ON scale (tenant_id, type);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX IF EXISTS scale_tenant_type_idx;
+DROP INDEX IF EXISTS grade_tenant_idx;
+ALTER TABLE scale DROP COLUMN tenant_id;
+ALTER TABLE grade DROP COLUMN tenant_id;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the outcome a grade records, empty for a numeric grade, the only ones counted in GPAs. SQLite can not drop a
+-- column used by a CHECK constraint, so the types are only checked by the service.
+ALTER TABLE grade ADD COLUMN type TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+ALTER TABLE grade DROP COLUMN type;
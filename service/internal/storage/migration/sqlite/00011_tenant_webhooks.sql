@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in section 'Up' is executed when this migration is applied
+-- the tenant every event, webhook and delivery belongs to, the rows from before belonging to the default one
+ALTER TABLE outbox ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE webhook_delivery ADD COLUMN tenant_id TEXT NOT NULL DEFAULT 'default';
+
+CREATE INDEX webhook_tenant_idx ON webhook (tenant_id, created_at, id);
+
+-- +goose Down
+-- SQL section 'Down' is executed when this migration is rolled back
+
+DROP INDEX IF EXISTS webhook_tenant_idx;
+ALTER TABLE webhook_delivery DROP COLUMN tenant_id;
+ALTER TABLE webhook DROP COLUMN tenant_id;
+ALTER TABLE outbox DROP COLUMN tenant_id;
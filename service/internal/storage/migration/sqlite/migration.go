@@ -0,0 +1,28 @@
+// Package sqlite ...
+package sqlite
+
+import (
+	"database/sql"
+	"embed"
+
+	"github.com/pressly/goose/v3"
+)
+
+// Migrations are the goose migrations of the sqlite schema.
+//
+//go:embed *.sql
+var Migrations embed.FS
+
+// Dialect is the goose dialect of the migrations.
+const Dialect = "sqlite3"
+
+// GooseUP ...
+func GooseUP(db *sql.DB) error {
+	goose.SetBaseFS(Migrations)
+
+	if err := goose.SetDialect(Dialect); err != nil {
+		return err
+	}
+
+	return goose.Up(db, ".")
+}
@@ -0,0 +1,31 @@
+// Package mysql is the implementation of the storage layer using MySQL, see qmark.
+package mysql
+
+import (
+	"errors"
+
+	driver "github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/qmark"
+)
+
+// dialect locks the claimed rows, and retries the units of work on deadlocks and lock wait timeouts.
+var dialect = qmark.Dialect{
+	Float:        "double",
+	Now:          "now(6)",
+	InsertIgnore: "insert ignore",
+	SkipLocked:   "for update skip locked",
+	IsRetryable:  retryable,
+}
+
+// New ...
+func New(db *sqlx.DB) rdbms.Repository {
+	return qmark.New(db, dialect)
+}
+
+// retryable reports the deadlocks (1213) and lock wait timeouts (1205), which a retried transaction may not run into.
+func retryable(err error) bool {
+	var mysqlErr *driver.MySQLError
+	return errors.As(err, &mysqlErr) && (mysqlErr.Number == 1213 || mysqlErr.Number == 1205)
+}
@@ -0,0 +1,42 @@
+//go:build integration
+// +build integration
+
+package mysql
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	migration "github.com/mnabbasabadi/grading/service/internal/storage/migration/mysql"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/rdbmstest"
+	"github.com/orlangure/gnomock"
+	preset "github.com/orlangure/gnomock/preset/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository(t *testing.T) {
+	container, err := gnomock.Start(preset.Preset(
+		preset.WithUser("gnomock", "gnomick"),
+		preset.WithDatabase("grading"),
+	))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = gnomock.Stop(container)
+	})
+
+	conn, err := db.ConnectToMySQL(
+		db.WithUser("gnomock"),
+		db.WithPassword("gnomick"),
+		db.WithHost(container.Host),
+		db.WithPort(strconv.Itoa(container.DefaultPort())),
+		db.WithDatabase("grading"),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	require.NoError(t, migration.GooseUP(conn.DB))
+
+	rdbmstest.Run(t, rdbmstest.SQLSetup(conn, New(conn)))
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// language=postgresql
+const insertEvent = `insert into outbox (id, type, payload, occurred_at, tenant_id, idempotency_key)
+values ($1, $2, $3, $4, $5, $6)
+on conflict (tenant_id, idempotency_key) do nothing`
+
+// AppendEvents writes the events to the outbox, in the tenant of ctx, dropping those with the key of one already
+// in it.
+func (w Writer) AppendEvents(ctx context.Context, events []domain.Event) error {
+	return w.atomic(ctx, func(tx rdbms.DBTX) error {
+		for _, event := range events {
+			key := sql.NullString{String: event.Key, Valid: event.Key != ""}
+			if _, err := tx.ExecContext(ctx, insertEvent, event.ID, event.Type, []byte(event.Payload), event.OccurredAt,
+				auth.TenantFrom(ctx), key); err != nil {
+				return fmt.Errorf("failed to insert event: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// language=postgresql
+const claimableEvents = `select id, type, payload, occurred_at, tenant_id from outbox
+where delivered_at is null and (claimed_until is null or claimed_until <= $1) order by seq limit $2
+for update skip locked`
+
+// language=postgresql
+const claimEvents = `update outbox set claimed_until=$1 where id = any($2::uuid[])`
+
+// ClaimEvents returns the oldest undelivered events not claimed by now, and claims them until now + lease. The
+// events are locked until the unit of work ends and skipped by the other units of work meanwhile, so concurrent
+// relays don't claim the same events. Only the events of the tenant of ctx are seen, those of every tenant
+// with auth.AllTenants.
+func (w Writer) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error) {
+	var events []domain.Event
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		if err := tx.SelectContext(ctx, &events, claimableEvents, now, limit); err != nil {
+			return fmt.Errorf("failed to get pending events: %w", err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		if _, err := tx.ExecContext(ctx, claimEvents, now.Add(lease), pq.Array(eventIDs(events))); err != nil {
+			return fmt.Errorf("failed to claim events: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// language=postgresql
+const markEventsDelivered = `update outbox set delivered_at=now() where id = any($1::uuid[])`
+
+// MarkEventsDelivered records the events were delivered.
+func (w Writer) MarkEventsDelivered(ctx context.Context, ids []uuid.UUID) error {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = id.String()
+	}
+	return w.atomic(ctx, func(tx rdbms.DBTX) error {
+		if _, err := tx.ExecContext(ctx, markEventsDelivered, pq.Array(strIDs)); err != nil {
+			return fmt.Errorf("failed to mark events delivered: %w", err)
+		}
+		return nil
+	})
+}
+
+// language=postgresql
+const releaseEvents = `update outbox set claimed_until=null where id = any($1::uuid[])`
+
+// ReleaseEvents gives up the claim on the events.
+func (w Writer) ReleaseEvents(ctx context.Context, ids []uuid.UUID) error {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = id.String()
+	}
+	return w.atomic(ctx, func(tx rdbms.DBTX) error {
+		if _, err := tx.ExecContext(ctx, releaseEvents, pq.Array(strIDs)); err != nil {
+			return fmt.Errorf("failed to release events: %w", err)
+		}
+		return nil
+	})
+}
+
+func eventIDs(events []domain.Event) []string {
+	ids := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = event.ID.String()
+	}
+	return ids
+}
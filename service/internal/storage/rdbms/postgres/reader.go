@@ -2,16 +2,25 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	kitDB "github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
 	"github.com/mnabbasabadi/grading/service/shared/domain"
 )
 
 type (
 	// Reader ...
 	Reader struct {
-		db *sqlx.DB
+		db       *sqlx.DB
+		tx       *sqlx.Tx
+		replicas *kitDB.Cluster
 	}
 )
 
@@ -22,47 +31,249 @@ func NewReader(db *sqlx.DB) Reader {
 	}
 }
 
+// node returns the database to read from, a replica when there are healthy ones.
+func (r Reader) node(ctx context.Context) *sqlx.DB {
+	if r.replicas != nil {
+		return r.replicas.Reader(ctx)
+	}
+	return r.db
+}
+
+// scoped runs fn on the transaction of the unit of work if any, in a read-only transaction of its own scoped to
+// the tenant of ctx otherwise, see scope.
+func (r Reader) scoped(ctx context.Context, fn func(rdbms.DBTX) error) error {
+	if r.tx != nil {
+		return fn(r.tx)
+	}
+	return rdbms.RunInTx(ctx, r.node(ctx), rdbms.NewTxOptions(rdbms.ReadOnly(), rdbms.MaxAttempts(1)), nil, func(tx *sqlx.Tx) error {
+		if err := scope(ctx, tx); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
 // language=postgresql
-const getgpas = `select student_id, course_id, grade from grade order by created_at limit :limit offset :offset`
+const getgpas = `select student_id, course_id, grade, term, credits, type from grade where tenant_id=:tenant_id
+order by created_at, id limit :limit offset :offset`
 
 // language=postgresql
-const totalgpas = `select count(*) from grade`
+const totalgpas = `select count(*) from grade where tenant_id=$1`
 
 // GetGrades ...
 func (r Reader) GetGrades(ctx context.Context, limit, offset int) ([]domain.Grade, int, error) {
 	type params struct {
-		Limit  int `db:"limit"`
-		Offset int `db:"offset"`
+		TenantID string `db:"tenant_id"`
+		Limit    int    `db:"limit"`
+		Offset   int    `db:"offset"`
 	}
 	p := params{
-		Limit:  limit,
-		Offset: offset,
+		TenantID: auth.TenantFrom(ctx),
+		Limit:    limit,
+		Offset:   offset,
 	}
 
-	stmt, err := r.db.PrepareNamedContext(ctx, getgpas)
+	var (
+		gpas  []domain.Grade
+		total int
+	)
+	// the page and the total must come from the same node
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		stmt, err := conn.PrepareNamedContext(ctx, getgpas)
+		if err != nil {
+			return fmt.Errorf("failed to prepare statement: %w", err)
+		}
+		if err := stmt.SelectContext(ctx, &gpas, p); err != nil {
+			return fmt.Errorf("failed to get gpas: %w", err)
+		}
+		if err := conn.QueryRowxContext(ctx, totalgpas, p.TenantID).Scan(&total); err != nil {
+			return fmt.Errorf("failed to get total: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to prepare statement: %w", err)
+		return nil, 0, err
 	}
-	var gpas []domain.Grade
-	if err := stmt.SelectContext(ctx, &gpas, p); err != nil {
-		return nil, 0, fmt.Errorf("failed to get gpas: %w", err)
+	return gpas, total, nil
+}
+
+// language=postgresql
+const getStudentGrades = `select student_id, course_id, grade, term, credits, type from grade where student_id=$1 and tenant_id=$2 order by created_at, id`
+
+// GetStudentGrades ...
+func (r Reader) GetStudentGrades(ctx context.Context, studentID uuid.UUID) ([]domain.Grade, error) {
+	var grades []domain.Grade
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		return conn.SelectContext(ctx, &grades, getStudentGrades, studentID, auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get student grades: %w", err)
 	}
+	return grades, nil
+}
+
+// language=postgresql
+const getStudentsGrades = `select student_id, course_id, grade, term, credits, type from grade
+where student_id = any($1::uuid[]) and tenant_id=$2 order by created_at, id`
 
-	var total int
-	if err := r.db.QueryRowxContext(ctx, totalgpas).Scan(&total); err != nil {
-		return nil, 0, fmt.Errorf("failed to get total: %w", err)
+// GetGradesByStudents fetches the grades of several students at once, keyed by student.
+func (r Reader) GetGradesByStudents(ctx context.Context, studentIDs []uuid.UUID) (map[uuid.UUID][]domain.Grade, error) {
+	ids := make([]string, len(studentIDs))
+	for i, id := range studentIDs {
+		ids[i] = id.String()
 	}
+	var grades []domain.Grade
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		return conn.SelectContext(ctx, &grades, getStudentsGrades, pq.Array(ids), auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get students grades: %w", err)
+	}
+	byStudent := make(map[uuid.UUID][]domain.Grade, len(studentIDs))
+	for _, grade := range grades {
+		byStudent[grade.StudentID] = append(byStudent[grade.StudentID], grade)
+	}
+	return byStudent, nil
+}
 
-	return gpas, total, nil
+// language=postgresql
+const getTermStudents = `select distinct student_id from grade where term=$1 and tenant_id=$2 order by student_id`
+
+// GetTermStudents ...
+func (r Reader) GetTermStudents(ctx context.Context, term string) ([]uuid.UUID, error) {
+	var studentIDs []uuid.UUID
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		return conn.SelectContext(ctx, &studentIDs, getTermStudents, term, auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get term students: %w", err)
+	}
+	return studentIDs, nil
+}
+
+// language=postgresql
+const getRankPosition = `with averages as (
+    select student_id,
+           case when sum(credits) > 0 then sum(grade * credits)::float8 / sum(credits)
+                else sum(grade)::float8 / count(*) end as average
+    from grade
+    where tenant_id = $2 and type = '' %s
+    group by student_id
+), ranked as (
+    select student_id, average,
+           rank() over (order by average desc) as competition_rank,
+           dense_rank() over (order by average desc) as dense_rank,
+           cume_dist() over (order by average) as cume_dist,
+           count(*) over () as cohort_size
+    from averages
+)
+select student_id, average, competition_rank, dense_rank, cume_dist, cohort_size from ranked where student_id = $1`
+
+// language=postgresql
+const courseCohort = `and student_id in (select student_id from grade where tenant_id = $2 and course_id = $3 and type = '')`
+
+// GetRankPosition ranks the cohort by average with window functions, the averages weighted by credits as GPAs are.
+func (r Reader) GetRankPosition(ctx context.Context, studentID, courseID uuid.UUID) (domain.RankPosition, error) {
+	query, args := fmt.Sprintf(getRankPosition, ""), []any{studentID, auth.TenantFrom(ctx)}
+	if courseID != uuid.Nil {
+		query, args = fmt.Sprintf(getRankPosition, courseCohort), append(args, courseID)
+	}
+	var position domain.RankPosition
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		return conn.GetContext(ctx, &position, query, args...)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.RankPosition{}, domain.ErrStudentNotFound
+		}
+		return domain.RankPosition{}, fmt.Errorf("failed to get rank position: %w", err)
+	}
+	return position, nil
 }
 
 // language=postgresql
-const getScale = `select min, gpa from scale where type=$1 order by min desc`
+const getCourseStats = `select count(*) as count,
+       coalesce(avg(grade), 0) as mean,
+       coalesce(stddev_pop(grade), 0) as stddev,
+       coalesce(min(grade), 0) as min,
+       coalesce(max(grade), 0) as max,
+       coalesce(percentile_cont(0.5) within group (order by grade), 0) as median,
+       coalesce(percentile_cont(0.1) within group (order by grade), 0) as p10,
+       coalesce(percentile_cont(0.25) within group (order by grade), 0) as p25,
+       coalesce(percentile_cont(0.75) within group (order by grade), 0) as p75,
+       coalesce(percentile_cont(0.9) within group (order by grade), 0) as p90
+from grade where course_id=$1 and tenant_id=$2 and type = ''`
+
+// language=postgresql
+const getCourseGradeCounts = `select grade, count(*) as count from grade where course_id=$1 and tenant_id=$2 and type = ''
+group by grade order by grade`
+
+// GetCourseStats computes the distribution of the grades of a course with aggregates, so that large courses
+// are not read into memory.
+func (r Reader) GetCourseStats(ctx context.Context, courseID uuid.UUID) (domain.GradeStats, error) {
+	var row struct {
+		Count  int     `db:"count"`
+		Mean   float64 `db:"mean"`
+		StdDev float64 `db:"stddev"`
+		Min    int     `db:"min"`
+		Max    int     `db:"max"`
+		Median float64 `db:"median"`
+		P10    float64 `db:"p10"`
+		P25    float64 `db:"p25"`
+		P75    float64 `db:"p75"`
+		P90    float64 `db:"p90"`
+	}
+	var counts []domain.GradeCount
+	// the aggregates and the counts must come from the same node
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		if err := conn.GetContext(ctx, &row, getCourseStats, courseID, auth.TenantFrom(ctx)); err != nil {
+			return fmt.Errorf("failed to get course stats: %w", err)
+		}
+		if err := conn.SelectContext(ctx, &counts, getCourseGradeCounts, courseID, auth.TenantFrom(ctx)); err != nil {
+			return fmt.Errorf("failed to get course grade counts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domain.GradeStats{}, err
+	}
+	return domain.GradeStats{
+		Count:       row.Count,
+		Mean:        row.Mean,
+		Median:      row.Median,
+		StdDev:      row.StdDev,
+		Min:         row.Min,
+		Max:         row.Max,
+		Percentiles: domain.Percentiles{P10: row.P10, P25: row.P25, P75: row.P75, P90: row.P90},
+		Counts:      counts,
+	}, nil
+}
+
+// language=postgresql
+const getGradeCounts = `select grade, count(*) as count from grade where tenant_id=$1 and type = '' group by grade order by grade`
+
+// GetGradeCounts ...
+func (r Reader) GetGradeCounts(ctx context.Context) ([]domain.GradeCount, error) {
+	var counts []domain.GradeCount
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		return conn.SelectContext(ctx, &counts, getGradeCounts, auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get grade counts: %w", err)
+	}
+	return counts, nil
+}
+
+// language=postgresql
+const getScale = `select min, gpa, max, min_exclusive, max_exclusive from scale where type=$1 and tenant_id=$2
+order by min desc`
 
 // GetScales ...
 func (r Reader) GetScales(ctx context.Context, gpa domain.ScaleType) (domain.Scales, error) {
 	var scales []domain.Scale
-	err := r.db.SelectContext(ctx, &scales, getScale, gpa)
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		return conn.SelectContext(ctx, &scales, getScale, gpa, auth.TenantFrom(ctx))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -72,3 +283,32 @@ func (r Reader) GetScales(ctx context.Context, gpa domain.ScaleType) (domain.Sca
 	return scales, nil
 
 }
+
+// language=postgresql
+const getScalesByTypes = `select type, min, gpa, max, min_exclusive, max_exclusive from scale
+where type::text = any($1) and tenant_id=$2 order by type, min desc`
+
+// GetScalesByTypes fetches the scales of several scale types at once, keyed by scale type.
+// Scale types without any band are left out of the result.
+func (r Reader) GetScalesByTypes(ctx context.Context, scaleTypes []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error) {
+	type row struct {
+		Type domain.ScaleType `db:"type"`
+		domain.Scale
+	}
+	types := make([]string, len(scaleTypes))
+	for i, scaleType := range scaleTypes {
+		types[i] = string(scaleType)
+	}
+	var rows []row
+	err := r.scoped(ctx, func(conn rdbms.DBTX) error {
+		return conn.SelectContext(ctx, &rows, getScalesByTypes, pq.Array(types), auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scales: %w", err)
+	}
+	byType := make(map[domain.ScaleType]domain.Scales, len(scaleTypes))
+	for _, r := range rows {
+		byType[r.Type] = append(byType[r.Type], r.Scale)
+	}
+	return byType, nil
+}
@@ -3,28 +3,70 @@ package postgres
 
 import (
 	"context"
+	"errors"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/lib/pq"
+	kitDB "github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
 )
 
-//go:generate go run github.com/golang/mock/mockgen@v1.6.0 -source=stores.go -package=postgres -destination=stores_mock.go Repository
-
 type (
-	// Repository is the interface that provides storage operations.
-	Repository interface {
-		GetGrades(context.Context, int, int) ([]domain.Grade, int, error)
-		GetScales(context.Context, domain.ScaleType) (domain.Scales, error)
-	}
-
 	stores struct {
 		Reader
+		Writer
 	}
+
+	// Option configures the repository.
+	Option func(*stores)
 )
 
+// WithReplicas routes the reads to the replicas of the cluster, the writes still going to db.
+func WithReplicas(cluster *kitDB.Cluster) Option {
+	return func(s *stores) {
+		s.Reader.replicas = cluster
+	}
+}
+
 // New ...
-func New(db *sqlx.DB) Repository {
-	return &stores{
+func New(db *sqlx.DB, opts ...Option) rdbms.Repository {
+	s := &stores{
 		Reader: NewReader(db),
+		Writer: NewWriter(db),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
+
+// WithTx runs fn as a unit of work on the primary, retried on serialization failures and deadlocks. The unit of
+// work is scoped to the tenant of ctx.
+func (s *stores) WithTx(ctx context.Context, fn func(rdbms.Repository) error, opts ...rdbms.TxOption) error {
+	if s.Writer.tx != nil {
+		return fn(s)
+	}
+	o := rdbms.NewTxOptions(opts...)
+	err := rdbms.RunInTx(ctx, s.Writer.db, o, retryable, func(tx *sqlx.Tx) error {
+		if err := scope(ctx, tx); err != nil {
+			return err
+		}
+		return fn(&stores{
+			Reader: Reader{db: s.Reader.db, tx: tx},
+			Writer: Writer{db: s.Writer.db, tx: tx},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if !o.ReadOnly {
+		kitDB.MarkWritten(ctx)
+	}
+	return nil
+}
+
+// retryable reports the serialization failures and deadlocks, which a retried transaction may not run into.
+func retryable(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && (pqErr.Code == "40001" || pqErr.Code == "40P01")
 }
@@ -0,0 +1,43 @@
+//go:build integration
+// +build integration
+
+package postgres
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	migration "github.com/mnabbasabadi/grading/service/internal/storage/migration/postgres"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/rdbmstest"
+	"github.com/orlangure/gnomock"
+	preset "github.com/orlangure/gnomock/preset/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository(t *testing.T) {
+	container, err := gnomock.Start(preset.Preset(
+		preset.WithUser("gnomock", "gnomick"),
+		preset.WithDatabase("grading"),
+	))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = gnomock.Stop(container)
+	})
+
+	conn, err := db.ConnectToPostgres(
+		db.WithUser("gnomock"),
+		db.WithPassword("gnomick"),
+		db.WithHost(container.Host),
+		db.WithPort(strconv.Itoa(container.DefaultPort())),
+		db.WithDatabase("grading"),
+		db.WithSSlMode(db.Disable),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	require.NoError(t, migration.GooseUP(conn.DB))
+
+	rdbmstest.Run(t, rdbmstest.SQLSetup(conn, New(conn)))
+}
@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+)
+
+// language=postgresql
+const setTenant = `select set_config('grading.tenant_id', $1, true)`
+
+// scope scopes the transaction to the tenant of ctx until it ends: the row-level security policies of the
+// grade, scale, outbox, webhook and webhook_delivery tables only let it see and write the rows of the tenant,
+// or of every tenant with auth.AllTenants.
+func scope(ctx context.Context, tx rdbms.DBTX) error {
+	if _, err := tx.ExecContext(ctx, setTenant, auth.TenantFrom(ctx)); err != nil {
+		return fmt.Errorf("failed to scope to tenant: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// language=postgresql
+const insertWebhook = `insert into webhook (id, url, secret, event_types, active, created_at, updated_at, tenant_id)
+values (:id, :url, :secret, :event_types, :active, :created_at, :updated_at, :tenant_id)`
+
+// CreateWebhook stores a new webhook, in the tenant of ctx.
+func (w Writer) CreateWebhook(ctx context.Context, webhook domain.Webhook) error {
+	webhook.TenantID = auth.TenantFrom(ctx)
+	return w.atomic(ctx, func(tx rdbms.DBTX) error {
+		if _, err := tx.NamedExecContext(ctx, insertWebhook, webhook); err != nil {
+			return fmt.Errorf("failed to insert webhook: %w", err)
+		}
+		return nil
+	})
+}
+
+// language=postgresql
+const webhookColumns = `id, url, secret, event_types, active, created_at, updated_at, tenant_id`
+
+// language=postgresql
+const getWebhook = `select ` + webhookColumns + ` from webhook where id=$1 and tenant_id=$2`
+
+// GetWebhook ...
+func (w Writer) GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	var webhook domain.Webhook
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		return tx.GetContext(ctx, &webhook, getWebhook, id, auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Webhook{}, domain.ErrWebhookNotFound
+		}
+		return domain.Webhook{}, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	return webhook, nil
+}
+
+// language=postgresql
+const listWebhooks = `select ` + webhookColumns + ` from webhook where tenant_id=$1 order by created_at, id`
+
+// ListWebhooks ...
+func (w Writer) ListWebhooks(ctx context.Context) ([]domain.Webhook, error) {
+	var webhooks []domain.Webhook
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		return tx.SelectContext(ctx, &webhooks, listWebhooks, auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+// language=postgresql
+const updateWebhook = `update webhook set url=:url, secret=:secret, event_types=:event_types, active=:active,
+updated_at=:updated_at where id=:id and tenant_id=:tenant_id`
+
+// UpdateWebhook replaces the webhook.
+func (w Writer) UpdateWebhook(ctx context.Context, webhook domain.Webhook) error {
+	webhook.TenantID = auth.TenantFrom(ctx)
+	var res sql.Result
+	err := w.atomic(ctx, func(tx rdbms.DBTX) (err error) {
+		res, err = tx.NamedExecContext(ctx, updateWebhook, webhook)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+	return expectRow(res, domain.ErrWebhookNotFound)
+}
+
+// language=postgresql
+const deleteWebhook = `delete from webhook where id=$1 and tenant_id=$2`
+
+// DeleteWebhook deletes the webhook, its deliveries cascading.
+func (w Writer) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	var res sql.Result
+	err := w.atomic(ctx, func(tx rdbms.DBTX) (err error) {
+		res, err = tx.ExecContext(ctx, deleteWebhook, id, auth.TenantFrom(ctx))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return expectRow(res, domain.ErrWebhookNotFound)
+}
+
+// language=postgresql
+const insertDelivery = `insert into webhook_delivery (id, webhook_id, event_id, event_type, body, status, attempts,
+next_attempt_at, last_status_code, last_error, created_at, updated_at, tenant_id)
+values (:id, :webhook_id, :event_id, :event_type, :body, :status, :attempts,
+:next_attempt_at, :last_status_code, :last_error, :created_at, :updated_at, :tenant_id)
+on conflict (webhook_id, event_id) do nothing`
+
+// EnqueueDeliveries ... The deliveries are stored in the tenant of ctx.
+func (w Writer) EnqueueDeliveries(ctx context.Context, deliveries []domain.Delivery) error {
+	return w.atomic(ctx, func(tx rdbms.DBTX) error {
+		for _, delivery := range deliveries {
+			delivery.TenantID = auth.TenantFrom(ctx)
+			if _, err := tx.NamedExecContext(ctx, insertDelivery, delivery); err != nil {
+				return fmt.Errorf("failed to insert delivery: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// language=postgresql
+const deliveryColumns = `id, webhook_id, event_id, event_type, body, status, attempts, next_attempt_at, last_status_code,
+last_error, created_at, updated_at, tenant_id`
+
+// language=postgresql
+const dueDeliveries = `select ` + deliveryColumns + ` from webhook_delivery
+where status='pending' and next_attempt_at <= $1 order by next_attempt_at, id limit $2
+for update skip locked`
+
+// DueDeliveries ... Within a unit of work, the deliveries are locked until it ends and skipped by the other
+// units of work, so concurrent workers don't get the same deliveries. Only the deliveries of the tenant of ctx
+// are seen, those of every tenant with auth.AllTenants.
+func (w Writer) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.Delivery, error) {
+	var deliveries []domain.Delivery
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		return tx.SelectContext(ctx, &deliveries, dueDeliveries, now, limit)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// language=postgresql
+const getDelivery = `select ` + deliveryColumns + ` from webhook_delivery where id=$1 and tenant_id=$2`
+
+// GetDelivery ...
+func (w Writer) GetDelivery(ctx context.Context, id uuid.UUID) (domain.Delivery, error) {
+	var delivery domain.Delivery
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		return tx.GetContext(ctx, &delivery, getDelivery, id, auth.TenantFrom(ctx))
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.Delivery{}, domain.ErrDeliveryNotFound
+		}
+		return domain.Delivery{}, fmt.Errorf("failed to get delivery: %w", err)
+	}
+	return delivery, nil
+}
+
+// language=postgresql
+const updateDelivery = `update webhook_delivery set status=:status, attempts=:attempts, next_attempt_at=:next_attempt_at,
+last_status_code=:last_status_code, last_error=:last_error, updated_at=:updated_at where id=:id and tenant_id=:tenant_id`
+
+// UpdateDelivery records an attempt of the delivery, or its rescheduling.
+func (w Writer) UpdateDelivery(ctx context.Context, delivery domain.Delivery) error {
+	delivery.TenantID = auth.TenantFrom(ctx)
+	var res sql.Result
+	err := w.atomic(ctx, func(tx rdbms.DBTX) (err error) {
+		res, err = tx.NamedExecContext(ctx, updateDelivery, delivery)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update delivery: %w", err)
+	}
+	return expectRow(res, domain.ErrDeliveryNotFound)
+}
+
+// language=postgresql
+const listDeliveries = `select ` + deliveryColumns + ` from webhook_delivery
+where webhook_id=$1 and tenant_id=$2 order by created_at desc, id limit $3 offset $4`
+
+// language=postgresql
+const totalDeliveries = `select count(*) from webhook_delivery where webhook_id=$1 and tenant_id=$2`
+
+// ListDeliveries ...
+func (w Writer) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]domain.Delivery, int, error) {
+	var (
+		deliveries []domain.Delivery
+		total      int
+	)
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		if err := tx.SelectContext(ctx, &deliveries, listDeliveries, webhookID, auth.TenantFrom(ctx), limit, offset); err != nil {
+			return fmt.Errorf("failed to list deliveries: %w", err)
+		}
+		if err := tx.GetContext(ctx, &total, totalDeliveries, webhookID, auth.TenantFrom(ctx)); err != nil {
+			return fmt.Errorf("failed to count deliveries: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return deliveries, total, nil
+}
+
+// expectRow returns notFound when the statement affected no row.
+func expectRow(res sql.Result, notFound error) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return notFound
+	}
+	return nil
+}
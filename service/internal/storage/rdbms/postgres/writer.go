@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	kitDB "github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+type (
+	// Writer ...
+	Writer struct {
+		db *sqlx.DB
+		tx *sqlx.Tx
+	}
+)
+
+// NewWriter ...
+func NewWriter(db *sqlx.DB) Writer {
+	return Writer{
+		db: db,
+	}
+}
+
+// atomic runs fn in the transaction of the unit of work if any, in a transaction of its own otherwise, scoped
+// to the tenant of ctx, see scope.
+func (w Writer) atomic(ctx context.Context, fn func(rdbms.DBTX) error) error {
+	if w.tx != nil {
+		return fn(w.tx)
+	}
+	return rdbms.RunInTx(ctx, w.db, rdbms.NewTxOptions(rdbms.MaxAttempts(1)), nil, func(tx *sqlx.Tx) error {
+		if err := scope(ctx, tx); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// language=postgresql
+const deleteScales = `delete from scale where type=$1 and tenant_id=$2`
+
+// language=postgresql
+const insertScale = `insert into scale (min, gpa, max, min_exclusive, max_exclusive, type, tenant_id)
+values ($1, $2, $3, $4, $5, $6, $7)`
+
+// SetScales replaces all the bands of the given scale type.
+func (w Writer) SetScales(ctx context.Context, scaleType domain.ScaleType, scales domain.Scales) error {
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		if _, err := tx.ExecContext(ctx, deleteScales, scaleType, auth.TenantFrom(ctx)); err != nil {
+			return fmt.Errorf("failed to delete scales: %w", err)
+		}
+		for _, scale := range scales {
+			if _, err := tx.ExecContext(ctx, insertScale, scale.Min, scale.GPA, scale.Max, scale.MinExclusive, scale.MaxExclusive, scaleType,
+				auth.TenantFrom(ctx)); err != nil {
+				return fmt.Errorf("failed to insert scale: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	kitDB.MarkWritten(ctx)
+	return nil
+}
+
+// DeleteScales removes all the bands of the given scale type.
+func (w Writer) DeleteScales(ctx context.Context, scaleType domain.ScaleType) error {
+	var n int64
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		res, err := tx.ExecContext(ctx, deleteScales, scaleType, auth.TenantFrom(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to delete scales: %w", err)
+		}
+		if n, err = res.RowsAffected(); err != nil {
+			return fmt.Errorf("failed to delete scales: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrScaleNotFound
+	}
+	kitDB.MarkWritten(ctx)
+	return nil
+}
+
+// language=postgresql
+const insertGrade = `insert into grade (student_id, course_id, grade, term, credits, tenant_id, type) values ($1, $2, $3, $4, $5, $6, $7)`
+
+// language=postgresql
+const updateGrade = `update grade set grade=$3, term=$4, credits=$5, type=$7, updated_at=now()
+where student_id=$1 and course_id=$2 and tenant_id=$6`
+
+// InsertGrade records the grade of a student in a course.
+func (w Writer) InsertGrade(ctx context.Context, grade domain.Grade) error {
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		_, err := tx.ExecContext(ctx, insertGrade, grade.StudentID, grade.CourseID, grade.Grade, grade.Term, grade.Credits,
+			auth.TenantFrom(ctx), grade.Type)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert grade: %w", err)
+	}
+	kitDB.MarkWritten(ctx)
+	return nil
+}
+
+// UpdateGrade replaces the grade of a student in a course.
+func (w Writer) UpdateGrade(ctx context.Context, grade domain.Grade) error {
+	var n int64
+	err := w.atomic(ctx, func(tx rdbms.DBTX) error {
+		res, err := tx.ExecContext(ctx, updateGrade, grade.StudentID, grade.CourseID, grade.Grade, grade.Term, grade.Credits,
+			auth.TenantFrom(ctx), grade.Type)
+		if err != nil {
+			return err
+		}
+		n, err = res.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update grade: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrGradeNotFound
+	}
+	kitDB.MarkWritten(ctx)
+	return nil
+}
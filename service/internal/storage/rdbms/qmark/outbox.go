@@ -0,0 +1,104 @@
+package qmark
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// language=sql
+const insertEvent = `{insert_ignore} into outbox (id, type, payload, occurred_at, tenant_id, idempotency_key)
+values (?, ?, ?, ?, ?, ?)`
+
+// AppendEvents writes the events to the outbox, in the tenant of ctx, dropping those with the key of one already
+// in it.
+func (w Writer) AppendEvents(ctx context.Context, events []domain.Event) error {
+	return w.atomic(ctx, func(tx rdbms.DBTX) error {
+		for _, event := range events {
+			key := sql.NullString{String: event.Key, Valid: event.Key != ""}
+			if _, err := tx.ExecContext(ctx, w.dialect.query(insertEvent), event.ID, event.Type, []byte(event.Payload), event.OccurredAt,
+				auth.TenantFrom(ctx), key); err != nil {
+				return fmt.Errorf("failed to insert event: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// language=sql
+const claimableEvents = `select id, type, payload, occurred_at, tenant_id from outbox
+where delivered_at is null and (claimed_until is null or claimed_until <= ?) and (tenant_id=? or ?='*')
+order by seq limit ?
+{skip_locked}`
+
+// language=sql
+const claimEvents = `update outbox set claimed_until=? where id in (?)`
+
+// ClaimEvents returns the oldest undelivered events not claimed by now, and claims them until now + lease. The events
+// are locked until the unit of work ends and skipped by the other units of work meanwhile, or the database
+// serializes the units of work, so concurrent relays don't claim the same events. Only the events of the tenant of ctx are
+// seen, those of every tenant with auth.AllTenants.
+func (w Writer) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error) {
+	var events []domain.Event
+	if err := w.conn().SelectContext(ctx, &events, w.dialect.query(claimableEvents), now, auth.TenantFrom(ctx), auth.TenantFrom(ctx), limit); err != nil {
+		return nil, fmt.Errorf("failed to get pending events: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	ids := make([]uuid.UUID, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+	query, args, err := sqlx.In(claimEvents, now.Add(lease), ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build claim events query: %w", err)
+	}
+	if _, err := w.conn().ExecContext(ctx, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to claim events: %w", err)
+	}
+	return events, nil
+}
+
+// language=sql
+const markEventsDelivered = `update outbox set delivered_at={now} where id in (?)`
+
+// MarkEventsDelivered records the events were delivered.
+func (w Writer) MarkEventsDelivered(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	query, args, err := sqlx.In(w.dialect.query(markEventsDelivered), ids)
+	if err != nil {
+		return fmt.Errorf("failed to build mark events delivered query: %w", err)
+	}
+	if _, err := w.conn().ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to mark events delivered: %w", err)
+	}
+	return nil
+}
+
+// language=sql
+const releaseEvents = `update outbox set claimed_until=null where id in (?)`
+
+// ReleaseEvents gives up the claim on the events.
+func (w Writer) ReleaseEvents(ctx context.Context, ids []uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	query, args, err := sqlx.In(releaseEvents, ids)
+	if err != nil {
+		return fmt.Errorf("failed to build release events query: %w", err)
+	}
+	if _, err := w.conn().ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to release events: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package qmark
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+type (
+	// Reader ...
+	Reader struct {
+		db      *sqlx.DB
+		tx      *sqlx.Tx
+		dialect Dialect
+	}
+)
+
+// NewReader ...
+func NewReader(db *sqlx.DB, dialect Dialect) Reader {
+	return Reader{
+		db:      db,
+		dialect: dialect,
+	}
+}
+
+// conn returns the database to read from, the transaction of the unit of work if any.
+func (r Reader) conn() rdbms.DBTX {
+	if r.tx != nil {
+		return r.tx
+	}
+	return r.db
+}
+
+// language=sql
+const getgpas = `select student_id, course_id, grade, term, credits, type from grade where tenant_id=?
+order by created_at, id limit ? offset ?`
+
+// language=sql
+const totalgpas = `select count(*) from grade where tenant_id=?`
+
+// GetGrades ...
+func (r Reader) GetGrades(ctx context.Context, limit, offset int) ([]domain.Grade, int, error) {
+	var gpas []domain.Grade
+	if err := r.conn().SelectContext(ctx, &gpas, getgpas, auth.TenantFrom(ctx), limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to get gpas: %w", err)
+	}
+
+	var total int
+	if err := r.conn().QueryRowxContext(ctx, totalgpas, auth.TenantFrom(ctx)).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to get total: %w", err)
+	}
+
+	return gpas, total, nil
+}
+
+// language=sql
+const getStudentGrades = `select student_id, course_id, grade, term, credits, type from grade where student_id=? and tenant_id=? order by created_at, id`
+
+// GetStudentGrades ...
+func (r Reader) GetStudentGrades(ctx context.Context, studentID uuid.UUID) ([]domain.Grade, error) {
+	var grades []domain.Grade
+	if err := r.conn().SelectContext(ctx, &grades, getStudentGrades, studentID, auth.TenantFrom(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to get student grades: %w", err)
+	}
+	return grades, nil
+}
+
+// language=sql
+const getStudentsGrades = `select student_id, course_id, grade, term, credits, type from grade where student_id in (?) and tenant_id=?
+order by created_at, id`
+
+// GetGradesByStudents fetches the grades of several students at once, keyed by student.
+func (r Reader) GetGradesByStudents(ctx context.Context, studentIDs []uuid.UUID) (map[uuid.UUID][]domain.Grade, error) {
+	byStudent := make(map[uuid.UUID][]domain.Grade, len(studentIDs))
+	if len(studentIDs) == 0 {
+		return byStudent, nil
+	}
+	query, args, err := sqlx.In(getStudentsGrades, studentIDs, auth.TenantFrom(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build students grades query: %w", err)
+	}
+	var grades []domain.Grade
+	if err := r.conn().SelectContext(ctx, &grades, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get students grades: %w", err)
+	}
+	for _, grade := range grades {
+		byStudent[grade.StudentID] = append(byStudent[grade.StudentID], grade)
+	}
+	return byStudent, nil
+}
+
+// language=sql
+const getTermStudents = `select distinct student_id from grade where term=? and tenant_id=? order by student_id`
+
+// GetTermStudents ...
+func (r Reader) GetTermStudents(ctx context.Context, term string) ([]uuid.UUID, error) {
+	var studentIDs []uuid.UUID
+	if err := r.conn().SelectContext(ctx, &studentIDs, getTermStudents, term, auth.TenantFrom(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to get term students: %w", err)
+	}
+	return studentIDs, nil
+}
+
+// language=sql
+const getRankPosition = `with averages as (
+    select student_id,
+           case when sum(credits) > 0 then cast(sum(grade * credits) as {float}) / sum(credits)
+                else cast(sum(grade) as {float}) / count(*) end as average
+    from grade
+    where tenant_id = ? and type = '' %s
+    group by student_id
+), ranked as (
+    select student_id, average,
+           rank() over (order by average desc) as competition_rank,
+           dense_rank() over (order by average desc) as dense_rank,
+           cume_dist() over (order by average) as cume_dist,
+           count(*) over () as cohort_size
+    from averages
+)
+select student_id, average, competition_rank, dense_rank, cume_dist, cohort_size from ranked where student_id = ?`
+
+// language=sql
+const courseCohort = `and student_id in (select student_id from grade where tenant_id = ? and course_id = ? and type = '')`
+
+// GetRankPosition ranks the cohort by average with window functions, the averages weighted by credits as GPAs are.
+func (r Reader) GetRankPosition(ctx context.Context, studentID, courseID uuid.UUID) (domain.RankPosition, error) {
+	tenant := auth.TenantFrom(ctx)
+	query, args := fmt.Sprintf(getRankPosition, ""), []any{tenant, studentID}
+	if courseID != uuid.Nil {
+		query, args = fmt.Sprintf(getRankPosition, courseCohort), []any{tenant, tenant, courseID, studentID}
+	}
+	query = r.dialect.query(query)
+	var position domain.RankPosition
+	if err := r.conn().GetContext(ctx, &position, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.RankPosition{}, domain.ErrStudentNotFound
+		}
+		return domain.RankPosition{}, fmt.Errorf("failed to get rank position: %w", err)
+	}
+	return position, nil
+}
+
+// language=sql
+const getCourseGradeCounts = `select grade, count(*) as count from grade where course_id=? and tenant_id=? and type = ''
+group by grade order by grade`
+
+// GetCourseStats computes the distribution of the grades of a course from how many times each was given.
+func (r Reader) GetCourseStats(ctx context.Context, courseID uuid.UUID) (domain.GradeStats, error) {
+	var counts []domain.GradeCount
+	if err := r.conn().SelectContext(ctx, &counts, getCourseGradeCounts, courseID, auth.TenantFrom(ctx)); err != nil {
+		return domain.GradeStats{}, fmt.Errorf("failed to get course grade counts: %w", err)
+	}
+	return domain.NewGradeStats(counts), nil
+}
+
+// language=sql
+const getGradeCounts = `select grade, count(*) as count from grade where tenant_id=? and type = '' group by grade order by grade`
+
+// GetGradeCounts ...
+func (r Reader) GetGradeCounts(ctx context.Context) ([]domain.GradeCount, error) {
+	var counts []domain.GradeCount
+	if err := r.conn().SelectContext(ctx, &counts, getGradeCounts, auth.TenantFrom(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to get grade counts: %w", err)
+	}
+	return counts, nil
+}
+
+// language=sql
+const getScale = `select min, gpa, max, min_exclusive, max_exclusive from scale where type=? and tenant_id=? order by min desc`
+
+// GetScales ...
+func (r Reader) GetScales(ctx context.Context, gpa domain.ScaleType) (domain.Scales, error) {
+	var scales []domain.Scale
+	err := r.conn().SelectContext(ctx, &scales, getScale, gpa, auth.TenantFrom(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(scales) == 0 {
+		return nil, domain.ErrScaleNotFound
+	}
+	return scales, nil
+}
+
+// language=sql
+const getScalesByTypes = `select type, min, gpa, max, min_exclusive, max_exclusive from scale where type in (?) and tenant_id=?
+order by type, min desc`
+
+// GetScalesByTypes fetches the scales of several scale types at once, keyed by scale type.
+// Scale types without any band are left out of the result.
+func (r Reader) GetScalesByTypes(ctx context.Context, scaleTypes []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error) {
+	type row struct {
+		Type domain.ScaleType `db:"type"`
+		domain.Scale
+	}
+	byType := make(map[domain.ScaleType]domain.Scales, len(scaleTypes))
+	if len(scaleTypes) == 0 {
+		return byType, nil
+	}
+	query, args, err := sqlx.In(getScalesByTypes, scaleTypes, auth.TenantFrom(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build scales query: %w", err)
+	}
+	var rows []row
+	if err := r.conn().SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to get scales: %w", err)
+	}
+	for _, r := range rows {
+		byType[r.Type] = append(byType[r.Type], r.Scale)
+	}
+	return byType, nil
+}
@@ -0,0 +1,66 @@
+// Package qmark is the implementation of the storage layer shared by the databases with ? placeholders, MySQL
+// and SQLite. The few queries differing between them are adapted by a Dialect.
+package qmark
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+)
+
+type (
+	// Dialect adapts the queries of the repository to a database, filling in the {float}, {now},
+	// {insert_ignore} and {skip_locked} fragments of the queries.
+	Dialect struct {
+		// Float is the type the averages are cast to, e.g. double.
+		Float string
+		// Now is the current time with sub-second precision, e.g. now(6).
+		Now string
+		// InsertIgnore starts the inserts skipping the rows conflicting with a unique key, e.g. insert ignore.
+		InsertIgnore string
+		// SkipLocked ends the selects locking the rows they return until the unit of work ends, skipping those
+		// locked by the other units of work, e.g. for update skip locked. It is empty for the databases
+		// serializing the units of work.
+		SkipLocked string
+		// IsRetryable reports whether a failed unit of work may succeed when retried, nil if none may.
+		IsRetryable func(error) bool
+	}
+
+	stores struct {
+		Reader
+		Writer
+	}
+)
+
+// query fills in the fragments of the query for the dialect.
+func (d Dialect) query(query string) string {
+	return strings.NewReplacer(
+		"{float}", d.Float,
+		"{now}", d.Now,
+		"{insert_ignore}", d.InsertIgnore,
+		"{skip_locked}", d.SkipLocked,
+	).Replace(query)
+}
+
+// New ...
+func New(db *sqlx.DB, dialect Dialect) rdbms.Repository {
+	return &stores{
+		Reader: NewReader(db, dialect),
+		Writer: NewWriter(db, dialect),
+	}
+}
+
+// WithTx runs fn as a unit of work, retried on the errors the dialect deems retryable.
+func (s *stores) WithTx(ctx context.Context, fn func(rdbms.Repository) error, opts ...rdbms.TxOption) error {
+	if s.Writer.tx != nil {
+		return fn(s)
+	}
+	return rdbms.RunInTx(ctx, s.Writer.db, rdbms.NewTxOptions(opts...), s.Writer.dialect.IsRetryable, func(tx *sqlx.Tx) error {
+		return fn(&stores{
+			Reader: Reader{db: s.Reader.db, tx: tx, dialect: s.Reader.dialect},
+			Writer: Writer{db: s.Writer.db, tx: tx, dialect: s.Writer.dialect},
+		})
+	})
+}
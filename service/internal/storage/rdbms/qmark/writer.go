@@ -0,0 +1,113 @@
+package qmark
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+type (
+	// Writer ...
+	Writer struct {
+		db      *sqlx.DB
+		tx      *sqlx.Tx
+		dialect Dialect
+	}
+)
+
+// NewWriter ...
+func NewWriter(db *sqlx.DB, dialect Dialect) Writer {
+	return Writer{
+		db:      db,
+		dialect: dialect,
+	}
+}
+
+// conn returns the database to write to, the transaction of the unit of work if any.
+func (w Writer) conn() rdbms.DBTX {
+	if w.tx != nil {
+		return w.tx
+	}
+	return w.db
+}
+
+// atomic runs fn in the transaction of the unit of work if any, in a transaction of its own otherwise.
+func (w Writer) atomic(ctx context.Context, fn func(rdbms.DBTX) error) error {
+	if w.tx != nil {
+		return fn(w.tx)
+	}
+	return rdbms.RunInTx(ctx, w.db, rdbms.NewTxOptions(rdbms.MaxAttempts(1)), nil, func(tx *sqlx.Tx) error {
+		return fn(tx)
+	})
+}
+
+// language=sql
+const deleteScales = `delete from scale where type=? and tenant_id=?`
+
+// language=sql
+const insertScale = `insert into scale (min, gpa, max, min_exclusive, max_exclusive, type, tenant_id) values (?, ?, ?, ?, ?, ?, ?)`
+
+// SetScales replaces all the bands of the given scale type.
+func (w Writer) SetScales(ctx context.Context, scaleType domain.ScaleType, scales domain.Scales) error {
+	return w.atomic(ctx, func(tx rdbms.DBTX) error {
+		if _, err := tx.ExecContext(ctx, deleteScales, scaleType, auth.TenantFrom(ctx)); err != nil {
+			return fmt.Errorf("failed to delete scales: %w", err)
+		}
+		for _, scale := range scales {
+			if _, err := tx.ExecContext(ctx, insertScale, scale.Min, scale.GPA, scale.Max, scale.MinExclusive, scale.MaxExclusive, scaleType, auth.TenantFrom(ctx)); err != nil {
+				return fmt.Errorf("failed to insert scale: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// DeleteScales removes all the bands of the given scale type.
+func (w Writer) DeleteScales(ctx context.Context, scaleType domain.ScaleType) error {
+	res, err := w.conn().ExecContext(ctx, deleteScales, scaleType, auth.TenantFrom(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete scales: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete scales: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrScaleNotFound
+	}
+	return nil
+}
+
+// language=sql
+const insertGrade = `insert into grade (student_id, course_id, grade, term, credits, tenant_id, type) values (?, ?, ?, ?, ?, ?, ?)`
+
+// language=sql
+const updateGrade = `update grade set grade=?, term=?, credits=?, type=?, updated_at={now} where student_id=? and course_id=? and tenant_id=?`
+
+// InsertGrade records the grade of a student in a course.
+func (w Writer) InsertGrade(ctx context.Context, grade domain.Grade) error {
+	if _, err := w.conn().ExecContext(ctx, insertGrade, grade.StudentID, grade.CourseID, grade.Grade, grade.Term, grade.Credits, auth.TenantFrom(ctx), grade.Type); err != nil {
+		return fmt.Errorf("failed to insert grade: %w", err)
+	}
+	return nil
+}
+
+// UpdateGrade replaces the grade of a student in a course.
+func (w Writer) UpdateGrade(ctx context.Context, grade domain.Grade) error {
+	res, err := w.conn().ExecContext(ctx, w.dialect.query(updateGrade), grade.Grade, grade.Term, grade.Credits, grade.Type, grade.StudentID, grade.CourseID, auth.TenantFrom(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to update grade: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update grade: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrGradeNotFound
+	}
+	return nil
+}
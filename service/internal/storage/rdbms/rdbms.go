@@ -0,0 +1,74 @@
+// Package rdbms defines the storage operations the use cases need, implemented by each relational backend.
+package rdbms
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+//go:generate go run github.com/golang/mock/mockgen@v1.6.0 -source=rdbms.go -package=rdbms -destination=rdbms_mock.go Repository
+
+type (
+	// Repository is the interface that provides storage operations.
+	Repository interface {
+		GetGrades(context.Context, int, int) ([]domain.Grade, int, error)
+		GetStudentGrades(context.Context, uuid.UUID) ([]domain.Grade, error)
+		GetGradesByStudents(context.Context, []uuid.UUID) (map[uuid.UUID][]domain.Grade, error)
+		// GetTermStudents returns the students graded in a term, sorted by id.
+		GetTermStudents(ctx context.Context, term string) ([]uuid.UUID, error)
+		// GetRankPosition returns the position of a student among every graded student, or among the students
+		// graded in a course unless courseID is uuid.Nil. It returns domain.ErrStudentNotFound when the student
+		// is not among them.
+		GetRankPosition(ctx context.Context, studentID, courseID uuid.UUID) (domain.RankPosition, error)
+		// GetCourseStats returns the distribution of the grades of a course, all zeros when it has none.
+		GetCourseStats(context.Context, uuid.UUID) (domain.GradeStats, error)
+		// GetGradeCounts returns how many times each grade counting in GPAs was given in the tenant, in ascending
+		// order of grade.
+		GetGradeCounts(context.Context) ([]domain.GradeCount, error)
+		GetScales(context.Context, domain.ScaleType) (domain.Scales, error)
+		GetScalesByTypes(context.Context, []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error)
+		SetScales(context.Context, domain.ScaleType, domain.Scales) error
+		DeleteScales(context.Context, domain.ScaleType) error
+		InsertGrade(context.Context, domain.Grade) error
+		// UpdateGrade returns domain.ErrGradeNotFound when the student has no grade in the course.
+		UpdateGrade(context.Context, domain.Grade) error
+		// AppendEvents writes events to the outbox, to be written in the unit of work of the change they record.
+		// The events belong to the tenant of the context, like the webhooks and the deliveries below.
+		AppendEvents(context.Context, []domain.Event) error
+		// ClaimEvents returns the oldest undelivered events of the outbox not claimed by now, in the order they
+		// were appended, and claims them for the lease: the other claims skip them until it ends or they are
+		// released. It is meant to run in a unit of work of its own, committed before the events are delivered.
+		// It only sees the events of the tenant of the context, those of every tenant with auth.AllTenants.
+		ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error)
+		MarkEventsDelivered(context.Context, []uuid.UUID) error
+		// ReleaseEvents gives up the claim on undelivered events, for the next claim to take them again.
+		ReleaseEvents(context.Context, []uuid.UUID) error
+		CreateWebhook(context.Context, domain.Webhook) error
+		// GetWebhook, UpdateWebhook and DeleteWebhook return domain.ErrWebhookNotFound when the tenant has no
+		// such webhook.
+		GetWebhook(context.Context, uuid.UUID) (domain.Webhook, error)
+		// ListWebhooks returns the webhooks of the tenant in the order they were created.
+		ListWebhooks(context.Context) ([]domain.Webhook, error)
+		UpdateWebhook(context.Context, domain.Webhook) error
+		// DeleteWebhook deletes the webhook along with its deliveries.
+		DeleteWebhook(context.Context, uuid.UUID) error
+		// EnqueueDeliveries stores new deliveries, skipping those of an event already enqueued for the webhook.
+		EnqueueDeliveries(context.Context, []domain.Delivery) error
+		// DueDeliveries returns the pending deliveries to attempt by now, the most overdue first. Within a unit
+		// of work, they are locked until it ends where the backend supports it. Like ClaimEvents, it only sees
+		// the deliveries of the tenant of the context, those of every tenant with auth.AllTenants.
+		DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.Delivery, error)
+		// GetDelivery and UpdateDelivery return domain.ErrDeliveryNotFound when the tenant has no such delivery.
+		GetDelivery(context.Context, uuid.UUID) (domain.Delivery, error)
+		UpdateDelivery(context.Context, domain.Delivery) error
+		// ListDeliveries returns a page of the deliveries of a webhook, the latest first, and their total.
+		ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]domain.Delivery, int, error)
+		// WithTx runs fn as a unit of work: the operations of the Repository fn is given are committed together
+		// when fn returns nil, and rolled back otherwise. fn may run again when the unit of work is retried,
+		// and must not use any other Repository. Units of work nested in fn join the outer one.
+		WithTx(ctx context.Context, fn func(Repository) error, opts ...TxOption) error
+	}
+)
@@ -0,0 +1,452 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: rdbms.go
+
+// Package rdbms is a generated GoMock package.
+package rdbms
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	domain "github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendEvents mocks base method.
+func (m *MockRepository) AppendEvents(arg0 context.Context, arg1 []domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendEvents indicates an expected call of AppendEvents.
+func (mr *MockRepositoryMockRecorder) AppendEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendEvents", reflect.TypeOf((*MockRepository)(nil).AppendEvents), arg0, arg1)
+}
+
+// ClaimEvents mocks base method.
+func (m *MockRepository) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimEvents", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimEvents indicates an expected call of ClaimEvents.
+func (mr *MockRepositoryMockRecorder) ClaimEvents(ctx, now, lease, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimEvents", reflect.TypeOf((*MockRepository)(nil).ClaimEvents), ctx, now, lease, limit)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockRepository) CreateWebhook(arg0 context.Context, arg1 domain.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockRepositoryMockRecorder) CreateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockRepository)(nil).CreateWebhook), arg0, arg1)
+}
+
+// DeleteScales mocks base method.
+func (m *MockRepository) DeleteScales(arg0 context.Context, arg1 domain.ScaleType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScales", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScales indicates an expected call of DeleteScales.
+func (mr *MockRepositoryMockRecorder) DeleteScales(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScales", reflect.TypeOf((*MockRepository)(nil).DeleteScales), arg0, arg1)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockRepository) DeleteWebhook(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockRepositoryMockRecorder) DeleteWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockRepository)(nil).DeleteWebhook), arg0, arg1)
+}
+
+// DueDeliveries mocks base method.
+func (m *MockRepository) DueDeliveries(ctx context.Context, now time.Time, limit int) ([]domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DueDeliveries", ctx, now, limit)
+	ret0, _ := ret[0].([]domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DueDeliveries indicates an expected call of DueDeliveries.
+func (mr *MockRepositoryMockRecorder) DueDeliveries(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DueDeliveries", reflect.TypeOf((*MockRepository)(nil).DueDeliveries), ctx, now, limit)
+}
+
+// EnqueueDeliveries mocks base method.
+func (m *MockRepository) EnqueueDeliveries(arg0 context.Context, arg1 []domain.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueDeliveries", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueDeliveries indicates an expected call of EnqueueDeliveries.
+func (mr *MockRepositoryMockRecorder) EnqueueDeliveries(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueDeliveries", reflect.TypeOf((*MockRepository)(nil).EnqueueDeliveries), arg0, arg1)
+}
+
+// GetCourseStats mocks base method.
+func (m *MockRepository) GetCourseStats(arg0 context.Context, arg1 uuid.UUID) (domain.GradeStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseStats", arg0, arg1)
+	ret0, _ := ret[0].(domain.GradeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseStats indicates an expected call of GetCourseStats.
+func (mr *MockRepositoryMockRecorder) GetCourseStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseStats", reflect.TypeOf((*MockRepository)(nil).GetCourseStats), arg0, arg1)
+}
+
+// GetDelivery mocks base method.
+func (m *MockRepository) GetDelivery(arg0 context.Context, arg1 uuid.UUID) (domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDelivery", arg0, arg1)
+	ret0, _ := ret[0].(domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDelivery indicates an expected call of GetDelivery.
+func (mr *MockRepositoryMockRecorder) GetDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelivery", reflect.TypeOf((*MockRepository)(nil).GetDelivery), arg0, arg1)
+}
+
+// GetGradeCounts mocks base method.
+func (m *MockRepository) GetGradeCounts(arg0 context.Context) ([]domain.GradeCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGradeCounts", arg0)
+	ret0, _ := ret[0].([]domain.GradeCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGradeCounts indicates an expected call of GetGradeCounts.
+func (mr *MockRepositoryMockRecorder) GetGradeCounts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradeCounts", reflect.TypeOf((*MockRepository)(nil).GetGradeCounts), arg0)
+}
+
+// GetGrades mocks base method.
+func (m *MockRepository) GetGrades(arg0 context.Context, arg1, arg2 int) ([]domain.Grade, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGrades", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]domain.Grade)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGrades indicates an expected call of GetGrades.
+func (mr *MockRepositoryMockRecorder) GetGrades(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrades", reflect.TypeOf((*MockRepository)(nil).GetGrades), arg0, arg1, arg2)
+}
+
+// GetGradesByStudents mocks base method.
+func (m *MockRepository) GetGradesByStudents(arg0 context.Context, arg1 []uuid.UUID) (map[uuid.UUID][]domain.Grade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGradesByStudents", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID][]domain.Grade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGradesByStudents indicates an expected call of GetGradesByStudents.
+func (mr *MockRepositoryMockRecorder) GetGradesByStudents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradesByStudents", reflect.TypeOf((*MockRepository)(nil).GetGradesByStudents), arg0, arg1)
+}
+
+// GetRankPosition mocks base method.
+func (m *MockRepository) GetRankPosition(ctx context.Context, studentID, courseID uuid.UUID) (domain.RankPosition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRankPosition", ctx, studentID, courseID)
+	ret0, _ := ret[0].(domain.RankPosition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRankPosition indicates an expected call of GetRankPosition.
+func (mr *MockRepositoryMockRecorder) GetRankPosition(ctx, studentID, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRankPosition", reflect.TypeOf((*MockRepository)(nil).GetRankPosition), ctx, studentID, courseID)
+}
+
+// GetScales mocks base method.
+func (m *MockRepository) GetScales(arg0 context.Context, arg1 domain.ScaleType) (domain.Scales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScales", arg0, arg1)
+	ret0, _ := ret[0].(domain.Scales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScales indicates an expected call of GetScales.
+func (mr *MockRepositoryMockRecorder) GetScales(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScales", reflect.TypeOf((*MockRepository)(nil).GetScales), arg0, arg1)
+}
+
+// GetScalesByTypes mocks base method.
+func (m *MockRepository) GetScalesByTypes(arg0 context.Context, arg1 []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScalesByTypes", arg0, arg1)
+	ret0, _ := ret[0].(map[domain.ScaleType]domain.Scales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScalesByTypes indicates an expected call of GetScalesByTypes.
+func (mr *MockRepositoryMockRecorder) GetScalesByTypes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScalesByTypes", reflect.TypeOf((*MockRepository)(nil).GetScalesByTypes), arg0, arg1)
+}
+
+// GetStudentGrades mocks base method.
+func (m *MockRepository) GetStudentGrades(arg0 context.Context, arg1 uuid.UUID) ([]domain.Grade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudentGrades", arg0, arg1)
+	ret0, _ := ret[0].([]domain.Grade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudentGrades indicates an expected call of GetStudentGrades.
+func (mr *MockRepositoryMockRecorder) GetStudentGrades(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudentGrades", reflect.TypeOf((*MockRepository)(nil).GetStudentGrades), arg0, arg1)
+}
+
+// GetTermStudents mocks base method.
+func (m *MockRepository) GetTermStudents(ctx context.Context, term string) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTermStudents", ctx, term)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTermStudents indicates an expected call of GetTermStudents.
+func (mr *MockRepositoryMockRecorder) GetTermStudents(ctx, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTermStudents", reflect.TypeOf((*MockRepository)(nil).GetTermStudents), ctx, term)
+}
+
+// GetWebhook mocks base method.
+func (m *MockRepository) GetWebhook(arg0 context.Context, arg1 uuid.UUID) (domain.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", arg0, arg1)
+	ret0, _ := ret[0].(domain.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockRepositoryMockRecorder) GetWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockRepository)(nil).GetWebhook), arg0, arg1)
+}
+
+// InsertGrade mocks base method.
+func (m *MockRepository) InsertGrade(arg0 context.Context, arg1 domain.Grade) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertGrade", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertGrade indicates an expected call of InsertGrade.
+func (mr *MockRepositoryMockRecorder) InsertGrade(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGrade", reflect.TypeOf((*MockRepository)(nil).InsertGrade), arg0, arg1)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockRepository) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]domain.Delivery, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, webhookID, limit, offset)
+	ret0, _ := ret[0].([]domain.Delivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockRepositoryMockRecorder) ListDeliveries(ctx, webhookID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockRepository)(nil).ListDeliveries), ctx, webhookID, limit, offset)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockRepository) ListWebhooks(arg0 context.Context) ([]domain.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", arg0)
+	ret0, _ := ret[0].([]domain.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockRepositoryMockRecorder) ListWebhooks(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockRepository)(nil).ListWebhooks), arg0)
+}
+
+// MarkEventsDelivered mocks base method.
+func (m *MockRepository) MarkEventsDelivered(arg0 context.Context, arg1 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEventsDelivered", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEventsDelivered indicates an expected call of MarkEventsDelivered.
+func (mr *MockRepositoryMockRecorder) MarkEventsDelivered(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEventsDelivered", reflect.TypeOf((*MockRepository)(nil).MarkEventsDelivered), arg0, arg1)
+}
+
+// ReleaseEvents mocks base method.
+func (m *MockRepository) ReleaseEvents(arg0 context.Context, arg1 []uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseEvents indicates an expected call of ReleaseEvents.
+func (mr *MockRepositoryMockRecorder) ReleaseEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseEvents", reflect.TypeOf((*MockRepository)(nil).ReleaseEvents), arg0, arg1)
+}
+
+// SetScales mocks base method.
+func (m *MockRepository) SetScales(arg0 context.Context, arg1 domain.ScaleType, arg2 domain.Scales) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScales", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScales indicates an expected call of SetScales.
+func (mr *MockRepositoryMockRecorder) SetScales(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScales", reflect.TypeOf((*MockRepository)(nil).SetScales), arg0, arg1, arg2)
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockRepository) UpdateDelivery(arg0 context.Context, arg1 domain.Delivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockRepositoryMockRecorder) UpdateDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockRepository)(nil).UpdateDelivery), arg0, arg1)
+}
+
+// UpdateGrade mocks base method.
+func (m *MockRepository) UpdateGrade(arg0 context.Context, arg1 domain.Grade) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGrade", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGrade indicates an expected call of UpdateGrade.
+func (mr *MockRepositoryMockRecorder) UpdateGrade(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGrade", reflect.TypeOf((*MockRepository)(nil).UpdateGrade), arg0, arg1)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockRepository) UpdateWebhook(arg0 context.Context, arg1 domain.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockRepositoryMockRecorder) UpdateWebhook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockRepository)(nil).UpdateWebhook), arg0, arg1)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(ctx context.Context, fn func(Repository) error, opts ...TxOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, fn}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WithTx", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(ctx, fn interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, fn}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), varargs...)
+}
@@ -0,0 +1,801 @@
+// Package rdbmstest is the conformance suite every rdbms.Repository implementation must pass.
+package rdbmstest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// Setup returns a repository holding the default scale of the migrations and the given grades only,
+	// inserted in order.
+	Setup func(t *testing.T, grades []domain.Grade) rdbms.Repository
+)
+
+// defaultScales are the bands of the default scale seeded by the migrations.
+var defaultScales = domain.Scales{
+	{Min: 4, GPA: "A"},
+	{Min: 3, GPA: "B"},
+	{Min: 2, GPA: "C"},
+	{Min: 1, GPA: "D"},
+	{Min: 0, GPA: "F"},
+}
+
+// language=sql
+const (
+	deleteGrades     = `delete from grade`
+	deleteScales     = `delete from scale where type <> 'default' or tenant_id <> 'default'`
+	deleteOutbox     = `delete from outbox`
+	deleteDeliveries = `delete from webhook_delivery`
+	deleteWebhooks   = `delete from webhook`
+	insertGrade      = `insert into grade (student_id, course_id, grade, term, credits, type) values (?, ?, ?, ?, ?, ?)`
+)
+
+// SQLSetup returns a Setup resetting the tables of a migrated SQL database before seeding it.
+func SQLSetup(db *sqlx.DB, repo rdbms.Repository) Setup {
+	return func(t *testing.T, grades []domain.Grade) rdbms.Repository {
+		t.Helper()
+		ctx := context.Background()
+		_, err := db.ExecContext(ctx, deleteGrades)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, deleteScales)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, deleteOutbox)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, deleteDeliveries)
+		require.NoError(t, err)
+		_, err = db.ExecContext(ctx, deleteWebhooks)
+		require.NoError(t, err)
+		for _, grade := range grades {
+			_, err := db.ExecContext(ctx, db.Rebind(insertGrade), grade.StudentID.String(), grade.CourseID.String(), grade.Grade,
+				grade.Term, grade.Credits, grade.Type)
+			require.NoError(t, err)
+		}
+		return repo
+	}
+}
+
+// Run runs the conformance suite against the repositories returned by setup.
+func Run(t *testing.T, setup Setup) {
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	grades := []domain.Grade{
+		{StudentID: alice, CourseID: uuid.New(), Grade: 4},
+		{StudentID: bob, CourseID: uuid.New(), Grade: 2},
+		{StudentID: alice, CourseID: uuid.New(), Grade: 3},
+		{StudentID: carol, CourseID: uuid.New(), Grade: 0},
+	}
+
+	t.Run("GetGrades", func(t *testing.T) {
+		testCases := map[string]struct {
+			limit, offset int
+			expected      []domain.Grade
+		}{
+			"first page":   {limit: 2, offset: 0, expected: grades[:2]},
+			"last page":    {limit: 3, offset: 2, expected: grades[2:]},
+			"out of range": {limit: 2, offset: 10, expected: nil},
+		}
+		repo := setup(t, grades)
+		for name, tc := range testCases {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				got, total, err := repo.GetGrades(context.Background(), tc.limit, tc.offset)
+				require.NoError(t, err)
+				require.Equal(t, len(grades), total)
+				if len(tc.expected) == 0 {
+					require.Empty(t, got)
+					return
+				}
+				require.Equal(t, tc.expected, got, "grades must be in insertion order")
+			})
+		}
+	})
+
+	t.Run("GetGrades empty", func(t *testing.T) {
+		got, total, err := setup(t, nil).GetGrades(context.Background(), 10, 0)
+		require.NoError(t, err)
+		require.Zero(t, total)
+		require.Empty(t, got)
+	})
+
+	t.Run("GetStudentGrades", func(t *testing.T) {
+		repo := setup(t, grades)
+		got, err := repo.GetStudentGrades(context.Background(), alice)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Grade{grades[0], grades[2]}, got)
+
+		got, err = repo.GetStudentGrades(context.Background(), uuid.New())
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("GetGradesByStudents", func(t *testing.T) {
+		repo := setup(t, grades)
+		unknown := uuid.New()
+		got, err := repo.GetGradesByStudents(context.Background(), []uuid.UUID{alice, carol, unknown})
+		require.NoError(t, err)
+		require.Equal(t, map[uuid.UUID][]domain.Grade{
+			alice: {grades[0], grades[2]},
+			carol: {grades[3]},
+		}, got)
+
+		got, err = repo.GetGradesByStudents(context.Background(), nil)
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("GetTermStudents", func(t *testing.T) {
+		fall := []domain.Grade{
+			{StudentID: carol, CourseID: uuid.New(), Grade: 3, Term: "2024-fall", Credits: 4},
+			{StudentID: alice, CourseID: uuid.New(), Grade: 2, Term: "2024-fall", Credits: 3},
+			{StudentID: carol, CourseID: uuid.New(), Grade: 4, Term: "2024-fall", Credits: 3},
+			{StudentID: bob, CourseID: uuid.New(), Grade: 1, Term: "2024-spring", Credits: 3},
+		}
+		repo := setup(t, append(fall, grades...))
+		expected := []uuid.UUID{alice, carol}
+		if carol.String() < alice.String() {
+			expected = []uuid.UUID{carol, alice}
+		}
+		got, err := repo.GetTermStudents(context.Background(), "2024-fall")
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+
+		got, err = repo.GetTermStudents(context.Background(), "2023-fall")
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("GetCourseStats", func(t *testing.T) {
+		course := uuid.New()
+		var courseGrades []domain.Grade
+		for _, grade := range []int{10, 2, 4, 1, 3, 2} {
+			courseGrades = append(courseGrades, domain.Grade{StudentID: uuid.New(), CourseID: course, Grade: grade})
+		}
+		repo := setup(t, append(courseGrades, grades...))
+
+		stats, err := repo.GetCourseStats(context.Background(), course)
+		require.NoError(t, err)
+		expected := domain.NewGradeStats([]domain.GradeCount{{Grade: 1, Count: 1}, {Grade: 2, Count: 2},
+			{Grade: 3, Count: 1}, {Grade: 4, Count: 1}, {Grade: 10, Count: 1}})
+		require.Equal(t, expected.Counts, stats.Counts)
+		require.Equal(t, expected.Count, stats.Count)
+		require.Equal(t, expected.Min, stats.Min)
+		require.Equal(t, expected.Max, stats.Max)
+		require.InDelta(t, expected.Mean, stats.Mean, 1e-9)
+		require.InDelta(t, expected.StdDev, stats.StdDev, 1e-9)
+		require.InDelta(t, expected.Median, stats.Median, 1e-9)
+		require.InDelta(t, expected.Percentiles.P10, stats.Percentiles.P10, 1e-9)
+		require.InDelta(t, expected.Percentiles.P25, stats.Percentiles.P25, 1e-9)
+		require.InDelta(t, expected.Percentiles.P75, stats.Percentiles.P75, 1e-9)
+		require.InDelta(t, expected.Percentiles.P90, stats.Percentiles.P90, 1e-9)
+
+		stats, err = repo.GetCourseStats(context.Background(), uuid.New())
+		require.NoError(t, err)
+		require.Zero(t, stats.Count)
+		require.Empty(t, stats.Counts)
+	})
+
+	t.Run("GetGradeCounts", func(t *testing.T) {
+		repo := setup(t, append([]domain.Grade{
+			{StudentID: bob, CourseID: uuid.New(), Grade: 4},
+			{StudentID: carol, CourseID: uuid.New(), Type: domain.PassGrade},
+		}, grades...))
+		got, err := repo.GetGradeCounts(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []domain.GradeCount{{Grade: 0, Count: 1}, {Grade: 2, Count: 1}, {Grade: 3, Count: 1}, {Grade: 4, Count: 2}}, got)
+
+		got, err = repo.GetGradeCounts(auth.WithTenant(context.Background(), "north-high"))
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("GetRankPosition", func(t *testing.T) {
+		math, art := uuid.New(), uuid.New()
+		dave := uuid.New()
+		repo := setup(t, append([]domain.Grade{
+			{StudentID: dave, CourseID: math, Grade: 3},
+			{StudentID: bob, CourseID: math, Grade: 1},
+			{StudentID: carol, CourseID: art, Grade: 4},
+		}, grades...))
+		// averages: alice 3.5, dave 3, carol 2, bob 1.5; bob and dave took math
+		testCases := map[string]struct {
+			studentID, courseID uuid.UUID
+			expected            domain.RankPosition
+			expectedErr         error
+		}{
+			"first of every student": {
+				studentID: alice,
+				expected:  domain.RankPosition{StudentID: alice, Average: 3.5, CompetitionRank: 1, DenseRank: 1, CohortSize: 4, CumeDist: 1},
+			},
+			"last of every student": {
+				studentID: bob,
+				expected:  domain.RankPosition{StudentID: bob, Average: 1.5, CompetitionRank: 4, DenseRank: 4, CohortSize: 4, CumeDist: 0.25},
+			},
+			"last of a course": {
+				studentID: bob,
+				courseID:  math,
+				expected:  domain.RankPosition{StudentID: bob, Average: 1.5, CompetitionRank: 2, DenseRank: 2, CohortSize: 2, CumeDist: 0.5},
+			},
+			"not in the course": {
+				studentID:   alice,
+				courseID:    math,
+				expectedErr: domain.ErrStudentNotFound,
+			},
+			"unknown student": {
+				studentID:   uuid.New(),
+				expectedErr: domain.ErrStudentNotFound,
+			},
+		}
+
+		for name, tc := range testCases {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				got, err := repo.GetRankPosition(context.Background(), tc.studentID, tc.courseID)
+				if tc.expectedErr != nil {
+					require.ErrorIs(t, err, tc.expectedErr)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			})
+		}
+
+		t.Run("ties", func(t *testing.T) {
+			repo := setup(t, []domain.Grade{
+				{StudentID: alice, CourseID: math, Grade: 4},
+				{StudentID: bob, CourseID: math, Grade: 3},
+				{StudentID: bob, CourseID: art, Grade: 5},
+				{StudentID: carol, CourseID: math, Grade: 2},
+			})
+			got, err := repo.GetRankPosition(context.Background(), carol, uuid.Nil)
+			require.NoError(t, err)
+			require.Equal(t, domain.RankPosition{StudentID: carol, Average: 2, CompetitionRank: 3, DenseRank: 2, CohortSize: 3, CumeDist: 1.0 / 3}, got)
+		})
+
+		t.Run("weighted by credits", func(t *testing.T) {
+			repo := setup(t, []domain.Grade{
+				{StudentID: alice, CourseID: math, Grade: 4, Credits: 1},
+				{StudentID: alice, CourseID: art, Grade: 1, Credits: 3},
+				{StudentID: bob, CourseID: math, Grade: 2, Credits: 1},
+				{StudentID: bob, CourseID: art, Grade: 2, Credits: 3},
+				{StudentID: carol, CourseID: math, Grade: 3},
+			})
+			// plain averages: alice 2.5, bob 2; weighted: carol 3 (no credits), bob 2, alice 1.75
+			got, err := repo.GetRankPosition(context.Background(), alice, uuid.Nil)
+			require.NoError(t, err)
+			require.Equal(t, domain.RankPosition{StudentID: alice, Average: 1.75, CompetitionRank: 3, DenseRank: 3, CohortSize: 3, CumeDist: 1.0 / 3}, got)
+		})
+	})
+
+	t.Run("GetScales", func(t *testing.T) {
+		repo := setup(t, nil)
+		got, err := repo.GetScales(context.Background(), domain.DefaultScaleType)
+		require.NoError(t, err)
+		require.Equal(t, defaultScales, got)
+
+		_, err = repo.GetScales(context.Background(), domain.ScaleType("4.0"))
+		require.ErrorIs(t, err, domain.ErrScaleNotFound)
+	})
+
+	t.Run("SetScales", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := context.Background()
+		scaleType := domain.ScaleType("4.0")
+		require.NoError(t, repo.SetScales(ctx, scaleType, domain.Scales{{Min: 3, GPA: "4.0"}, {Min: 0, GPA: "0.0"}}))
+		require.NoError(t, repo.SetScales(ctx, scaleType, domain.Scales{{Min: 2, GPA: "3.0"}, {Min: 0, GPA: "1.0"}}))
+
+		got, err := repo.GetScales(ctx, scaleType)
+		require.NoError(t, err)
+		require.Equal(t, domain.Scales{{Min: 2, GPA: "3.0"}, {Min: 0, GPA: "1.0"}}, got, "bands must be replaced")
+
+		got, err = repo.GetScales(ctx, domain.DefaultScaleType)
+		require.NoError(t, err)
+		require.Equal(t, defaultScales, got, "other scales must be untouched")
+	})
+
+	t.Run("SetScales with bounds", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := context.Background()
+		high, low := 100, 90
+		bands := domain.Scales{
+			{Min: 90, GPA: "A", Max: &high},
+			{Min: 80, GPA: "B", Max: &low, MaxExclusive: true},
+			{Min: 60, GPA: "D", MinExclusive: true},
+		}
+		require.NoError(t, repo.SetScales(ctx, "ECTS", bands))
+
+		got, err := repo.GetScales(ctx, "ECTS")
+		require.NoError(t, err)
+		require.Equal(t, bands, got)
+
+		byType, err := repo.GetScalesByTypes(ctx, []domain.ScaleType{"ECTS"})
+		require.NoError(t, err)
+		require.Equal(t, bands, byType["ECTS"])
+	})
+
+	t.Run("GetScalesByTypes", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := context.Background()
+		require.NoError(t, repo.SetScales(ctx, "ECTS", domain.Scales{{Min: 3, GPA: "A"}, {Min: 0, GPA: "F"}}))
+
+		got, err := repo.GetScalesByTypes(ctx, []domain.ScaleType{domain.DefaultScaleType, "ECTS", "5.0"})
+		require.NoError(t, err)
+		require.Equal(t, map[domain.ScaleType]domain.Scales{
+			domain.DefaultScaleType: defaultScales,
+			"ECTS":                  {{Min: 3, GPA: "A"}, {Min: 0, GPA: "F"}},
+		}, got)
+
+		got, err = repo.GetScalesByTypes(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("DeleteScales", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := context.Background()
+		require.NoError(t, repo.SetScales(ctx, "7.0", domain.Scales{{Min: 0, GPA: "7"}}))
+		require.NoError(t, repo.DeleteScales(ctx, "7.0"))
+
+		_, err := repo.GetScales(ctx, "7.0")
+		require.ErrorIs(t, err, domain.ErrScaleNotFound)
+		require.ErrorIs(t, repo.DeleteScales(ctx, "7.0"), domain.ErrScaleNotFound)
+	})
+
+	t.Run("InsertGrade", func(t *testing.T) {
+		repo := setup(t, grades)
+		ctx := context.Background()
+		grade := domain.Grade{StudentID: carol, CourseID: uuid.New(), Grade: 3, Term: "2024-fall", Credits: 4}
+		require.NoError(t, repo.InsertGrade(ctx, grade))
+
+		got, err := repo.GetStudentGrades(ctx, carol)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Grade{grades[3], grade}, got)
+	})
+
+	t.Run("UpdateGrade", func(t *testing.T) {
+		repo := setup(t, grades)
+		ctx := context.Background()
+		updated := grades[2]
+		updated.Grade, updated.Term, updated.Credits = 1, "2024-spring", 3
+		require.NoError(t, repo.UpdateGrade(ctx, updated))
+
+		got, err := repo.GetStudentGrades(ctx, alice)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Grade{grades[0], updated}, got)
+
+		err = repo.UpdateGrade(ctx, domain.Grade{StudentID: alice, CourseID: uuid.New(), Grade: 1})
+		require.ErrorIs(t, err, domain.ErrGradeNotFound)
+	})
+
+	t.Run("GradeTypes", func(t *testing.T) {
+		dave := uuid.New()
+		withdrawn := domain.Grade{StudentID: dave, CourseID: grades[0].CourseID, Term: "2024-fall", Credits: 4, Type: domain.WithdrawnGrade}
+		repo := setup(t, append([]domain.Grade{withdrawn}, grades...))
+		ctx := context.Background()
+
+		got, err := repo.GetStudentGrades(ctx, dave)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Grade{withdrawn}, got)
+		stats, err := repo.GetCourseStats(ctx, grades[0].CourseID)
+		require.NoError(t, err)
+		require.Equal(t, 1, stats.Count, "a withdrawn grade must not count in the course stats")
+		_, err = repo.GetRankPosition(ctx, dave, uuid.Nil)
+		require.ErrorIs(t, err, domain.ErrStudentNotFound, "a student without counted grades must not be ranked")
+		position, err := repo.GetRankPosition(ctx, alice, grades[0].CourseID)
+		require.NoError(t, err)
+		require.Equal(t, 1, position.CohortSize, "a withdrawn grade must not join the course cohort")
+
+		audit := domain.Grade{StudentID: alice, CourseID: grades[2].CourseID, Term: "2024-spring", Type: domain.AuditGrade}
+		require.NoError(t, repo.InsertGrade(ctx, domain.Grade{StudentID: dave, CourseID: uuid.New(), Type: domain.PassGrade}))
+		require.NoError(t, repo.UpdateGrade(ctx, audit))
+		got, err = repo.GetStudentGrades(ctx, alice)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Grade{grades[0], audit}, got)
+		got, err = repo.GetStudentGrades(ctx, dave)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+		require.Equal(t, domain.PassGrade, got[1].Type)
+	})
+
+	t.Run("Tenants", func(t *testing.T) {
+		repo := setup(t, grades)
+		ctx := auth.WithTenant(context.Background(), "north-high")
+
+		got, total, err := repo.GetGrades(ctx, 10, 0)
+		require.NoError(t, err)
+		require.Zero(t, total, "the grades of the default tenant must not be visible")
+		require.Empty(t, got)
+		_, err = repo.GetScales(ctx, domain.DefaultScaleType)
+		require.ErrorIs(t, err, domain.ErrScaleNotFound, "a new tenant must start without scales")
+
+		grade := domain.Grade{StudentID: alice, CourseID: grades[0].CourseID, Grade: 1, Term: "2024-fall", Credits: 2}
+		require.NoError(t, repo.InsertGrade(ctx, grade))
+		require.NoError(t, repo.SetScales(ctx, domain.DefaultScaleType, domain.Scales{{Min: 0, GPA: "P"}}))
+		require.ErrorIs(t, repo.UpdateGrade(ctx, grades[2]), domain.ErrGradeNotFound)
+
+		got, err = repo.GetStudentGrades(ctx, alice)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Grade{grade}, got)
+		stats, err := repo.GetCourseStats(ctx, grades[0].CourseID)
+		require.NoError(t, err)
+		require.Equal(t, 1, stats.Count)
+		position, err := repo.GetRankPosition(ctx, alice, uuid.Nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, position.CohortSize)
+
+		got, err = repo.GetStudentGrades(context.Background(), alice)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Grade{grades[0], grades[2]}, got, "the default tenant must not see the grades of others")
+		scales, err := repo.GetScales(context.Background(), domain.DefaultScaleType)
+		require.NoError(t, err)
+		require.Equal(t, defaultScales, scales, "the default tenant must not see the scales of others")
+
+		require.NoError(t, repo.WithTx(ctx, func(tx rdbms.Repository) error {
+			return tx.DeleteScales(ctx, domain.DefaultScaleType)
+		}))
+		_, err = repo.GetScales(context.Background(), domain.DefaultScaleType)
+		require.NoError(t, err, "a unit of work must be scoped to its tenant")
+	})
+
+	t.Run("Outbox", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := context.Background()
+		events := make([]domain.Event, 3)
+		for i := range events {
+			event, err := domain.NewGradePosted(domain.Grade{StudentID: alice, CourseID: uuid.New(), Grade: i})
+			require.NoError(t, err)
+			// backends keep microseconds
+			event.OccurredAt = event.OccurredAt.Truncate(time.Microsecond)
+			event.TenantID = auth.DefaultTenant
+			events[i] = event
+		}
+		require.NoError(t, repo.AppendEvents(ctx, events[:2]))
+		require.NoError(t, repo.AppendEvents(ctx, events[2:]))
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		claimed, err := repo.ClaimEvents(ctx, now, time.Minute, 2)
+		require.NoError(t, err)
+		requireEvents(t, events[:2], claimed)
+		claimed, err = repo.ClaimEvents(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		requireEvents(t, events[2:], claimed, "claimed events must be skipped")
+		claimed, err = repo.ClaimEvents(ctx, now.Add(2*time.Minute), time.Minute, 1)
+		require.NoError(t, err)
+		requireEvents(t, events[:1], claimed, "events must be claimed again once the lease ended")
+
+		require.NoError(t, repo.MarkEventsDelivered(ctx, []uuid.UUID{events[0].ID, events[1].ID}))
+		require.NoError(t, repo.ReleaseEvents(ctx, []uuid.UUID{events[2].ID}))
+		pending, err := repo.ClaimEvents(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		requireEvents(t, events[2:], pending, "released events must be claimed again")
+		require.NoError(t, repo.ReleaseEvents(ctx, []uuid.UUID{events[2].ID}))
+
+		discarded, err := domain.NewGradePosted(domain.Grade{StudentID: bob, CourseID: uuid.New(), Grade: 1})
+		require.NoError(t, err)
+		errAbort := errors.New("abort")
+		err = repo.WithTx(ctx, func(repo rdbms.Repository) error {
+			if err := repo.AppendEvents(ctx, []domain.Event{discarded}); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+		pending, err = repo.ClaimEvents(ctx, now, time.Minute, 10)
+		require.NoError(t, err)
+		requireEvents(t, events[2:], pending, "events of a rolled back unit of work must be discarded")
+
+		t.Run("keys", func(t *testing.T) {
+			repo := setup(t, nil)
+			standing := domain.Standing{StudentID: alice, StandingMetrics: domain.StandingMetrics{Term: "2024-fall"}}
+			flagged := make([]domain.Event, 3)
+			for i := range flagged {
+				event, err := domain.NewStandingFlagged(standing)
+				require.NoError(t, err)
+				event.OccurredAt = event.OccurredAt.Truncate(time.Microsecond)
+				event.TenantID = auth.DefaultTenant
+				flagged[i] = event
+			}
+			require.NoError(t, repo.AppendEvents(ctx, flagged[:1]))
+			claimed, err := repo.ClaimEvents(ctx, now, time.Minute, 10)
+			require.NoError(t, err)
+			requireEvents(t, flagged[:1], claimed)
+			require.NoError(t, repo.MarkEventsDelivered(ctx, []uuid.UUID{flagged[0].ID}))
+
+			require.NoError(t, repo.AppendEvents(ctx, flagged[1:]))
+			require.NoError(t, repo.AppendEvents(auth.WithTenant(ctx, "north-high"), flagged[2:]))
+			pending, err := repo.ClaimEvents(auth.WithTenant(ctx, auth.AllTenants), now, time.Minute, 10)
+			require.NoError(t, err)
+			flagged[2].TenantID = "north-high"
+			requireEvents(t, flagged[2:], pending, "an event with the key of one appended in its tenant must be dropped")
+		})
+	})
+
+	t.Run("Webhooks", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := context.Background()
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		first := domain.Webhook{
+			ID: uuid.New(), TenantID: auth.DefaultTenant, URL: "https://partner.example.com/hooks", Secret: "s3cr3t",
+			Active: true, CreatedAt: now, UpdatedAt: now,
+		}
+		second := domain.Webhook{
+			ID: uuid.New(), TenantID: auth.DefaultTenant, URL: "http://localhost:9000", Secret: "other",
+			EventTypes: domain.EventTypeSet{domain.GPAChanged, domain.GradePosted}, Active: true,
+			CreatedAt: now.Add(time.Second), UpdatedAt: now.Add(time.Second),
+		}
+		require.NoError(t, repo.CreateWebhook(ctx, first))
+		require.NoError(t, repo.CreateWebhook(ctx, second))
+
+		got, err := repo.GetWebhook(ctx, second.ID)
+		require.NoError(t, err)
+		require.Equal(t, second, normalizeWebhook(got))
+		webhooks, err := repo.ListWebhooks(ctx)
+		require.NoError(t, err)
+		require.Len(t, webhooks, 2)
+		require.Equal(t, first, normalizeWebhook(webhooks[0]))
+
+		second.URL, second.Active, second.EventTypes = "http://localhost:9001", false, nil
+		second.UpdatedAt = now.Add(time.Minute)
+		require.NoError(t, repo.UpdateWebhook(ctx, second))
+		got, err = repo.GetWebhook(ctx, second.ID)
+		require.NoError(t, err)
+		require.Equal(t, second, normalizeWebhook(got))
+
+		require.NoError(t, repo.DeleteWebhook(ctx, first.ID))
+		_, err = repo.GetWebhook(ctx, first.ID)
+		require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+		require.ErrorIs(t, repo.DeleteWebhook(ctx, first.ID), domain.ErrWebhookNotFound)
+		require.ErrorIs(t, repo.UpdateWebhook(ctx, first), domain.ErrWebhookNotFound)
+	})
+
+	t.Run("Deliveries", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := context.Background()
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		webhook := domain.Webhook{ID: uuid.New(), URL: "http://localhost:9000", Secret: "s3cr3t", Active: true, CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, repo.CreateWebhook(ctx, webhook))
+
+		deliveries := make([]domain.Delivery, 3)
+		for i := range deliveries {
+			deliveries[i] = domain.Delivery{
+				ID: uuid.New(), WebhookID: webhook.ID, TenantID: auth.DefaultTenant, EventID: uuid.New(), EventType: domain.GradePosted,
+				Body: []byte(`{"id":"event"}`), Status: domain.DeliveryPending,
+				// the last one is the most overdue, and the first one is not due yet
+				NextAttemptAt: now.Add(time.Duration(1-i) * time.Minute),
+				CreatedAt:     now.Add(time.Duration(i) * time.Second), UpdatedAt: now,
+			}
+		}
+		require.NoError(t, repo.EnqueueDeliveries(ctx, deliveries[:2]))
+		duplicate := deliveries[1]
+		duplicate.ID = uuid.New()
+		require.NoError(t, repo.EnqueueDeliveries(ctx, []domain.Delivery{deliveries[2], duplicate}))
+
+		due, err := repo.DueDeliveries(ctx, now, 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Delivery{deliveries[2], deliveries[1]}, normalizeDeliveries(due))
+		due, err = repo.DueDeliveries(ctx, now, 1)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Delivery{deliveries[2]}, normalizeDeliveries(due))
+
+		attempted := deliveries[2]
+		attempted.Status, attempted.Attempts, attempted.LastStatusCode, attempted.LastError = domain.DeliveryDead, 5, 503, "503 Service Unavailable"
+		attempted.UpdatedAt = now.Add(time.Minute)
+		require.NoError(t, repo.UpdateDelivery(ctx, attempted))
+		got, err := repo.GetDelivery(ctx, attempted.ID)
+		require.NoError(t, err)
+		require.Equal(t, attempted, normalizeDelivery(got))
+		due, err = repo.DueDeliveries(ctx, now, 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Delivery{deliveries[1]}, normalizeDeliveries(due))
+
+		page, total, err := repo.ListDeliveries(ctx, webhook.ID, 2, 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, total)
+		require.Equal(t, []domain.Delivery{attempted, deliveries[1]}, normalizeDeliveries(page))
+		page, _, err = repo.ListDeliveries(ctx, webhook.ID, 2, 2)
+		require.NoError(t, err)
+		require.Equal(t, []domain.Delivery{deliveries[0]}, normalizeDeliveries(page))
+
+		_, err = repo.GetDelivery(ctx, duplicate.ID)
+		require.ErrorIs(t, err, domain.ErrDeliveryNotFound)
+		require.ErrorIs(t, repo.UpdateDelivery(ctx, duplicate), domain.ErrDeliveryNotFound)
+
+		require.NoError(t, repo.DeleteWebhook(ctx, webhook.ID))
+		_, err = repo.GetDelivery(ctx, attempted.ID)
+		require.ErrorIs(t, err, domain.ErrDeliveryNotFound, "the deliveries of a webhook must be deleted with it")
+	})
+
+	t.Run("Tenant webhooks", func(t *testing.T) {
+		repo := setup(t, nil)
+		ctx := auth.WithTenant(context.Background(), "north-high")
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		webhook := domain.Webhook{ID: uuid.New(), URL: "http://localhost:9000", Secret: "s3cr3t", Active: true, CreatedAt: now, UpdatedAt: now}
+		require.NoError(t, repo.CreateWebhook(ctx, webhook))
+		delivery := domain.Delivery{
+			ID: uuid.New(), WebhookID: webhook.ID, EventID: uuid.New(), EventType: domain.GradePosted,
+			Body: []byte(`{"id":"event"}`), Status: domain.DeliveryPending, NextAttemptAt: now, CreatedAt: now, UpdatedAt: now,
+		}
+		require.NoError(t, repo.EnqueueDeliveries(ctx, []domain.Delivery{delivery}))
+		event, err := domain.NewGradePosted(domain.Grade{StudentID: alice, CourseID: uuid.New(), Grade: 1})
+		require.NoError(t, err)
+		require.NoError(t, repo.AppendEvents(ctx, []domain.Event{event}))
+
+		other := context.Background()
+		webhooks, err := repo.ListWebhooks(other)
+		require.NoError(t, err)
+		require.Empty(t, webhooks, "the default tenant must not see the webhooks of others")
+		_, err = repo.GetWebhook(other, webhook.ID)
+		require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+		require.ErrorIs(t, repo.UpdateWebhook(other, webhook), domain.ErrWebhookNotFound)
+		require.ErrorIs(t, repo.DeleteWebhook(other, webhook.ID), domain.ErrWebhookNotFound)
+		_, err = repo.GetDelivery(other, delivery.ID)
+		require.ErrorIs(t, err, domain.ErrDeliveryNotFound)
+		require.ErrorIs(t, repo.UpdateDelivery(other, delivery), domain.ErrDeliveryNotFound)
+		page, total, err := repo.ListDeliveries(other, webhook.ID, 10, 0)
+		require.NoError(t, err)
+		require.Zero(t, total)
+		require.Empty(t, page)
+		due, err := repo.DueDeliveries(other, now, 10)
+		require.NoError(t, err)
+		require.Empty(t, due, "the default tenant must not see the deliveries of others")
+		claimed, err := repo.ClaimEvents(other, now, time.Minute, 10)
+		require.NoError(t, err)
+		require.Empty(t, claimed, "the default tenant must not see the events of others")
+
+		webhooks, err = repo.ListWebhooks(ctx)
+		require.NoError(t, err)
+		require.Len(t, webhooks, 1)
+		require.Equal(t, "north-high", webhooks[0].TenantID)
+
+		all := auth.WithTenant(context.Background(), auth.AllTenants)
+		due, err = repo.DueDeliveries(all, now, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1, "the deliverer must see the deliveries of every tenant")
+		require.Equal(t, "north-high", due[0].TenantID)
+		var events []domain.Event
+		require.NoError(t, repo.WithTx(all, func(repo rdbms.Repository) error {
+			events, err = repo.ClaimEvents(all, now, time.Minute, 10)
+			return err
+		}))
+		require.Len(t, events, 1, "the relay must see the events of every tenant")
+		require.Equal(t, event.ID, events[0].ID)
+		require.Equal(t, "north-high", events[0].TenantID)
+		require.NoError(t, repo.MarkEventsDelivered(all, []uuid.UUID{event.ID}))
+	})
+
+	t.Run("WithTx", func(t *testing.T) {
+		errAbort := errors.New("abort")
+		bands := domain.Scales{{Min: 3, GPA: "A"}, {Min: 0, GPA: "F"}}
+		testCases := map[string]struct {
+			fn       func(ctx context.Context, repo rdbms.Repository) error
+			opts     []rdbms.TxOption
+			err      error
+			expected map[domain.ScaleType]domain.Scales
+		}{
+			"commit": {
+				fn: func(ctx context.Context, repo rdbms.Repository) error {
+					if err := repo.SetScales(ctx, "ECTS", bands); err != nil {
+						return err
+					}
+					got, err := repo.GetScales(ctx, "ECTS")
+					if err != nil {
+						return err
+					}
+					if len(got) != len(bands) {
+						return errors.New("the unit of work must read its own writes")
+					}
+					return repo.DeleteScales(ctx, "7.0")
+				},
+				expected: map[domain.ScaleType]domain.Scales{domain.DefaultScaleType: defaultScales, "ECTS": bands},
+			},
+			"rollback": {
+				fn: func(ctx context.Context, repo rdbms.Repository) error {
+					if err := repo.SetScales(ctx, "ECTS", bands); err != nil {
+						return err
+					}
+					if err := repo.DeleteScales(ctx, "7.0"); err != nil {
+						return err
+					}
+					return errAbort
+				},
+				err: errAbort,
+				expected: map[domain.ScaleType]domain.Scales{
+					domain.DefaultScaleType: defaultScales,
+					"7.0":                   {{Min: 0, GPA: "7"}},
+				},
+			},
+			"nested units of work join the outer one": {
+				fn: func(ctx context.Context, repo rdbms.Repository) error {
+					if err := repo.WithTx(ctx, func(repo rdbms.Repository) error {
+						return repo.SetScales(ctx, "ECTS", bands)
+					}); err != nil {
+						return err
+					}
+					return errAbort
+				},
+				err: errAbort,
+				expected: map[domain.ScaleType]domain.Scales{
+					domain.DefaultScaleType: defaultScales,
+					"7.0":                   {{Min: 0, GPA: "7"}},
+				},
+			},
+			"serializable": {
+				fn: func(ctx context.Context, repo rdbms.Repository) error {
+					return repo.SetScales(ctx, "ECTS", bands)
+				},
+				opts: []rdbms.TxOption{rdbms.Isolation(sql.LevelSerializable), rdbms.MaxAttempts(5)},
+				expected: map[domain.ScaleType]domain.Scales{
+					domain.DefaultScaleType: defaultScales,
+					"7.0":                   {{Min: 0, GPA: "7"}},
+					"ECTS":                  bands,
+				},
+			},
+		}
+
+		for name, tc := range testCases {
+			tc := tc
+			t.Run(name, func(t *testing.T) {
+				repo := setup(t, nil)
+				ctx := context.Background()
+				require.NoError(t, repo.SetScales(ctx, "7.0", domain.Scales{{Min: 0, GPA: "7"}}))
+
+				err := repo.WithTx(ctx, func(repo rdbms.Repository) error {
+					return tc.fn(ctx, repo)
+				}, tc.opts...)
+				if tc.err != nil {
+					require.ErrorIs(t, err, tc.err)
+				} else {
+					require.NoError(t, err)
+				}
+
+				got, err := repo.GetScalesByTypes(ctx, domain.ScaleTypes)
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, got)
+			})
+		}
+	})
+}
+
+// requireEvents compares events as stored: payloads are compared as JSON, and times regardless of location.
+func requireEvents(t *testing.T, expected, actual []domain.Event, msgAndArgs ...interface{}) {
+	t.Helper()
+	require.Len(t, actual, len(expected), msgAndArgs...)
+	for i := range expected {
+		require.Equal(t, expected[i].ID, actual[i].ID, msgAndArgs...)
+		require.Equal(t, expected[i].Type, actual[i].Type, msgAndArgs...)
+		require.Equal(t, expected[i].TenantID, actual[i].TenantID, msgAndArgs...)
+		require.JSONEq(t, string(expected[i].Payload), string(actual[i].Payload), msgAndArgs...)
+		require.True(t, expected[i].OccurredAt.Equal(actual[i].OccurredAt), "occurred at %s, got %s",
+			expected[i].OccurredAt, actual[i].OccurredAt)
+	}
+}
+
+// normalizeWebhook sets the times of a stored webhook in UTC, backends returning them in various locations.
+func normalizeWebhook(webhook domain.Webhook) domain.Webhook {
+	webhook.CreatedAt, webhook.UpdatedAt = webhook.CreatedAt.UTC(), webhook.UpdatedAt.UTC()
+	return webhook
+}
+
+// normalizeDelivery sets the times of a stored delivery in UTC, backends returning them in various locations.
+func normalizeDelivery(delivery domain.Delivery) domain.Delivery {
+	delivery.NextAttemptAt = delivery.NextAttemptAt.UTC()
+	delivery.CreatedAt, delivery.UpdatedAt = delivery.CreatedAt.UTC(), delivery.UpdatedAt.UTC()
+	return delivery
+}
+
+func normalizeDeliveries(deliveries []domain.Delivery) []domain.Delivery {
+	normalized := make([]domain.Delivery, len(deliveries))
+	for i, delivery := range deliveries {
+		normalized[i] = normalizeDelivery(delivery)
+	}
+	return normalized
+}
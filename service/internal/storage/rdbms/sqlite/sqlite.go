@@ -0,0 +1,20 @@
+// Package sqlite is the implementation of the storage layer using SQLite, see qmark.
+package sqlite
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/qmark"
+)
+
+// dialect neither locks rows nor retries the units of work: SQLite serializes them.
+var dialect = qmark.Dialect{
+	Float:        "real",
+	Now:          "strftime('%Y-%m-%d %H:%M:%f', 'now')",
+	InsertIgnore: "insert or ignore",
+}
+
+// New ...
+func New(db *sqlx.DB) rdbms.Repository {
+	return qmark.New(db, dialect)
+}
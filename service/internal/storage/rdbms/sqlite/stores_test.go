@@ -0,0 +1,21 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	migration "github.com/mnabbasabadi/grading/service/internal/storage/migration/sqlite"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/rdbmstest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository(t *testing.T) {
+	conn, err := db.ConnectToSQLite(db.WithDatabase(":memory:"))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	require.NoError(t, migration.GooseUP(conn.DB))
+
+	rdbmstest.Run(t, rdbmstest.SQLSetup(conn, New(conn)))
+}
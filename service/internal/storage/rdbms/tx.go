@@ -0,0 +1,114 @@
+package rdbms
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	// DBTX is what the SQL backends query through: a *sqlx.DB, or a *sqlx.Tx within a unit of work.
+	DBTX interface {
+		sqlx.ExtContext
+		GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+		SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+		PrepareNamedContext(ctx context.Context, query string) (*sqlx.NamedStmt, error)
+		NamedExecContext(ctx context.Context, query string, arg interface{}) (sql.Result, error)
+	}
+
+	// TxOptions are the options of a unit of work.
+	TxOptions struct {
+		Isolation sql.IsolationLevel
+		ReadOnly  bool
+		// MaxAttempts bounds the attempts of a unit of work failing on a serialization failure or a deadlock.
+		MaxAttempts int
+	}
+
+	// TxOption configures a unit of work, see Repository.WithTx.
+	TxOption func(*TxOptions)
+)
+
+var (
+	_ DBTX = new(sqlx.DB)
+	_ DBTX = new(sqlx.Tx)
+)
+
+// Isolation sets the isolation level of the transaction, the default level of the backend otherwise.
+func Isolation(level sql.IsolationLevel) TxOption {
+	return func(o *TxOptions) {
+		o.Isolation = level
+	}
+}
+
+// ReadOnly makes the transaction read-only.
+func ReadOnly() TxOption {
+	return func(o *TxOptions) {
+		o.ReadOnly = true
+	}
+}
+
+// MaxAttempts sets how many times a unit of work is attempted while it fails on serialization failures.
+// The default is 3.
+func MaxAttempts(attempts int) TxOption {
+	return func(o *TxOptions) {
+		o.MaxAttempts = attempts
+	}
+}
+
+// NewTxOptions applies the options to the defaults.
+func NewTxOptions(opts ...TxOption) TxOptions {
+	o := TxOptions{
+		Isolation:   sql.LevelDefault,
+		MaxAttempts: 3,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.MaxAttempts < 1 {
+		o.MaxAttempts = 1
+	}
+	return o
+}
+
+// RunInTx runs fn in a transaction of db, committed when fn returns nil and rolled back otherwise.
+// The whole transaction is attempted again, after a short pause, while it fails with an error retryable reports,
+// a nil retryable retrying nothing.
+func RunInTx(ctx context.Context, db *sqlx.DB, o TxOptions, retryable func(error) bool, fn func(*sqlx.Tx) error) error {
+	var err error
+	for attempt := 1; attempt <= o.MaxAttempts; attempt++ {
+		if err = runInTx(ctx, db, o, fn); err == nil || retryable == nil || !retryable(err) {
+			return err
+		}
+		if attempt == o.MaxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * 10 * time.Millisecond):
+		}
+	}
+	return fmt.Errorf("giving up after %d attempts: %w", o.MaxAttempts, err)
+}
+
+func runInTx(ctx context.Context, db *sqlx.DB, o TxOptions, fn func(*sqlx.Tx) error) (err error) {
+	tx, err := db.BeginTxx(ctx, &sql.TxOptions{Isolation: o.Isolation, ReadOnly: o.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	if err = fn(tx); err != nil {
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
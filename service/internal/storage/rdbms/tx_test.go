@@ -0,0 +1,89 @@
+package rdbms
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunInTx(t *testing.T) {
+	conn, err := db.ConnectToSQLite(db.WithDatabase(":memory:"))
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	_, err = conn.Exec(`create table counter (n integer not null)`)
+	require.NoError(t, err)
+
+	errConflict, errOther := errors.New("conflict"), errors.New("other")
+	retryable := func(err error) bool {
+		return errors.Is(err, errConflict)
+	}
+
+	testCases := map[string]struct {
+		opts      []TxOption
+		failures  []error
+		attempts  int
+		committed bool
+		err       error
+	}{
+		"commit": {
+			attempts:  1,
+			committed: true,
+		},
+		"retried conflict": {
+			failures:  []error{errConflict, errConflict},
+			attempts:  3,
+			committed: true,
+		},
+		"too many conflicts": {
+			opts:     []TxOption{MaxAttempts(2)},
+			failures: []error{errConflict, errConflict, errConflict},
+			attempts: 2,
+			err:      errConflict,
+		},
+		"other errors are not retried": {
+			failures: []error{errOther},
+			attempts: 1,
+			err:      errOther,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := conn.Exec(`delete from counter`)
+			require.NoError(t, err)
+
+			attempts := 0
+			err = RunInTx(context.Background(), conn, NewTxOptions(tc.opts...), retryable, func(tx *sqlx.Tx) error {
+				attempts++
+				if _, err := tx.Exec(`insert into counter (n) values (?)`, attempts); err != nil {
+					return err
+				}
+				if attempts <= len(tc.failures) {
+					return tc.failures[attempts-1]
+				}
+				return nil
+			})
+			require.Equal(t, tc.attempts, attempts)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			var rows []int
+			require.NoError(t, conn.Select(&rows, `select n from counter`))
+			if tc.committed {
+				require.Equal(t, []int{tc.attempts}, rows, "only the last attempt must be committed")
+			} else {
+				require.Empty(t, rows)
+			}
+		})
+	}
+}
@@ -0,0 +1,204 @@
+// Package seed generates synthetic grades and loads them into a database, to load-test and demo the service.
+package seed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// batchSize is the number of grades inserted per statement, within the bind variable limits of every backend.
+const batchSize = 500
+
+// timestampLayout is understood by every backend, and is the layout SQLite stores timestamps with.
+const timestampLayout = "2006-01-02 15:04:05.000"
+
+// firstTerm is when the first term ends. Terms last six months.
+var firstTerm = time.Date(2020, time.January, 31, 12, 0, 0, 0, time.UTC)
+
+// credits are the credits a course may be worth.
+var credits = []int{2, 3, 4, 5, 6}
+
+type (
+	// Options are the volumes to generate. The same options generate the same grades.
+	Options struct {
+		Students int
+		Courses  int
+		// Terms is the number of terms the students attend, taking CoursesPerTerm courses per term.
+		Terms          int
+		CoursesPerTerm int
+		// MaxGrade is the highest grade, the lowest being 0.
+		MaxGrade int
+		Seed     int64
+	}
+
+	// Grade is a generated grade, with its term and the credits of its course, and the end of the term it was
+	// given at.
+	Grade struct {
+		domain.Grade
+		GivenAt time.Time
+	}
+)
+
+// DefaultOptions generate 60000 grades: 1000 students taking 5 of 200 courses per term for 12 terms.
+var DefaultOptions = Options{
+	Students:       1000,
+	Courses:        200,
+	Terms:          12,
+	CoursesPerTerm: 5,
+	MaxGrade:       4,
+	Seed:           1,
+}
+
+// Validate checks the options generate grades.
+func (o Options) Validate() error {
+	switch {
+	case o.Students <= 0:
+		return errors.New("students must be positive")
+	case o.Courses <= 0:
+		return errors.New("courses must be positive")
+	case o.Terms <= 0:
+		return errors.New("terms must be positive")
+	case o.CoursesPerTerm <= 0 || o.CoursesPerTerm > o.Courses:
+		return fmt.Errorf("courses per term must be between 1 and %d", o.Courses)
+	case o.MaxGrade <= 0:
+		return errors.New("max grade must be positive")
+	}
+	return nil
+}
+
+// Generate returns the grades of the options, ordered by term then student. Every student has an ability and
+// every course a difficulty and credits, so averages spread over the scale rather than all being the middle grade.
+// Students don't take a course twice, unless they have taken them all. Terms are named after the half year they
+// end in, e.g. 2020-1, see termName.
+func Generate(o Options) ([]Grade, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	rng := rand.New(rand.NewSource(o.Seed))
+
+	mean := float64(o.MaxGrade) / 2
+	students := make([]uuid.UUID, o.Students)
+	abilities := make([]float64, o.Students)
+	for i := range students {
+		students[i] = newUUID(rng)
+		abilities[i] = mean + rng.NormFloat64()*float64(o.MaxGrade)/5
+	}
+	courses := make([]uuid.UUID, o.Courses)
+	difficulties := make([]float64, o.Courses)
+	courseCredits := make([]int, o.Courses)
+	for i := range courses {
+		courses[i] = newUUID(rng)
+		difficulties[i] = rng.NormFloat64() * float64(o.MaxGrade) / 10
+		courseCredits[i] = credits[rng.Intn(len(credits))]
+	}
+
+	taken := make([]map[int]struct{}, o.Students)
+	grades := make([]Grade, 0, o.Students*o.Terms*o.CoursesPerTerm)
+	for term := 0; term < o.Terms; term++ {
+		givenAt := firstTerm.AddDate(0, 6*term, 0)
+		name := termName(givenAt)
+		for s := range students {
+			if taken[s] == nil || len(taken[s])+o.CoursesPerTerm > o.Courses {
+				taken[s] = make(map[int]struct{}, o.Courses)
+			}
+			for n := 0; n < o.CoursesPerTerm; {
+				c := rng.Intn(o.Courses)
+				if _, ok := taken[s][c]; ok {
+					continue
+				}
+				taken[s][c] = struct{}{}
+				n++
+
+				grade := math.Round(abilities[s] - difficulties[c] + rng.NormFloat64()*0.75)
+				grades = append(grades, Grade{
+					Grade: domain.Grade{
+						StudentID: students[s],
+						CourseID:  courses[c],
+						Grade:     int(math.Max(0, math.Min(float64(o.MaxGrade), grade))),
+						Term:      name,
+						Credits:   courseCredits[c],
+					},
+					GivenAt: givenAt,
+				})
+			}
+		}
+	}
+	return grades, nil
+}
+
+// termName names the term ending at end after its half year, so that the names sort chronologically.
+func termName(end time.Time) string {
+	half := 1
+	if end.Month() > time.June {
+		half = 2
+	}
+	return fmt.Sprintf("%d-%d", end.Year(), half)
+}
+
+func newUUID(rng *rand.Rand) uuid.UUID {
+	id, err := uuid.NewRandomFromReader(rng)
+	if err != nil {
+		// reading from a math/rand source never fails
+		panic(err)
+	}
+	return id
+}
+
+// Insert inserts the grades of the tenant of ctx in batches within a transaction, so a failed seed leaves the
+// database untouched. When reset is set, the existing grades of the tenant are deleted first.
+func Insert(ctx context.Context, db *sqlx.DB, grades []Grade, reset bool) error {
+	tenant := auth.TenantFrom(ctx)
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if db.DriverName() == "postgres" {
+		// the row level security policies only let the transaction write the rows of its tenant
+		if _, err := tx.ExecContext(ctx, `SELECT set_config('grading.tenant_id', $1, true)`, tenant); err != nil {
+			return fmt.Errorf("scoping to tenant: %w", err)
+		}
+	}
+	if reset {
+		if _, err := tx.ExecContext(ctx, db.Rebind(`DELETE FROM grade WHERE tenant_id = ?`), tenant); err != nil {
+			return fmt.Errorf("deleting grades: %w", err)
+		}
+	}
+	for start := 0; start < len(grades); start += batchSize {
+		batch := grades[start:min(start+batchSize, len(grades))]
+		query, args := insertQuery(tenant, batch)
+		if _, err := tx.ExecContext(ctx, db.Rebind(query), args...); err != nil {
+			return fmt.Errorf("inserting grades: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func insertQuery(tenant string, grades []Grade) (string, []interface{}) {
+	var query strings.Builder
+	query.WriteString(`INSERT INTO grade (student_id, course_id, grade, term, credits, created_at, updated_at, tenant_id) VALUES `)
+	args := make([]interface{}, 0, 8*len(grades))
+	for i, grade := range grades {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+		query.WriteString("(?, ?, ?, ?, ?, ?, ?, ?)")
+		givenAt := grade.GivenAt.Format(timestampLayout)
+		args = append(args, grade.StudentID.String(), grade.CourseID.String(), grade.Grade.Grade, grade.Term, grade.Credits,
+			givenAt, givenAt, tenant)
+	}
+	return query.String(), args
+}
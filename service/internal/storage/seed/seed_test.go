@@ -0,0 +1,131 @@
+package seed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	migration "github.com/mnabbasabadi/grading/service/internal/storage/migration/sqlite"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/sqlite"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	testCases := map[string]struct {
+		options Options
+		err     bool
+	}{
+		"default": {
+			options: DefaultOptions,
+		},
+		"more courses per term than courses over the terms": {
+			options: Options{Students: 3, Courses: 4, Terms: 5, CoursesPerTerm: 3, MaxGrade: 10, Seed: 2},
+		},
+		"no students": {
+			options: Options{Courses: 4, Terms: 1, CoursesPerTerm: 1, MaxGrade: 4},
+			err:     true,
+		},
+		"too many courses per term": {
+			options: Options{Students: 1, Courses: 4, Terms: 1, CoursesPerTerm: 5, MaxGrade: 4},
+			err:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			grades, err := Generate(tc.options)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			o := tc.options
+			require.Len(t, grades, o.Students*o.Terms*o.CoursesPerTerm)
+
+			students := map[uuid.UUID]struct{}{}
+			courses := map[uuid.UUID]int{}
+			terms := map[string]map[uuid.UUID]map[uuid.UUID]struct{}{}
+			for _, grade := range grades {
+				require.GreaterOrEqual(t, grade.Grade.Grade, 0)
+				require.LessOrEqual(t, grade.Grade.Grade, o.MaxGrade)
+				require.Contains(t, credits, grade.Credits)
+				if courseCredits, ok := courses[grade.CourseID]; ok {
+					require.Equal(t, courseCredits, grade.Credits, "a course is always worth the same credits")
+				}
+				students[grade.StudentID] = struct{}{}
+				courses[grade.CourseID] = grade.Credits
+
+				term := grade.Term
+				require.Equal(t, termName(grade.GivenAt), term)
+				if terms[term] == nil {
+					terms[term] = map[uuid.UUID]map[uuid.UUID]struct{}{}
+				}
+				if terms[term][grade.StudentID] == nil {
+					terms[term][grade.StudentID] = map[uuid.UUID]struct{}{}
+				}
+				require.NotContains(t, terms[term][grade.StudentID], grade.CourseID, "a course is taken once per term")
+				terms[term][grade.StudentID][grade.CourseID] = struct{}{}
+			}
+			require.Len(t, students, o.Students)
+			require.LessOrEqual(t, len(courses), o.Courses)
+			require.Len(t, terms, o.Terms)
+
+			again, err := Generate(o)
+			require.NoError(t, err)
+			require.Equal(t, grades, again, "the same options generate the same grades")
+		})
+	}
+}
+
+func TestTermName(t *testing.T) {
+	require.Equal(t, "2020-1", termName(firstTerm))
+	require.Equal(t, "2020-2", termName(firstTerm.AddDate(0, 6, 0)))
+	require.Equal(t, "2021-1", termName(firstTerm.AddDate(0, 12, 0)))
+}
+
+func TestGenerate_Seed(t *testing.T) {
+	options := DefaultOptions
+	first, err := Generate(options)
+	require.NoError(t, err)
+	options.Seed++
+	second, err := Generate(options)
+	require.NoError(t, err)
+	require.NotEqual(t, first, second)
+}
+
+func TestInsert(t *testing.T) {
+	conn, err := db.ConnectToSQLite(db.WithDatabase(":memory:"))
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+	require.NoError(t, migration.GooseUP(conn.DB))
+	repo := sqlite.New(conn)
+	ctx := context.Background()
+
+	grades, err := Generate(Options{Students: 50, Courses: 20, Terms: 4, CoursesPerTerm: 3, MaxGrade: 4, Seed: 1})
+	require.NoError(t, err)
+	require.NoError(t, Insert(ctx, conn, grades, false))
+	require.NoError(t, Insert(ctx, conn, grades, false))
+	_, total, err := repo.GetGrades(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2*len(grades), total)
+
+	require.NoError(t, Insert(ctx, conn, grades[:10], true))
+	page, total, err := repo.GetGrades(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, 10, total)
+	require.Equal(t, grades[0].Grade, page[0])
+
+	tenantCtx := auth.WithTenant(ctx, "north-high")
+	require.NoError(t, Insert(tenantCtx, conn, grades[:5], true))
+	_, total, err = repo.GetGrades(tenantCtx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 5, total)
+	_, total, err = repo.GetGrades(ctx, 1, 0)
+	require.NoError(t, err)
+	require.Equal(t, 10, total, "a reset must only delete the grades of the tenant")
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// ConvertGrade converts a grade or a letter from a scale to another, with the configured table of the scales,
+// by linear interpolation or by percentile equivalence among the grades of the tenant, see domain.Conversion.
+func (c *controller) ConvertGrade(ctx context.Context, conversion domain.Conversion) (domain.ConvertedGrade, error) {
+	if err := conversion.Validate(); err != nil {
+		return domain.ConvertedGrade{}, err
+	}
+	from, err := c.fetchScales(ctx, conversion.From)
+	if err != nil {
+		return domain.ConvertedGrade{}, fmt.Errorf("fetching scales failed: %w", err)
+	}
+	to, err := c.fetchScales(ctx, conversion.To)
+	if err != nil {
+		return domain.ConvertedGrade{}, fmt.Errorf("fetching scales failed: %w", err)
+	}
+	var grades []domain.GradeCount
+	if conversion.Method == domain.ConvertByPercentile {
+		if grades, err = c.repo.GetGradeCounts(ctx); err != nil {
+			c.logger.Error("ConvertGrade: failed to get grade counts", "error", err)
+			return domain.ConvertedGrade{}, fmt.Errorf("fetching grade counts failed: %w", err)
+		}
+	}
+	return conversion.Convert(from, to, c.conversionTables, grades)
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestController_ConvertGrade(t *testing.T) {
+	ects := domain.Scales{{Min: 90, GPA: "A"}, {Min: 80, GPA: "B"}, {Min: 70, GPA: "C"}, {Min: 60, GPA: "D"}, {Min: 50, GPA: "E"}, {Min: 10, GPA: "F"}}
+	fourPoint := domain.Scales{{Min: 4, GPA: "A"}, {Min: 3, GPA: "B"}, {Min: 2, GPA: "C"}, {Min: 1, GPA: "D"}, {Min: 0, GPA: "F"}}
+	four := 4
+	boundedFourPoint := append(domain.Scales{{Min: 4, GPA: "A", Max: &four}}, fourPoint[1:]...)
+	tables, err := domain.ParseConversionTables("ECTS->4.0: A=A, B=B, C=C, D=D, E=D, F=F")
+	require.NoError(t, err)
+	grade := 30
+	testCases := map[string]struct {
+		conversion  domain.Conversion
+		setMock     func(m *rdbms.MockRepository)
+		expected    domain.ConvertedGrade
+		expectedErr error
+	}{
+		"by table": {
+			conversion: domain.Conversion{From: "ECTS", To: "4.0", Letter: "E"},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("ECTS")).Return(ects, nil)
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("4.0")).Return(fourPoint, nil)
+			},
+			expected: domain.ConvertedGrade{From: "ECTS", To: "4.0", Method: domain.ConvertByTable, FromGrade: 50, FromLetter: "E", Grade: 1, Letter: "D"},
+		},
+		"by linear interpolation": {
+			conversion: domain.Conversion{From: "4.0", To: "ECTS", Letter: "D"},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("4.0")).Return(fourPoint, nil)
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("ECTS")).Return(ects, nil)
+			},
+			expected: domain.ConvertedGrade{From: "4.0", To: "ECTS", Method: domain.ConvertByLinear, FromGrade: 1, FromLetter: "D", Grade: 30, Letter: "F"},
+		},
+		"by percentile equivalence": {
+			conversion: domain.Conversion{From: "ECTS", To: "4.0", Grade: &grade, Method: domain.ConvertByPercentile},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("ECTS")).Return(ects, nil)
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("4.0")).Return(boundedFourPoint, nil)
+				m.EXPECT().GetGradeCounts(gomock.Any()).Return([]domain.GradeCount{{Grade: 1, Count: 1}, {Grade: 3, Count: 1},
+					{Grade: 20, Count: 1}, {Grade: 30, Count: 1}}, nil)
+			},
+			expected: domain.ConvertedGrade{From: "ECTS", To: "4.0", Method: domain.ConvertByPercentile, FromGrade: 30, FromLetter: "F", Grade: 3, Letter: "B"},
+		},
+		"invalid conversion": {
+			conversion:  domain.Conversion{From: "ECTS", To: "4.0", Grade: &grade, Letter: "F"},
+			expectedErr: domain.ErrInvalidConversion,
+		},
+		"scale not found": {
+			conversion: domain.Conversion{From: "ECTS", To: "4.0", Grade: &grade},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("ECTS")).Return(nil, domain.ErrScaleNotFound)
+			},
+			expectedErr: domain.ErrScaleNotFound,
+		},
+		"storage failure": {
+			conversion: domain.Conversion{From: "ECTS", To: "4.0", Grade: &grade},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("ECTS")).Return(ects, nil)
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("4.0")).Return(nil, errors.New("connection refused"))
+			},
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := rdbms.NewMockRepository(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(m)
+			}
+			c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), m, ConversionTables(tables))
+
+			got, err := c.ConvertGrade(context.Background(), tc.conversion)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -3,9 +3,14 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
-	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/postgres"
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	outboundWebhook "github.com/mnabbasabadi/grading/service/internal/webhook"
 	"github.com/mnabbasabadi/grading/service/shared/domain"
 	"golang.org/x/exp/slog"
 )
@@ -18,18 +23,114 @@ type (
 	// Logic is the interface that provides business usecase operations.
 	Logic interface {
 		GetGrades(ctx context.Context, scaleType domain.ScaleType, limit, offset int) ([]domain.GradeWithGPA, int, error)
+		GetGradesByScales(ctx context.Context, scaleTypes []domain.ScaleType, limit, offset int) ([]domain.GradeWithGPA, int, error)
+		GetStudentGPA(ctx context.Context, studentID uuid.UUID, scaleType domain.ScaleType) (domain.StudentGPA, error)
+		GetStudentsGrades(ctx context.Context, studentIDs []uuid.UUID) (map[uuid.UUID][]domain.Grade, error)
+		GetScales(ctx context.Context, scaleType domain.ScaleType) (domain.Scales, error)
+		GetScalesByTypes(ctx context.Context, scaleTypes []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error)
+		SetScales(ctx context.Context, scaleType domain.ScaleType, scales domain.Scales) (domain.Scales, error)
+		DeleteScales(ctx context.Context, scaleType domain.ScaleType) error
+		ConvertGrade(ctx context.Context, conversion domain.Conversion) (domain.ConvertedGrade, error)
+		GetClassRank(ctx context.Context, studentID, courseID uuid.UUID, ties domain.TieMethod) (domain.ClassRank, error)
+		GetCourseStats(ctx context.Context, courseID uuid.UUID, scaleType domain.ScaleType) (domain.CourseStats, error)
+		GetStanding(ctx context.Context, studentID uuid.UUID, term string) (domain.Standing, error)
+		RunStanding(ctx context.Context, term string) ([]domain.Standing, error)
+		PostGrade(ctx context.Context, grade domain.Grade) (domain.Event, error)
+		CreateWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error)
+		GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, error)
+		ListWebhooks(ctx context.Context) ([]domain.Webhook, error)
+		UpdateWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error)
+		DeleteWebhook(ctx context.Context, id uuid.UUID) error
+		ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]domain.Delivery, int, error)
+		Redeliver(ctx context.Context, webhookID, deliveryID uuid.UUID) (domain.Delivery, error)
 	}
 	controller struct {
-		pg     postgres.Repository
+		repo   rdbms.Repository
 		logger *slog.Logger
+		stats  *statsCache
+		// rankViewers are the roles allowed to see the rank of any student
+		rankViewers []string
+		// admins are the roles allowed to manage the webhooks and to run the standing of a term
+		admins        []string
+		standingRules domain.StandingRules
+		// conversionTables are the tables converting the letters of a scale to another
+		conversionTables domain.ConversionTables
 	}
+
+	options struct {
+		statsTTL         time.Duration
+		statsMinCount    int
+		rankViewers      []string
+		admins           []string
+		standingRules    domain.StandingRules
+		conversionTables domain.ConversionTables
+	}
+
+	// Option is used to provide overrides to the Logic implementation.
+	Option func(*options)
 )
 
+// CourseStatsCache sets how long the stats of the courses with at least minCount grades are cached.
+// The defaults are 1 minute and 1000 grades; a zero ttl disables the cache.
+func CourseStatsCache(ttl time.Duration, minCount int) Option {
+	return func(o *options) {
+		o.statsTTL = ttl
+		o.statsMinCount = minCount
+	}
+}
+
+// RankViewerRoles sets the roles allowed to see the rank of any student, see auth.Caller.
+// The defaults are registrar and committee.
+func RankViewerRoles(roles ...string) Option {
+	return func(o *options) {
+		o.rankViewers = roles
+	}
+}
+
+// AdminRoles sets the roles allowed to manage the webhooks and to run the standing of a term, see auth.Caller.
+// The default is registrar.
+func AdminRoles(roles ...string) Option {
+	return func(o *options) {
+		o.admins = roles
+	}
+}
+
+// StandingRules sets the rules evaluated to find the standing of the students.
+// The defaults are domain.DefaultStandingRules.
+func StandingRules(rules domain.StandingRules) Option {
+	return func(o *options) {
+		o.standingRules = rules
+	}
+}
+
+// ConversionTables sets the tables converting the letters of a scale to another, none by default.
+func ConversionTables(tables domain.ConversionTables) Option {
+	return func(o *options) {
+		o.conversionTables = tables
+	}
+}
+
 // New returns a new Logic.
-func New(logger *slog.Logger, pg postgres.Repository) Logic {
+func New(logger *slog.Logger, repo rdbms.Repository, opts ...Option) Logic {
+	o := options{
+		statsTTL:      time.Minute,
+		statsMinCount: 1000,
+		rankViewers:   []string{"registrar", "committee"},
+		admins:        []string{"registrar"},
+		standingRules: domain.MustParseStandingRules(domain.DefaultStandingRules),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
 	return &controller{
 		logger: logger,
-		pg:     pg,
+		repo:   repo,
+		stats:  newStatsCache(o.statsTTL, o.statsMinCount),
+
+		rankViewers:      o.rankViewers,
+		admins:           o.admins,
+		standingRules:    o.standingRules,
+		conversionTables: o.conversionTables,
 	}
 }
 
@@ -57,8 +158,60 @@ func (c *controller) GetGrades(ctx context.Context, scaleType domain.ScaleType,
 	return gradesWithGPA, total, nil
 }
 
+// GetGradesByScales fetches the grades and associates them with their GPA under every given scale type, the
+// first one giving GPA. The scales are fetched in a single query; an empty scale type, or none, stands for the
+// default one. It returns domain.ErrScaleNotFound when a scale type has no bands.
+func (c *controller) GetGradesByScales(ctx context.Context, scaleTypes []domain.ScaleType, limit int, offset int) ([]domain.GradeWithGPA, int, error) {
+	types := []domain.ScaleType{domain.DefaultScaleType}
+	if len(scaleTypes) > 0 {
+		types = make([]domain.ScaleType, 0, len(scaleTypes))
+		seen := make(map[domain.ScaleType]bool, len(scaleTypes))
+		for _, scaleType := range scaleTypes {
+			if scaleType == "" {
+				scaleType = domain.DefaultScaleType
+			}
+			if !seen[scaleType] {
+				seen[scaleType] = true
+				types = append(types, scaleType)
+			}
+		}
+	}
+
+	grades, total, err := c.fetchGrades(ctx, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetching grades failed: %w", err)
+	}
+	if len(grades) == 0 {
+		return nil, 0, fmt.Errorf("no grades provided")
+	}
+
+	scales, err := c.GetScalesByTypes(ctx, types)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, scaleType := range types {
+		if _, ok := scales[scaleType]; !ok {
+			return nil, 0, fmt.Errorf("%w: scale %s", domain.ErrScaleNotFound, scaleType)
+		}
+	}
+
+	gradesWithGPA := make([]domain.GradeWithGPA, len(grades))
+	for i := range grades {
+		gpas := make(map[domain.ScaleType]string, len(types))
+		for _, scaleType := range types {
+			gpa, err := scales[scaleType].GetGradeGPA(grades[i])
+			if err != nil {
+				return nil, 0, fmt.Errorf("scale %s: %w", scaleType, err)
+			}
+			gpas[scaleType] = gpa
+		}
+		gradesWithGPA[i] = domain.GradeWithGPA{Grade: &grades[i], GPA: gpas[types[0]], GPAs: gpas}
+	}
+	return gradesWithGPA, total, nil
+}
+
 func (c *controller) fetchGrades(ctx context.Context, limit int, offset int) ([]domain.Grade, int, error) {
-	grades, total, err := c.pg.GetGrades(ctx, limit, offset)
+	grades, total, err := c.repo.GetGrades(ctx, limit, offset)
 	if err != nil {
 		c.logger.Error("fetchGrades: failed to get grades", "error", err)
 		return nil, 0, err
@@ -70,7 +223,7 @@ func (c *controller) fetchScales(ctx context.Context, scaleType domain.ScaleType
 	if scaleType == "" {
 		scaleType = domain.DefaultScaleType
 	}
-	scales, err := c.pg.GetScales(ctx, scaleType)
+	scales, err := c.repo.GetScales(ctx, scaleType)
 	if err != nil {
 		c.logger.Error("fetchScales: failed to get scales", "error", err)
 		return nil, err
@@ -83,7 +236,10 @@ func (c *controller) calculateGradesWithGPA(grades []domain.Grade, scales domain
 
 	gradesWithGPA := make([]domain.GradeWithGPA, len(grades))
 	for i, grade := range grades {
-		gpa := scales.GetGPA(grade.Grade)
+		gpa, err := scales.GetGradeGPA(grade)
+		if err != nil {
+			return nil, err
+		}
 		gradesWithGPA[i] = domain.GradeWithGPA{
 			Grade: &grades[i], // Directly use the address of the original slice element
 			GPA:   gpa,
@@ -91,3 +247,306 @@ func (c *controller) calculateGradesWithGPA(grades []domain.Grade, scales domain
 	}
 	return gradesWithGPA, nil
 }
+
+// GetStudentGPA fetches all the grades of a student and computes the student's GPA under the given scaleType,
+// that is the letter the average of their grades falls in.
+func (c *controller) GetStudentGPA(ctx context.Context, studentID uuid.UUID, scaleType domain.ScaleType) (domain.StudentGPA, error) {
+	grades, err := c.repo.GetStudentGrades(ctx, studentID)
+	if err != nil {
+		c.logger.Error("GetStudentGPA: failed to get student grades", "error", err)
+		return domain.StudentGPA{}, fmt.Errorf("fetching student grades failed: %w", err)
+	}
+	if len(grades) == 0 {
+		return domain.StudentGPA{}, domain.ErrStudentNotFound
+	}
+
+	scales, err := c.fetchScales(ctx, scaleType)
+	if err != nil {
+		return domain.StudentGPA{}, fmt.Errorf("fetching scales failed: %w", err)
+	}
+
+	gpa, err := domain.NewStudentGPA(studentID, grades, scales)
+	if err != nil {
+		return domain.StudentGPA{}, fmt.Errorf("calculating student GPA failed: %w", err)
+	}
+	return gpa, nil
+}
+
+// GetStudentsGrades fetches the grades of several students in a single round trip, keyed by student.
+// Students without grades are left out of the result.
+func (c *controller) GetStudentsGrades(ctx context.Context, studentIDs []uuid.UUID) (map[uuid.UUID][]domain.Grade, error) {
+	if len(studentIDs) == 0 {
+		return map[uuid.UUID][]domain.Grade{}, nil
+	}
+	grades, err := c.repo.GetGradesByStudents(ctx, studentIDs)
+	if err != nil {
+		c.logger.Error("GetStudentsGrades: failed to get grades", "error", err)
+		return nil, fmt.Errorf("fetching students grades failed: %w", err)
+	}
+	return grades, nil
+}
+
+// GetScalesByTypes fetches the bands of several scale types in a single round trip, keyed by scale type.
+// An empty scale type stands for the default one. Scale types without any band are left out of the result.
+func (c *controller) GetScalesByTypes(ctx context.Context, scaleTypes []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error) {
+	types := make([]domain.ScaleType, len(scaleTypes))
+	for i, scaleType := range scaleTypes {
+		if scaleType == "" {
+			scaleType = domain.DefaultScaleType
+		}
+		types[i] = scaleType
+	}
+	scales, err := c.repo.GetScalesByTypes(ctx, types)
+	if err != nil {
+		c.logger.Error("GetScalesByTypes: failed to get scales", "error", err)
+		return nil, fmt.Errorf("fetching scales failed: %w", err)
+	}
+	return scales, nil
+}
+
+// GetScales returns the bands of the given scaleType, sorted by Min in descending order.
+func (c *controller) GetScales(ctx context.Context, scaleType domain.ScaleType) (domain.Scales, error) {
+	return c.fetchScales(ctx, scaleType)
+}
+
+// SetScales validates and replaces all the bands of the given scaleType. It returns the stored bands.
+func (c *controller) SetScales(ctx context.Context, scaleType domain.ScaleType, scales domain.Scales) (domain.Scales, error) {
+	if !scaleType.Valid() {
+		return nil, fmt.Errorf("%w: unknown scale type %q", domain.ErrInvalidScales, scaleType)
+	}
+	if err := scales.Validate(); err != nil {
+		return nil, err
+	}
+	sorted := scales.Sorted()
+	event, err := domain.NewScaleUpdated(scaleType, sorted)
+	if err != nil {
+		return nil, err
+	}
+	err = c.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		if err := repo.SetScales(ctx, scaleType, sorted); err != nil {
+			return err
+		}
+		return repo.AppendEvents(ctx, []domain.Event{event})
+	})
+	if err != nil {
+		c.logger.Error("SetScales: failed to set scales", "error", err)
+		return nil, fmt.Errorf("setting scales failed: %w", err)
+	}
+	return sorted, nil
+}
+
+// DeleteScales removes all the bands of the given scaleType. The default scale can not be removed.
+func (c *controller) DeleteScales(ctx context.Context, scaleType domain.ScaleType) error {
+	if scaleType == domain.DefaultScaleType {
+		return fmt.Errorf("%w: the default scale can not be deleted", domain.ErrInvalidScales)
+	}
+	event, err := domain.NewScaleUpdated(scaleType, nil)
+	if err != nil {
+		return err
+	}
+	err = c.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		if err := repo.DeleteScales(ctx, scaleType); err != nil {
+			return err
+		}
+		return repo.AppendEvents(ctx, []domain.Event{event})
+	})
+	if err != nil {
+		c.logger.Error("DeleteScales: failed to delete scales", "error", err)
+		return fmt.Errorf("deleting scales failed: %w", err)
+	}
+	return nil
+}
+
+// PostGrade records the grade of a student in a course, replacing the previous one if any.
+// The change and its event, GradePosted or GradeChanged, are stored together; the event is returned.
+// A GPAChanged event is stored as well when the average of the student changes. The unit of work is serializable,
+// so that concurrent first posts of a grade are retried rather than both inserting it.
+func (c *controller) PostGrade(ctx context.Context, grade domain.Grade) (domain.Event, error) {
+	if err := grade.Validate(); err != nil {
+		return domain.Event{}, err
+	}
+	var event domain.Event
+	err := c.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		grades, err := repo.GetStudentGrades(ctx, grade.StudentID)
+		if err != nil {
+			return err
+		}
+		event, err = domain.NewGradePosted(grade)
+		after := append(make([]domain.Grade, 0, len(grades)+1), grades...)
+		for i, previous := range grades {
+			if previous.CourseID == grade.CourseID {
+				event, err = domain.NewGradeChanged(previous, grade)
+				after[i] = grade
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+		events := []domain.Event{event}
+		if event.Type == domain.GradePosted {
+			after = append(after, grade)
+		}
+		gpaChanged, changed, err := domain.NewGPAChanged(grade.StudentID, grades, after)
+		if err != nil {
+			return err
+		}
+		if changed {
+			events = append(events, gpaChanged)
+		}
+		if event.Type == domain.GradeChanged {
+			err = repo.UpdateGrade(ctx, grade)
+		} else {
+			err = repo.InsertGrade(ctx, grade)
+		}
+		if err != nil {
+			return err
+		}
+		return repo.AppendEvents(ctx, events)
+	}, rdbms.Isolation(sql.LevelSerializable))
+	if err != nil {
+		c.logger.Error("PostGrade: failed to post grade", "error", err)
+		return domain.Event{}, fmt.Errorf("posting grade failed: %w", err)
+	}
+	c.stats.evict(ctx, grade.CourseID)
+	return event, nil
+}
+
+// authorizeAdmin returns domain.ErrForbidden unless the caller has an admin role.
+func (c *controller) authorizeAdmin(ctx context.Context) error {
+	if caller, _ := auth.CallerFrom(ctx); !caller.HasRole(c.admins...) {
+		return fmt.Errorf("%w: only the admins may do this", domain.ErrForbidden)
+	}
+	return nil
+}
+
+// CreateWebhook validates and registers a webhook, active and signed with a generated secret unless one is given.
+// The returned webhook holds the secret, which receivers need to verify the signatures.
+func (c *controller) CreateWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return domain.Webhook{}, err
+	}
+	if err := webhook.Validate(); err != nil {
+		return domain.Webhook{}, err
+	}
+	if webhook.Secret == "" {
+		secret, err := outboundWebhook.NewSecret()
+		if err != nil {
+			return domain.Webhook{}, err
+		}
+		webhook.Secret = secret
+	}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	webhook.ID, webhook.Active, webhook.CreatedAt, webhook.UpdatedAt = uuid.New(), true, now, now
+	if err := c.repo.CreateWebhook(ctx, webhook); err != nil {
+		c.logger.Error("CreateWebhook: failed to create webhook", "error", err)
+		return domain.Webhook{}, fmt.Errorf("creating webhook failed: %w", err)
+	}
+	return webhook, nil
+}
+
+// GetWebhook ...
+func (c *controller) GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return domain.Webhook{}, err
+	}
+	webhook, err := c.repo.GetWebhook(ctx, id)
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("fetching webhook failed: %w", err)
+	}
+	return webhook, nil
+}
+
+// ListWebhooks ...
+func (c *controller) ListWebhooks(ctx context.Context) ([]domain.Webhook, error) {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	webhooks, err := c.repo.ListWebhooks(ctx)
+	if err != nil {
+		c.logger.Error("ListWebhooks: failed to list webhooks", "error", err)
+		return nil, fmt.Errorf("listing webhooks failed: %w", err)
+	}
+	return webhooks, nil
+}
+
+// UpdateWebhook validates and replaces the url, event types and activity of a webhook, and its secret when one
+// is given.
+func (c *controller) UpdateWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return domain.Webhook{}, err
+	}
+	if err := webhook.Validate(); err != nil {
+		return domain.Webhook{}, err
+	}
+	var updated domain.Webhook
+	err := c.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		current, err := repo.GetWebhook(ctx, webhook.ID)
+		if err != nil {
+			return err
+		}
+		updated = current
+		updated.URL, updated.EventTypes, updated.Active = webhook.URL, webhook.EventTypes, webhook.Active
+		if webhook.Secret != "" {
+			updated.Secret = webhook.Secret
+		}
+		updated.UpdatedAt = time.Now().UTC().Truncate(time.Microsecond)
+		return repo.UpdateWebhook(ctx, updated)
+	})
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("updating webhook failed: %w", err)
+	}
+	return updated, nil
+}
+
+// DeleteWebhook deletes a webhook and its deliveries.
+func (c *controller) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return err
+	}
+	if err := c.repo.DeleteWebhook(ctx, id); err != nil {
+		return fmt.Errorf("deleting webhook failed: %w", err)
+	}
+	return nil
+}
+
+// ListDeliveries returns a page of the deliveries of a webhook, the latest first, and their total.
+func (c *controller) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]domain.Delivery, int, error) {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return nil, 0, err
+	}
+	if _, err := c.repo.GetWebhook(ctx, webhookID); err != nil {
+		return nil, 0, fmt.Errorf("fetching webhook failed: %w", err)
+	}
+	deliveries, total, err := c.repo.ListDeliveries(ctx, webhookID, limit, offset)
+	if err != nil {
+		c.logger.Error("ListDeliveries: failed to list deliveries", "error", err)
+		return nil, 0, fmt.Errorf("listing deliveries failed: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// Redeliver schedules a delivery of the webhook, typically a dead one, to be attempted again right away with
+// all its attempts.
+func (c *controller) Redeliver(ctx context.Context, webhookID, deliveryID uuid.UUID) (domain.Delivery, error) {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return domain.Delivery{}, err
+	}
+	var delivery domain.Delivery
+	err := c.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		var err error
+		if delivery, err = repo.GetDelivery(ctx, deliveryID); err != nil {
+			return err
+		}
+		if delivery.WebhookID != webhookID {
+			return domain.ErrDeliveryNotFound
+		}
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.UpdatedAt = domain.DeliveryPending, 0, now, now
+		return repo.UpdateDelivery(ctx, delivery)
+	})
+	if err != nil {
+		return domain.Delivery{}, fmt.Errorf("redelivering failed: %w", err)
+	}
+	return delivery, nil
+}
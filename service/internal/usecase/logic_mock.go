@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	domain "github.com/mnabbasabadi/grading/service/shared/domain"
 )
 
@@ -35,6 +36,94 @@ func (m *MockLogic) EXPECT() *MockLogicMockRecorder {
 	return m.recorder
 }
 
+// ConvertGrade mocks base method.
+func (m *MockLogic) ConvertGrade(ctx context.Context, conversion domain.Conversion) (domain.ConvertedGrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertGrade", ctx, conversion)
+	ret0, _ := ret[0].(domain.ConvertedGrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertGrade indicates an expected call of ConvertGrade.
+func (mr *MockLogicMockRecorder) ConvertGrade(ctx, conversion interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertGrade", reflect.TypeOf((*MockLogic)(nil).ConvertGrade), ctx, conversion)
+}
+
+// CreateWebhook mocks base method.
+func (m *MockLogic) CreateWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, webhook)
+	ret0, _ := ret[0].(domain.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockLogicMockRecorder) CreateWebhook(ctx, webhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockLogic)(nil).CreateWebhook), ctx, webhook)
+}
+
+// DeleteScales mocks base method.
+func (m *MockLogic) DeleteScales(ctx context.Context, scaleType domain.ScaleType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScales", ctx, scaleType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScales indicates an expected call of DeleteScales.
+func (mr *MockLogicMockRecorder) DeleteScales(ctx, scaleType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScales", reflect.TypeOf((*MockLogic)(nil).DeleteScales), ctx, scaleType)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockLogic) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockLogicMockRecorder) DeleteWebhook(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockLogic)(nil).DeleteWebhook), ctx, id)
+}
+
+// GetClassRank mocks base method.
+func (m *MockLogic) GetClassRank(ctx context.Context, studentID, courseID uuid.UUID, ties domain.TieMethod) (domain.ClassRank, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClassRank", ctx, studentID, courseID, ties)
+	ret0, _ := ret[0].(domain.ClassRank)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClassRank indicates an expected call of GetClassRank.
+func (mr *MockLogicMockRecorder) GetClassRank(ctx, studentID, courseID, ties interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClassRank", reflect.TypeOf((*MockLogic)(nil).GetClassRank), ctx, studentID, courseID, ties)
+}
+
+// GetCourseStats mocks base method.
+func (m *MockLogic) GetCourseStats(ctx context.Context, courseID uuid.UUID, scaleType domain.ScaleType) (domain.CourseStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseStats", ctx, courseID, scaleType)
+	ret0, _ := ret[0].(domain.CourseStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseStats indicates an expected call of GetCourseStats.
+func (mr *MockLogicMockRecorder) GetCourseStats(ctx, courseID, scaleType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseStats", reflect.TypeOf((*MockLogic)(nil).GetCourseStats), ctx, courseID, scaleType)
+}
+
 // GetGrades mocks base method.
 func (m *MockLogic) GetGrades(ctx context.Context, scaleType domain.ScaleType, limit, offset int) ([]domain.GradeWithGPA, int, error) {
 	m.ctrl.T.Helper()
@@ -50,3 +139,215 @@ func (mr *MockLogicMockRecorder) GetGrades(ctx, scaleType, limit, offset interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGrades", reflect.TypeOf((*MockLogic)(nil).GetGrades), ctx, scaleType, limit, offset)
 }
+
+// GetGradesByScales mocks base method.
+func (m *MockLogic) GetGradesByScales(ctx context.Context, scaleTypes []domain.ScaleType, limit, offset int) ([]domain.GradeWithGPA, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGradesByScales", ctx, scaleTypes, limit, offset)
+	ret0, _ := ret[0].([]domain.GradeWithGPA)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGradesByScales indicates an expected call of GetGradesByScales.
+func (mr *MockLogicMockRecorder) GetGradesByScales(ctx, scaleTypes, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGradesByScales", reflect.TypeOf((*MockLogic)(nil).GetGradesByScales), ctx, scaleTypes, limit, offset)
+}
+
+// GetScales mocks base method.
+func (m *MockLogic) GetScales(ctx context.Context, scaleType domain.ScaleType) (domain.Scales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScales", ctx, scaleType)
+	ret0, _ := ret[0].(domain.Scales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScales indicates an expected call of GetScales.
+func (mr *MockLogicMockRecorder) GetScales(ctx, scaleType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScales", reflect.TypeOf((*MockLogic)(nil).GetScales), ctx, scaleType)
+}
+
+// GetScalesByTypes mocks base method.
+func (m *MockLogic) GetScalesByTypes(ctx context.Context, scaleTypes []domain.ScaleType) (map[domain.ScaleType]domain.Scales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScalesByTypes", ctx, scaleTypes)
+	ret0, _ := ret[0].(map[domain.ScaleType]domain.Scales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScalesByTypes indicates an expected call of GetScalesByTypes.
+func (mr *MockLogicMockRecorder) GetScalesByTypes(ctx, scaleTypes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScalesByTypes", reflect.TypeOf((*MockLogic)(nil).GetScalesByTypes), ctx, scaleTypes)
+}
+
+// GetStanding mocks base method.
+func (m *MockLogic) GetStanding(ctx context.Context, studentID uuid.UUID, term string) (domain.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStanding", ctx, studentID, term)
+	ret0, _ := ret[0].(domain.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStanding indicates an expected call of GetStanding.
+func (mr *MockLogicMockRecorder) GetStanding(ctx, studentID, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStanding", reflect.TypeOf((*MockLogic)(nil).GetStanding), ctx, studentID, term)
+}
+
+// GetStudentGPA mocks base method.
+func (m *MockLogic) GetStudentGPA(ctx context.Context, studentID uuid.UUID, scaleType domain.ScaleType) (domain.StudentGPA, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudentGPA", ctx, studentID, scaleType)
+	ret0, _ := ret[0].(domain.StudentGPA)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudentGPA indicates an expected call of GetStudentGPA.
+func (mr *MockLogicMockRecorder) GetStudentGPA(ctx, studentID, scaleType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudentGPA", reflect.TypeOf((*MockLogic)(nil).GetStudentGPA), ctx, studentID, scaleType)
+}
+
+// GetStudentsGrades mocks base method.
+func (m *MockLogic) GetStudentsGrades(ctx context.Context, studentIDs []uuid.UUID) (map[uuid.UUID][]domain.Grade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudentsGrades", ctx, studentIDs)
+	ret0, _ := ret[0].(map[uuid.UUID][]domain.Grade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudentsGrades indicates an expected call of GetStudentsGrades.
+func (mr *MockLogicMockRecorder) GetStudentsGrades(ctx, studentIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudentsGrades", reflect.TypeOf((*MockLogic)(nil).GetStudentsGrades), ctx, studentIDs)
+}
+
+// GetWebhook mocks base method.
+func (m *MockLogic) GetWebhook(ctx context.Context, id uuid.UUID) (domain.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, id)
+	ret0, _ := ret[0].(domain.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockLogicMockRecorder) GetWebhook(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockLogic)(nil).GetWebhook), ctx, id)
+}
+
+// ListDeliveries mocks base method.
+func (m *MockLogic) ListDeliveries(ctx context.Context, webhookID uuid.UUID, limit, offset int) ([]domain.Delivery, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries", ctx, webhookID, limit, offset)
+	ret0, _ := ret[0].([]domain.Delivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockLogicMockRecorder) ListDeliveries(ctx, webhookID, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockLogic)(nil).ListDeliveries), ctx, webhookID, limit, offset)
+}
+
+// ListWebhooks mocks base method.
+func (m *MockLogic) ListWebhooks(ctx context.Context) ([]domain.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooks", ctx)
+	ret0, _ := ret[0].([]domain.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooks indicates an expected call of ListWebhooks.
+func (mr *MockLogicMockRecorder) ListWebhooks(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockLogic)(nil).ListWebhooks), ctx)
+}
+
+// PostGrade mocks base method.
+func (m *MockLogic) PostGrade(ctx context.Context, grade domain.Grade) (domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostGrade", ctx, grade)
+	ret0, _ := ret[0].(domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostGrade indicates an expected call of PostGrade.
+func (mr *MockLogicMockRecorder) PostGrade(ctx, grade interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostGrade", reflect.TypeOf((*MockLogic)(nil).PostGrade), ctx, grade)
+}
+
+// Redeliver mocks base method.
+func (m *MockLogic) Redeliver(ctx context.Context, webhookID, deliveryID uuid.UUID) (domain.Delivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, webhookID, deliveryID)
+	ret0, _ := ret[0].(domain.Delivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockLogicMockRecorder) Redeliver(ctx, webhookID, deliveryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockLogic)(nil).Redeliver), ctx, webhookID, deliveryID)
+}
+
+// RunStanding mocks base method.
+func (m *MockLogic) RunStanding(ctx context.Context, term string) ([]domain.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunStanding", ctx, term)
+	ret0, _ := ret[0].([]domain.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunStanding indicates an expected call of RunStanding.
+func (mr *MockLogicMockRecorder) RunStanding(ctx, term interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunStanding", reflect.TypeOf((*MockLogic)(nil).RunStanding), ctx, term)
+}
+
+// SetScales mocks base method.
+func (m *MockLogic) SetScales(ctx context.Context, scaleType domain.ScaleType, scales domain.Scales) (domain.Scales, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScales", ctx, scaleType, scales)
+	ret0, _ := ret[0].(domain.Scales)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetScales indicates an expected call of SetScales.
+func (mr *MockLogicMockRecorder) SetScales(ctx, scaleType, scales interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScales", reflect.TypeOf((*MockLogic)(nil).SetScales), ctx, scaleType, scales)
+}
+
+// UpdateWebhook mocks base method.
+func (m *MockLogic) UpdateWebhook(ctx context.Context, webhook domain.Webhook) (domain.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", ctx, webhook)
+	ret0, _ := ret[0].(domain.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook.
+func (mr *MockLogicMockRecorder) UpdateWebhook(ctx, webhook interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockLogic)(nil).UpdateWebhook), ctx, webhook)
+}
@@ -2,13 +2,18 @@ package usecase
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
-	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/postgres"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/memory"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
 	"github.com/mnabbasabadi/grading/service/shared/domain"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slog"
@@ -18,13 +23,13 @@ func TestController_GetGrades(t *testing.T) {
 
 	testCases := map[string]struct {
 		gpa         domain.ScaleType
-		setMock     func(m *postgres.MockRepository)
+		setMock     func(m *rdbms.MockRepository)
 		expectedGPA []string
 		wantErr     bool
 	}{
 		"success": {
 			gpa: domain.ScaleType("4.0"),
-			setMock: func(m *postgres.MockRepository) {
+			setMock: func(m *rdbms.MockRepository) {
 				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Grade{
 					{
 						StudentID: uuid.New(),
@@ -61,7 +66,7 @@ func TestController_GetGrades(t *testing.T) {
 		},
 		"success with default gpa": {
 			gpa: domain.ScaleType(""),
-			setMock: func(m *postgres.MockRepository) {
+			setMock: func(m *rdbms.MockRepository) {
 				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Grade{
 					{
 						StudentID: uuid.New(),
@@ -98,14 +103,14 @@ func TestController_GetGrades(t *testing.T) {
 		},
 		"fail to get grades": {
 			gpa: domain.ScaleType(""),
-			setMock: func(m *postgres.MockRepository) {
+			setMock: func(m *rdbms.MockRepository) {
 				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, 0, errors.New("error"))
 			},
 			wantErr: true,
 		},
 		"fail to get scales": {
 			gpa: domain.ScaleType(""),
-			setMock: func(m *postgres.MockRepository) {
+			setMock: func(m *rdbms.MockRepository) {
 				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Grade{
 					{
 						StudentID: uuid.New(),
@@ -127,6 +132,25 @@ func TestController_GetGrades(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		"grade out of scale": {
+			gpa: domain.ScaleType("4.0"),
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetGrades(gomock.Any(), gomock.Any(), gomock.Any()).Return([]domain.Grade{
+					{
+						StudentID: uuid.New(),
+						CourseID:  uuid.New(),
+						Grade:     15,
+					},
+				}, 1, nil)
+				m.EXPECT().GetScales(gomock.Any(), gomock.Any()).Return(domain.Scales{
+					{
+						Min: 20,
+						GPA: "F",
+					},
+				}, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -136,12 +160,12 @@ func TestController_GetGrades(t *testing.T) {
 			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
-			m := postgres.NewMockRepository(ctrl)
+			m := rdbms.NewMockRepository(ctrl)
 			if tc.setMock != nil {
 				tc.setMock(m)
 			}
 			c := controller{
-				pg:     m,
+				repo:   m,
 				logger: logger,
 			}
 			grades, total, err := c.GetGrades(context.TODO(), tc.gpa, 10, 0)
@@ -156,3 +180,559 @@ func TestController_GetGrades(t *testing.T) {
 		})
 	}
 }
+
+func TestController_GetGradesByScales(t *testing.T) {
+	grades := []domain.Grade{
+		{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 2},
+		{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 4},
+	}
+	scales := map[domain.ScaleType]domain.Scales{
+		domain.DefaultScaleType: {{Min: 4, GPA: "A"}, {Min: 3, GPA: "B"}, {Min: 0, GPA: "F"}},
+		"ECTS":                  {{Min: 3, GPA: "A"}, {Min: 2, GPA: "C"}, {Min: 0, GPA: "F"}},
+	}
+	testCases := map[string]struct {
+		scaleTypes   []domain.ScaleType
+		fetchedTypes []domain.ScaleType
+		expected     []map[domain.ScaleType]string
+		expectedGPA  []string
+		expectedErr  error
+	}{
+		"several scales": {
+			scaleTypes:   []domain.ScaleType{"ECTS", "", "ECTS"},
+			fetchedTypes: []domain.ScaleType{"ECTS", domain.DefaultScaleType},
+			expected:     []map[domain.ScaleType]string{{"ECTS": "C", "default": "F"}, {"ECTS": "A", "default": "A"}},
+			expectedGPA:  []string{"C", "A"},
+		},
+		"default scale": {
+			fetchedTypes: []domain.ScaleType{domain.DefaultScaleType},
+			expected:     []map[domain.ScaleType]string{{"default": "F"}, {"default": "A"}},
+			expectedGPA:  []string{"F", "A"},
+		},
+		"scale not found": {
+			scaleTypes:   []domain.ScaleType{"ECTS", "4.0"},
+			fetchedTypes: []domain.ScaleType{"ECTS", "4.0"},
+			expectedErr:  domain.ErrScaleNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := rdbms.NewMockRepository(ctrl)
+			m.EXPECT().GetGrades(gomock.Any(), 10, 0).Return(grades, 2, nil)
+			fetched := make(map[domain.ScaleType]domain.Scales)
+			for _, scaleType := range tc.fetchedTypes {
+				if bands, ok := scales[scaleType]; ok {
+					fetched[scaleType] = bands
+				}
+			}
+			m.EXPECT().GetScalesByTypes(gomock.Any(), tc.fetchedTypes).Return(fetched, nil)
+			c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), m)
+
+			got, total, err := c.GetGradesByScales(context.Background(), tc.scaleTypes, 10, 0)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 2, total)
+			for i, grade := range got {
+				require.Equal(t, tc.expected[i], grade.GPAs)
+				require.Equal(t, tc.expectedGPA[i], grade.GPA)
+			}
+		})
+	}
+}
+
+func TestController_GetStudentGPA(t *testing.T) {
+	studentID := uuid.New()
+	scales := domain.Scales{
+		{Min: 4, GPA: "A"},
+		{Min: 3, GPA: "B"},
+		{Min: 2, GPA: "C"},
+		{Min: 0, GPA: "F"},
+	}
+
+	testCases := map[string]struct {
+		setMock         func(m *rdbms.MockRepository)
+		expectedAverage float64
+		expectedGPA     string
+		expectedErr     error
+	}{
+		"success": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Grade: 2},
+					{StudentID: studentID, CourseID: uuid.New(), Grade: 3},
+				}, nil)
+				m.EXPECT().GetScales(gomock.Any(), domain.DefaultScaleType).Return(scales, nil)
+			},
+			expectedAverage: 2.5,
+			expectedGPA:     "C",
+		},
+		"grades not counted": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Grade: 2},
+					{StudentID: studentID, CourseID: uuid.New(), Type: domain.PassGrade},
+				}, nil)
+				m.EXPECT().GetScales(gomock.Any(), domain.DefaultScaleType).Return(scales, nil)
+			},
+			expectedAverage: 2,
+			expectedGPA:     "C",
+		},
+		"no grade counted": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Type: domain.WithdrawnGrade},
+					{StudentID: studentID, CourseID: uuid.New(), Type: domain.AuditGrade},
+				}, nil)
+				m.EXPECT().GetScales(gomock.Any(), domain.DefaultScaleType).Return(scales, nil)
+			},
+		},
+		"student without grades": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return(nil, nil)
+			},
+			expectedErr: domain.ErrStudentNotFound,
+		},
+		"fail to get scales": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Grade: 2},
+				}, nil)
+				m.EXPECT().GetScales(gomock.Any(), gomock.Any()).Return(nil, domain.ErrScaleNotFound)
+			},
+			expectedErr: domain.ErrScaleNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := rdbms.NewMockRepository(ctrl)
+			tc.setMock(m)
+			c := controller{
+				repo:   m,
+				logger: logger,
+			}
+			gpa, err := c.GetStudentGPA(context.TODO(), studentID, "")
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, studentID, gpa.StudentID)
+			require.Equal(t, tc.expectedAverage, gpa.Average)
+			require.Equal(t, tc.expectedGPA, gpa.GPA)
+			require.Len(t, gpa.Grades, 2)
+		})
+	}
+}
+
+// expectTx expects a unit of work, run against the mock itself.
+func expectTx(m *rdbms.MockRepository) {
+	m.EXPECT().WithTx(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fn func(rdbms.Repository) error, _ ...rdbms.TxOption) error {
+		return fn(m)
+	})
+}
+
+// expectSerializableTx expects a serializable unit of work, run against the mock itself.
+func expectSerializableTx(m *rdbms.MockRepository) {
+	m.EXPECT().WithTx(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fn func(rdbms.Repository) error, opts ...rdbms.TxOption) error {
+		if isolation := rdbms.NewTxOptions(opts...).Isolation; isolation != sql.LevelSerializable {
+			return fmt.Errorf("unexpected isolation %s", isolation)
+		}
+		return fn(m)
+	})
+}
+
+func TestController_SetScales(t *testing.T) {
+	testCases := map[string]struct {
+		scaleType      domain.ScaleType
+		scales         domain.Scales
+		setMock        func(m *rdbms.MockRepository)
+		expectedScales domain.Scales
+		expectedErr    error
+	}{
+		"success sorts bands": {
+			scaleType: "4.0",
+			scales: domain.Scales{
+				{Min: 80, GPA: "B"},
+				{Min: 90, GPA: "A"},
+			},
+			setMock: func(m *rdbms.MockRepository) {
+				expectTx(m)
+				m.EXPECT().SetScales(gomock.Any(), domain.ScaleType("4.0"), domain.Scales{
+					{Min: 90, GPA: "A"},
+					{Min: 80, GPA: "B"},
+				}).Return(nil)
+				m.EXPECT().AppendEvents(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []domain.Event) error {
+					require.Len(t, events, 1)
+					require.Equal(t, domain.ScaleUpdated, events[0].Type)
+					return nil
+				})
+			},
+			expectedScales: domain.Scales{
+				{Min: 90, GPA: "A"},
+				{Min: 80, GPA: "B"},
+			},
+		},
+		"unknown scale type": {
+			scaleType:   "3.0",
+			scales:      domain.Scales{{Min: 90, GPA: "A"}},
+			expectedErr: domain.ErrInvalidScales,
+		},
+		"invalid bands": {
+			scaleType:   "4.0",
+			scales:      domain.Scales{},
+			expectedErr: domain.ErrInvalidScales,
+		},
+		"storage failure": {
+			scaleType: "4.0",
+			scales:    domain.Scales{{Min: 90, GPA: "A"}},
+			setMock: func(m *rdbms.MockRepository) {
+				expectTx(m)
+				m.EXPECT().SetScales(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("error"))
+			},
+			expectedErr: errors.New("error"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := rdbms.NewMockRepository(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(m)
+			}
+			c := controller{
+				repo:   m,
+				logger: logger,
+			}
+			scales, err := c.SetScales(context.TODO(), tc.scaleType, tc.scales)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				if errors.Is(tc.expectedErr, domain.ErrInvalidScales) {
+					require.ErrorIs(t, err, domain.ErrInvalidScales)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedScales, scales)
+		})
+	}
+}
+
+func TestController_DeleteScales(t *testing.T) {
+	testCases := map[string]struct {
+		scaleType   domain.ScaleType
+		setMock     func(m *rdbms.MockRepository)
+		expectedErr error
+	}{
+		"success": {
+			scaleType: "ECTS",
+			setMock: func(m *rdbms.MockRepository) {
+				expectTx(m)
+				m.EXPECT().DeleteScales(gomock.Any(), domain.ScaleType("ECTS")).Return(nil)
+				m.EXPECT().AppendEvents(gomock.Any(), gomock.Len(1)).Return(nil)
+			},
+		},
+		"default scale": {
+			scaleType:   domain.DefaultScaleType,
+			expectedErr: domain.ErrInvalidScales,
+		},
+		"not found": {
+			scaleType: "ECTS",
+			setMock: func(m *rdbms.MockRepository) {
+				expectTx(m)
+				m.EXPECT().DeleteScales(gomock.Any(), gomock.Any()).Return(domain.ErrScaleNotFound)
+			},
+			expectedErr: domain.ErrScaleNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := rdbms.NewMockRepository(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(m)
+			}
+			c := controller{
+				repo:   m,
+				logger: logger,
+			}
+			err := c.DeleteScales(context.TODO(), tc.scaleType)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestController_GetScalesByTypes(t *testing.T) {
+	testCases := map[string]struct {
+		scaleTypes  []domain.ScaleType
+		setMock     func(m *rdbms.MockRepository)
+		expected    map[domain.ScaleType]domain.Scales
+		expectedErr bool
+	}{
+		"empty scale type is the default one": {
+			scaleTypes: []domain.ScaleType{"", "4.0"},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScalesByTypes(gomock.Any(), []domain.ScaleType{domain.DefaultScaleType, "4.0"}).Return(map[domain.ScaleType]domain.Scales{
+					domain.DefaultScaleType: {{Min: 0, GPA: "F"}},
+				}, nil)
+			},
+			expected: map[domain.ScaleType]domain.Scales{
+				domain.DefaultScaleType: {{Min: 0, GPA: "F"}},
+			},
+		},
+		"fail to get scales": {
+			scaleTypes: []domain.ScaleType{"4.0"},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScalesByTypes(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection refused"))
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := rdbms.NewMockRepository(ctrl)
+			tc.setMock(m)
+			c := controller{
+				repo:   m,
+				logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			}
+			scales, err := c.GetScalesByTypes(context.TODO(), tc.scaleTypes)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, scales)
+		})
+	}
+}
+
+func TestController_PostGrade(t *testing.T) {
+	studentID, courseID := uuid.New(), uuid.New()
+	grade := domain.Grade{StudentID: studentID, CourseID: courseID, Grade: 3}
+	testCases := map[string]struct {
+		grade        domain.Grade
+		setMock      func(m *rdbms.MockRepository)
+		expectedType domain.EventType
+		expectedErr  error
+	}{
+		"first grade in the course": {
+			grade: grade,
+			setMock: func(m *rdbms.MockRepository) {
+				expectSerializableTx(m)
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Grade: 1},
+				}, nil)
+				m.EXPECT().InsertGrade(gomock.Any(), grade).Return(nil)
+				m.EXPECT().AppendEvents(gomock.Any(), gomock.Len(2)).Return(nil)
+			},
+			expectedType: domain.GradePosted,
+		},
+		"average unchanged": {
+			grade: grade,
+			setMock: func(m *rdbms.MockRepository) {
+				expectSerializableTx(m)
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Grade: 3},
+				}, nil)
+				m.EXPECT().InsertGrade(gomock.Any(), grade).Return(nil)
+				m.EXPECT().AppendEvents(gomock.Any(), gomock.Len(1)).Return(nil)
+			},
+			expectedType: domain.GradePosted,
+		},
+		"grade changed": {
+			grade: grade,
+			setMock: func(m *rdbms.MockRepository) {
+				expectSerializableTx(m)
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: courseID, Grade: 1},
+				}, nil)
+				m.EXPECT().UpdateGrade(gomock.Any(), grade).Return(nil)
+				m.EXPECT().AppendEvents(gomock.Any(), gomock.Len(2)).Return(nil)
+			},
+			expectedType: domain.GradeChanged,
+		},
+		"withdrawn leaves the average unchanged": {
+			grade: domain.Grade{StudentID: studentID, CourseID: courseID, Type: domain.WithdrawnGrade},
+			setMock: func(m *rdbms.MockRepository) {
+				expectSerializableTx(m)
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Grade: 3},
+				}, nil)
+				m.EXPECT().InsertGrade(gomock.Any(), domain.Grade{StudentID: studentID, CourseID: courseID, Type: domain.WithdrawnGrade}).Return(nil)
+				m.EXPECT().AppendEvents(gomock.Any(), gomock.Len(1)).Return(nil)
+			},
+			expectedType: domain.GradePosted,
+		},
+		"invalid grade": {
+			grade:       domain.Grade{StudentID: studentID, CourseID: courseID, Grade: -1},
+			expectedErr: domain.ErrInvalidGrade,
+		},
+		"outbox failure": {
+			grade: grade,
+			setMock: func(m *rdbms.MockRepository) {
+				expectSerializableTx(m)
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return(nil, nil)
+				m.EXPECT().InsertGrade(gomock.Any(), grade).Return(nil)
+				m.EXPECT().AppendEvents(gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+			},
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := rdbms.NewMockRepository(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(m)
+			}
+			c := controller{
+				repo:   m,
+				logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			}
+			event, err := c.PostGrade(context.TODO(), tc.grade)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				if errors.Is(tc.expectedErr, domain.ErrInvalidGrade) {
+					require.ErrorIs(t, err, domain.ErrInvalidGrade)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedType, event.Type)
+		})
+	}
+}
+
+func TestController_MemoryStore(t *testing.T) {
+	ctx := context.Background()
+	studentID := uuid.New()
+	store := memory.New(memory.WithFixtures(memory.Fixtures{
+		Grades: []domain.Grade{
+			{StudentID: studentID, CourseID: uuid.New(), Grade: 4},
+			{StudentID: studentID, CourseID: uuid.New(), Grade: 1},
+			{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 0},
+		},
+	}))
+	c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), store)
+
+	_, err := c.SetScales(ctx, "4.0", domain.Scales{{Min: 0, GPA: "0.0"}, {Min: 2, GPA: "2.0"}})
+	require.NoError(t, err)
+
+	gpa, err := c.GetStudentGPA(ctx, studentID, "4.0")
+	require.NoError(t, err)
+	require.Equal(t, 2.5, gpa.Average)
+	require.Equal(t, "2.0", gpa.GPA)
+
+	grades, total, err := c.GetGrades(ctx, "", 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, 3, total)
+	require.Len(t, grades, 2)
+	require.Equal(t, "D", grades[0].GPA)
+
+	require.NoError(t, c.DeleteScales(ctx, "4.0"))
+	_, err = c.GetStudentGPA(ctx, studentID, "4.0")
+	require.ErrorIs(t, err, domain.ErrScaleNotFound)
+
+	_, err = c.PostGrade(ctx, domain.Grade{StudentID: studentID, CourseID: uuid.New(), Grade: 4})
+	require.NoError(t, err)
+	events, err := store.ClaimEvents(ctx, time.Now(), time.Minute, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	require.Equal(t, []domain.EventType{domain.ScaleUpdated, domain.ScaleUpdated, domain.GradePosted, domain.GPAChanged},
+		[]domain.EventType{events[0].Type, events[1].Type, events[2].Type, events[3].Type})
+}
+
+func TestController_Webhooks(t *testing.T) {
+	ctx := auth.WithCaller(context.Background(), auth.Caller{Role: "registrar"})
+	store := memory.New()
+	c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), store)
+
+	_, err := c.CreateWebhook(context.Background(), domain.Webhook{URL: "https://partner.example/hooks"})
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = c.ListWebhooks(auth.WithCaller(context.Background(), auth.Caller{Role: "student"}))
+	require.ErrorIs(t, err, domain.ErrForbidden)
+
+	_, err = c.CreateWebhook(ctx, domain.Webhook{URL: "ftp://partner.example"})
+	require.ErrorIs(t, err, domain.ErrInvalidWebhook)
+
+	created, err := c.CreateWebhook(ctx, domain.Webhook{URL: "https://partner.example/hooks"})
+	require.NoError(t, err)
+	require.NotEqual(t, uuid.Nil, created.ID)
+	require.NotEmpty(t, created.Secret)
+	require.True(t, created.Active)
+
+	updated, err := c.UpdateWebhook(ctx, domain.Webhook{
+		ID: created.ID, URL: "https://partner.example/v2", EventTypes: domain.EventTypeSet{domain.GPAChanged},
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.Secret, updated.Secret)
+	require.Equal(t, created.CreatedAt, updated.CreatedAt)
+	require.False(t, updated.Active)
+	got, err := c.GetWebhook(ctx, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, updated, got)
+
+	_, err = c.UpdateWebhook(ctx, domain.Webhook{ID: uuid.New(), URL: "https://partner.example"})
+	require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+
+	event, err := domain.NewEvent(domain.GPAChanged, domain.GPAChangedPayload{StudentID: uuid.New(), Average: 3})
+	require.NoError(t, err)
+	delivery := domain.Delivery{
+		ID: uuid.New(), WebhookID: created.ID, EventID: event.ID, EventType: event.Type, Body: []byte(`{}`),
+		Status: domain.DeliveryDead, Attempts: 8, NextAttemptAt: event.OccurredAt, CreatedAt: event.OccurredAt,
+		UpdatedAt: event.OccurredAt,
+	}
+	require.NoError(t, store.EnqueueDeliveries(ctx, []domain.Delivery{delivery}))
+
+	deliveries, total, err := c.ListDeliveries(ctx, created.ID, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, total)
+	require.Equal(t, domain.DeliveryDead, deliveries[0].Status)
+	_, _, err = c.ListDeliveries(ctx, uuid.New(), 10, 0)
+	require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+
+	_, err = c.Redeliver(ctx, uuid.New(), delivery.ID)
+	require.ErrorIs(t, err, domain.ErrDeliveryNotFound)
+	redelivered, err := c.Redeliver(ctx, created.ID, delivery.ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.DeliveryPending, redelivered.Status)
+	require.Zero(t, redelivered.Attempts)
+
+	webhooks, err := c.ListWebhooks(ctx)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	require.NoError(t, c.DeleteWebhook(ctx, created.ID))
+	require.ErrorIs(t, c.DeleteWebhook(ctx, created.ID), domain.ErrWebhookNotFound)
+	_, err = c.GetWebhook(ctx, created.ID)
+	require.ErrorIs(t, err, domain.ErrWebhookNotFound)
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// GetClassRank ranks a student by average among every graded student, or among the students graded in a course
+// unless courseID is uuid.Nil, with competition ranking unless told otherwise.
+// Students may see their own rank; only the callers with a rank viewer role may see the rank of others.
+func (c *controller) GetClassRank(ctx context.Context, studentID, courseID uuid.UUID, ties domain.TieMethod) (domain.ClassRank, error) {
+	if ties == "" {
+		ties = domain.TiesCompetition
+	}
+	if err := ties.Validate(); err != nil {
+		return domain.ClassRank{}, err
+	}
+	if caller, _ := auth.CallerFrom(ctx); !caller.Is(studentID) && !caller.HasRole(c.rankViewers...) {
+		return domain.ClassRank{}, fmt.Errorf("%w: only the student and the rank viewers may see their rank", domain.ErrForbidden)
+	}
+
+	position, err := c.repo.GetRankPosition(ctx, studentID, courseID)
+	if err != nil {
+		return domain.ClassRank{}, fmt.Errorf("fetching rank position failed: %w", err)
+	}
+	return domain.NewClassRank(position, courseID, ties), nil
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestController_GetClassRank(t *testing.T) {
+	studentID, courseID := uuid.New(), uuid.New()
+	position := domain.RankPosition{StudentID: studentID, Average: 3, CompetitionRank: 3, DenseRank: 2, CohortSize: 4, CumeDist: 0.5}
+	testCases := map[string]struct {
+		caller      *auth.Caller
+		courseID    uuid.UUID
+		ties        domain.TieMethod
+		setMock     func(m *rdbms.MockRepository)
+		expected    domain.ClassRank
+		expectedErr error
+	}{
+		"own rank": {
+			caller: &auth.Caller{Role: "student", StudentID: studentID},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetRankPosition(gomock.Any(), studentID, uuid.Nil).Return(position, nil)
+			},
+			expected: domain.ClassRank{StudentID: studentID, Ties: domain.TiesCompetition, Average: 3, Rank: 3, CohortSize: 4, Percentile: 50},
+		},
+		"rank viewer in a course with dense ranking": {
+			caller:   &auth.Caller{Role: "committee"},
+			courseID: courseID,
+			ties:     domain.TiesDense,
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetRankPosition(gomock.Any(), studentID, courseID).Return(position, nil)
+			},
+			expected: domain.ClassRank{StudentID: studentID, CourseID: courseID, Ties: domain.TiesDense, Average: 3, Rank: 2, CohortSize: 4, Percentile: 50},
+		},
+		"another student": {
+			caller:      &auth.Caller{Role: "student", StudentID: uuid.New()},
+			expectedErr: domain.ErrForbidden,
+		},
+		"anonymous": {
+			expectedErr: domain.ErrForbidden,
+		},
+		"unknown tie method": {
+			caller:      &auth.Caller{Role: "registrar"},
+			ties:        "fractional",
+			expectedErr: domain.ErrInvalidRank,
+		},
+		"not ranked": {
+			caller: &auth.Caller{Role: "registrar"},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetRankPosition(gomock.Any(), studentID, uuid.Nil).Return(domain.RankPosition{}, domain.ErrStudentNotFound)
+			},
+			expectedErr: domain.ErrStudentNotFound,
+		},
+		"storage failure": {
+			caller: &auth.Caller{Role: "registrar"},
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetRankPosition(gomock.Any(), studentID, uuid.Nil).Return(domain.RankPosition{}, errors.New("connection refused"))
+			},
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := rdbms.NewMockRepository(ctrl)
+			if tc.setMock != nil {
+				tc.setMock(m)
+			}
+			c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), m)
+			ctx := context.Background()
+			if tc.caller != nil {
+				ctx = auth.WithCaller(ctx, *tc.caller)
+			}
+			got, err := c.GetClassRank(ctx, studentID, tc.courseID, tc.ties)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestController_GetClassRank_ViewerRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := rdbms.NewMockRepository(ctrl)
+	m.EXPECT().GetRankPosition(gomock.Any(), gomock.Any(), gomock.Any()).Return(domain.RankPosition{CompetitionRank: 1}, nil)
+	c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), m, RankViewerRoles("dean"))
+
+	_, err := c.GetClassRank(auth.WithCaller(context.Background(), auth.Caller{Role: "registrar"}), uuid.New(), uuid.Nil, "")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+	_, err = c.GetClassRank(auth.WithCaller(context.Background(), auth.Caller{Role: "dean"}), uuid.New(), uuid.Nil, "")
+	require.NoError(t, err)
+}
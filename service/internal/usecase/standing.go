@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+// standingBatchSize is how many students the standing run of a term fetches the grades of at once.
+const standingBatchSize = 500
+
+// GetStanding evaluates the standing rules against the grades of a student in a term, their latest one when
+// term is empty. It returns domain.ErrStudentNotFound when the student has no grades in the term.
+func (c *controller) GetStanding(ctx context.Context, studentID uuid.UUID, term string) (domain.Standing, error) {
+	grades, err := c.repo.GetStudentGrades(ctx, studentID)
+	if err != nil {
+		return domain.Standing{}, fmt.Errorf("fetching student grades failed: %w", err)
+	}
+	if len(grades) == 0 {
+		return domain.Standing{}, domain.ErrStudentNotFound
+	}
+	if term == "" {
+		term = domain.LatestTerm(grades)
+	}
+	for _, grade := range grades {
+		if grade.Term == term {
+			return domain.NewStanding(studentID, term, grades, c.standingRules), nil
+		}
+	}
+	return domain.Standing{}, fmt.Errorf("%w: no grades in term %q", domain.ErrStudentNotFound, term)
+}
+
+// RunStanding evaluates the standing rules for every student graded in a term and returns their standings,
+// sorted by student. A StandingFlagged event is stored for every student meeting a rule, unless one was stored for
+// them in an earlier run of the term. Only the admins may run it.
+func (c *controller) RunStanding(ctx context.Context, term string) ([]domain.Standing, error) {
+	if err := c.authorizeAdmin(ctx); err != nil {
+		return nil, err
+	}
+	var standings []domain.Standing
+	err := c.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		standings = nil
+		studentIDs, err := repo.GetTermStudents(ctx, term)
+		if err != nil {
+			return err
+		}
+		var events []domain.Event
+		for start := 0; start < len(studentIDs); start += standingBatchSize {
+			batch := studentIDs[start:min(start+standingBatchSize, len(studentIDs))]
+			grades, err := repo.GetGradesByStudents(ctx, batch)
+			if err != nil {
+				return err
+			}
+			for _, studentID := range batch {
+				standing := domain.NewStanding(studentID, term, grades[studentID], c.standingRules)
+				standings = append(standings, standing)
+				if len(standing.Standings) == 0 {
+					continue
+				}
+				event, err := domain.NewStandingFlagged(standing)
+				if err != nil {
+					return err
+				}
+				events = append(events, event)
+			}
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		return repo.AppendEvents(ctx, events)
+	})
+	if err != nil {
+		c.logger.Error("RunStanding: failed to run standing", "term", term, "error", err)
+		return nil, fmt.Errorf("running standing failed: %w", err)
+	}
+	return standings, nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestController_GetStanding(t *testing.T) {
+	studentID := uuid.New()
+	grades := []domain.Grade{
+		{StudentID: studentID, CourseID: uuid.New(), Grade: 4, Term: "2024-2", Credits: 12},
+		{StudentID: studentID, CourseID: uuid.New(), Grade: 1, Term: "2024-1", Credits: 12},
+	}
+	testCases := map[string]struct {
+		term        string
+		setMock     func(m *rdbms.MockRepository)
+		expected    domain.StandingMetrics
+		standings   []string
+		expectedErr error
+	}{
+		"latest term": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return(grades, nil)
+			},
+			expected:  domain.StandingMetrics{Term: "2024-2", TermGPA: ptr(4), TermCredits: 12, CumulativeGPA: ptr(2.5), CumulativeCredits: 24},
+			standings: []string{"Dean's List"},
+		},
+		"given term": {
+			term: "2024-1",
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return(grades, nil)
+			},
+			expected:  domain.StandingMetrics{Term: "2024-1", TermGPA: ptr(1), TermCredits: 12, CumulativeGPA: ptr(1), CumulativeCredits: 12},
+			standings: []string{"Probation"},
+		},
+		"only grades not counted": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return([]domain.Grade{
+					{StudentID: studentID, CourseID: uuid.New(), Term: "2024-2", Credits: 6, Type: domain.PassGrade},
+					{StudentID: studentID, CourseID: uuid.New(), Term: "2024-2", Credits: 3, Type: domain.WithdrawnGrade},
+					{StudentID: studentID, CourseID: uuid.New(), Term: "2024-1", Credits: 3, Type: domain.AuditGrade},
+				}, nil)
+			},
+			expected: domain.StandingMetrics{Term: "2024-2"},
+		},
+		"no grades in the term": {
+			term: "2023-2",
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return(grades, nil)
+			},
+			expectedErr: domain.ErrStudentNotFound,
+		},
+		"no grades": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return(nil, nil)
+			},
+			expectedErr: domain.ErrStudentNotFound,
+		},
+		"storage failure": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetStudentGrades(gomock.Any(), studentID).Return(nil, errors.New("connection refused"))
+			},
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := rdbms.NewMockRepository(ctrl)
+			tc.setMock(m)
+			c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), m)
+
+			got, err := c.GetStanding(context.Background(), studentID, tc.term)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, studentID, got.StudentID)
+			require.Equal(t, tc.expected, got.StandingMetrics)
+			require.Equal(t, tc.standings, got.Standings)
+		})
+	}
+}
+
+func TestController_RunStanding(t *testing.T) {
+	flagged, unflagged := uuid.New(), uuid.New()
+	ctrl := gomock.NewController(t)
+	m := rdbms.NewMockRepository(ctrl)
+	expectTx(m)
+	m.EXPECT().GetTermStudents(gomock.Any(), "2024-2").Return([]uuid.UUID{flagged, unflagged}, nil)
+	m.EXPECT().GetGradesByStudents(gomock.Any(), []uuid.UUID{flagged, unflagged}).Return(map[uuid.UUID][]domain.Grade{
+		flagged:   {{StudentID: flagged, Grade: 3, Term: "2024-2", Credits: 6}},
+		unflagged: {{StudentID: unflagged, Grade: 2, Term: "2024-2", Credits: 6}},
+	}, nil)
+	m.EXPECT().AppendEvents(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, events []domain.Event) error {
+		require.Len(t, events, 1)
+		require.Equal(t, domain.StandingFlagged, events[0].Type)
+		require.JSONEq(t, `{"student_id":"`+flagged.String()+`","term":"2024-2","standings":["Honors"],
+			"term_gpa":3,"term_credits":6,"cumulative_gpa":3,"cumulative_credits":6}`, string(events[0].Payload))
+		return nil
+	})
+	rules := domain.MustParseStandingRules("Honors: term_gpa >= 3")
+	c := New(slog.New(slog.NewJSONHandler(os.Stdout, nil)), m, StandingRules(rules), AdminRoles("registrar", "dean"))
+
+	_, err := c.RunStanding(auth.WithCaller(context.Background(), auth.Caller{Role: "committee"}), "2024-2")
+	require.ErrorIs(t, err, domain.ErrForbidden)
+
+	standings, err := c.RunStanding(auth.WithCaller(context.Background(), auth.Caller{Role: "dean"}), "2024-2")
+	require.NoError(t, err)
+	require.Len(t, standings, 2)
+	require.Equal(t, []string{"Honors"}, standings[0].Standings)
+	require.Empty(t, standings[1].Standings)
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+type (
+	// statsCache keeps the stats of the large courses of every tenant for a while, since computing them reads
+	// all their grades.
+	// Posting a grade evicts the stats of its course from the cache of the instance; other instances may serve
+	// stale stats until they expire.
+	// The expired entries are swept when caching new stats, at most once per ttl, so that the stats of the courses
+	// never queried again do not pile up.
+	statsCache struct {
+		ttl      time.Duration
+		minCount int
+		mu       sync.Mutex
+		entries  map[statsKey]statsEntry
+		sweepAt  time.Time
+	}
+
+	// statsKey is a course of a tenant, see auth.TenantFrom.
+	statsKey struct {
+		tenant   string
+		courseID uuid.UUID
+	}
+
+	statsEntry struct {
+		stats   domain.GradeStats
+		expires time.Time
+	}
+)
+
+func newStatsCache(ttl time.Duration, minCount int) *statsCache {
+	return &statsCache{
+		ttl:      ttl,
+		minCount: minCount,
+		entries:  make(map[statsKey]statsEntry),
+	}
+}
+
+func (c *statsCache) get(ctx context.Context, courseID uuid.UUID) (domain.GradeStats, bool) {
+	if c == nil {
+		return domain.GradeStats{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	key := statsKey{tenant: auth.TenantFrom(ctx), courseID: courseID}
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return domain.GradeStats{}, false
+	}
+	return entry.stats, true
+}
+
+// put caches the stats of a course with at least minCount grades.
+func (c *statsCache) put(ctx context.Context, courseID uuid.UUID, stats domain.GradeStats) {
+	if c == nil || c.ttl <= 0 || stats.Count < c.minCount {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if !now.Before(c.sweepAt) {
+		c.sweep(now)
+	}
+	c.entries[statsKey{tenant: auth.TenantFrom(ctx), courseID: courseID}] = statsEntry{stats: stats, expires: now.Add(c.ttl)}
+}
+
+// sweep removes the entries expired by now; c.mu must be held.
+func (c *statsCache) sweep(now time.Time) {
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+	c.sweepAt = now.Add(c.ttl)
+}
+
+func (c *statsCache) evict(ctx context.Context, courseID uuid.UUID) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, statsKey{tenant: auth.TenantFrom(ctx), courseID: courseID})
+}
+
+// GetCourseStats computes how a course went under the given scale type, the default one if empty.
+// It returns domain.ErrCourseNotFound when the course has no grades.
+func (c *controller) GetCourseStats(ctx context.Context, courseID uuid.UUID, scaleType domain.ScaleType) (domain.CourseStats, error) {
+	if scaleType == "" {
+		scaleType = domain.DefaultScaleType
+	}
+	scales, err := c.fetchScales(ctx, scaleType)
+	if err != nil {
+		return domain.CourseStats{}, fmt.Errorf("fetching scales failed: %w", err)
+	}
+
+	stats, ok := c.stats.get(ctx, courseID)
+	if !ok {
+		if stats, err = c.repo.GetCourseStats(ctx, courseID); err != nil {
+			c.logger.Error("GetCourseStats: failed to get course stats", "error", err)
+			return domain.CourseStats{}, fmt.Errorf("fetching course stats failed: %w", err)
+		}
+		c.stats.put(ctx, courseID, stats)
+	}
+	if stats.Count == 0 {
+		return domain.CourseStats{}, domain.ErrCourseNotFound
+	}
+	courseStats, err := domain.NewCourseStats(courseID, scaleType, stats, scales)
+	if err != nil {
+		return domain.CourseStats{}, fmt.Errorf("scale %s: %w", scaleType, err)
+	}
+	return courseStats, nil
+}
@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+func TestController_GetCourseStats(t *testing.T) {
+	courseID := uuid.New()
+	scales := domain.Scales{{Min: 3, GPA: "pass"}, {Min: 0, GPA: "fail"}}
+	stats := domain.NewGradeStats([]domain.GradeCount{{Grade: 1, Count: 2}, {Grade: 4, Count: 3}})
+	testCases := map[string]struct {
+		scaleType   domain.ScaleType
+		setMock     func(m *rdbms.MockRepository)
+		expected    domain.CourseStats
+		expectedErr error
+	}{
+		"default scale": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.DefaultScaleType).Return(scales, nil)
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID).Return(stats, nil)
+			},
+			expected: domain.CourseStats{
+				CourseID: courseID, ScaleType: domain.DefaultScaleType, GradeStats: stats,
+				Histogram: []domain.LetterCount{{GPA: "pass", Count: 3}, {GPA: "fail", Count: 2}},
+			},
+		},
+		"unknown scale": {
+			scaleType: "ECTS",
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("ECTS")).Return(nil, domain.ErrScaleNotFound)
+			},
+			expectedErr: domain.ErrScaleNotFound,
+		},
+		"course without grades": {
+			scaleType: "4.0",
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.ScaleType("4.0")).Return(scales, nil)
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID).Return(domain.GradeStats{}, nil)
+			},
+			expectedErr: domain.ErrCourseNotFound,
+		},
+		"storage failure": {
+			setMock: func(m *rdbms.MockRepository) {
+				m.EXPECT().GetScales(gomock.Any(), domain.DefaultScaleType).Return(scales, nil)
+				m.EXPECT().GetCourseStats(gomock.Any(), courseID).Return(domain.GradeStats{}, errors.New("connection refused"))
+			},
+			expectedErr: errors.New("connection refused"),
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := rdbms.NewMockRepository(ctrl)
+			tc.setMock(m)
+			c := controller{
+				repo:   m,
+				logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+			}
+			got, err := c.GetCourseStats(context.TODO(), courseID, tc.scaleType)
+			if tc.expectedErr != nil {
+				require.Error(t, err)
+				if errors.Is(tc.expectedErr, domain.ErrScaleNotFound) || errors.Is(tc.expectedErr, domain.ErrCourseNotFound) {
+					require.ErrorIs(t, err, tc.expectedErr)
+				}
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestController_GetCourseStats_Cache(t *testing.T) {
+	ctx := context.Background()
+	large, small := uuid.New(), uuid.New()
+	ctrl := gomock.NewController(t)
+	m := rdbms.NewMockRepository(ctrl)
+	m.EXPECT().GetScales(gomock.Any(), gomock.Any()).Return(domain.Scales{{Min: 0, GPA: "F"}}, nil).AnyTimes()
+	largeStats := domain.NewGradeStats([]domain.GradeCount{{Grade: 3, Count: 10}})
+	smallStats := domain.NewGradeStats([]domain.GradeCount{{Grade: 3, Count: 9}})
+	m.EXPECT().GetCourseStats(gomock.Any(), large).Return(largeStats, nil).Times(3)
+	m.EXPECT().GetCourseStats(gomock.Any(), small).Return(smallStats, nil).Times(2)
+	c := controller{
+		repo:   m,
+		logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+		stats:  newStatsCache(time.Minute, 10),
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := c.GetCourseStats(ctx, large, "")
+		require.NoError(t, err)
+		_, err = c.GetCourseStats(ctx, small, "")
+		require.NoError(t, err)
+	}
+
+	// the stats are cached per tenant
+	_, err := c.GetCourseStats(auth.WithTenant(ctx, "north-high"), large, "")
+	require.NoError(t, err)
+
+	// posting a grade in the course evicts its stats
+	grade := domain.Grade{StudentID: uuid.New(), CourseID: large, Grade: 3}
+	expectSerializableTx(m)
+	m.EXPECT().GetStudentGrades(gomock.Any(), grade.StudentID).Return(nil, nil)
+	m.EXPECT().InsertGrade(gomock.Any(), grade).Return(nil)
+	m.EXPECT().AppendEvents(gomock.Any(), gomock.Any()).Return(nil)
+	_, err = c.PostGrade(ctx, grade)
+	require.NoError(t, err)
+	got, err := c.GetCourseStats(ctx, large, "")
+	require.NoError(t, err)
+	require.Equal(t, 10, got.Count)
+}
+
+func TestStatsCache_Sweep(t *testing.T) {
+	ctx := context.Background()
+	stats := domain.NewGradeStats([]domain.GradeCount{{Grade: 3, Count: 10}})
+	c := newStatsCache(50*time.Millisecond, 10)
+	for i := 0; i < 3; i++ {
+		c.put(ctx, uuid.New(), stats)
+	}
+	require.Len(t, c.entries, 3)
+
+	time.Sleep(60 * time.Millisecond)
+	fresh := uuid.New()
+	c.put(ctx, fresh, stats)
+	require.Len(t, c.entries, 1, "the expired entries are swept")
+	_, ok := c.get(ctx, fresh)
+	require.True(t, ok)
+}
@@ -0,0 +1,288 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"golang.org/x/exp/slog"
+)
+
+// maxErrorLength bounds the part of a response body kept as the error of an attempt.
+const maxErrorLength = 512
+
+type (
+	// Deliverer posts the due deliveries to their webhooks.
+	Deliverer struct {
+		repo     rdbms.Repository
+		logger   *slog.Logger
+		options  delivererOptions
+		cancel   context.CancelFunc
+		stopOnce sync.Once
+		done     sync.WaitGroup
+	}
+
+	delivererOptions struct {
+		interval       time.Duration
+		batchSize      int
+		maxAttempts    int
+		initialBackoff time.Duration
+		maxBackoff     time.Duration
+		client         *http.Client
+		now            func() time.Time
+	}
+
+	// DelivererOption is used to provide overrides to the Deliverer implementation.
+	DelivererOption func(*delivererOptions)
+)
+
+// Interval sets how often the due deliveries are looked for. The default is 1 second.
+func Interval(interval time.Duration) DelivererOption {
+	return func(o *delivererOptions) {
+		o.interval = interval
+	}
+}
+
+// BatchSize sets the maximum number of deliveries attempted at once. The default is 20.
+func BatchSize(size int) DelivererOption {
+	return func(o *delivererOptions) {
+		o.batchSize = size
+	}
+}
+
+// MaxAttempts sets the attempts of a delivery before it is dead. The default is 8.
+func MaxAttempts(attempts int) DelivererOption {
+	return func(o *delivererOptions) {
+		o.maxAttempts = attempts
+	}
+}
+
+// Backoff sets the wait after the first failed attempt, doubling on each failure up to max.
+// The defaults are 30 seconds and 1 hour, so the 8 attempts of a delivery span an hour.
+func Backoff(initial, max time.Duration) DelivererOption {
+	return func(o *delivererOptions) {
+		o.initialBackoff = initial
+		o.maxBackoff = max
+	}
+}
+
+// Client sets the client posting the deliveries. The default times out after 10 seconds.
+func Client(client *http.Client) DelivererOption {
+	return func(o *delivererOptions) {
+		o.client = client
+	}
+}
+
+// Clock sets the function returning the current time, for tests.
+func Clock(now func() time.Time) DelivererOption {
+	return func(o *delivererOptions) {
+		o.now = now
+	}
+}
+
+// NewDeliverer returns a deliverer of the deliveries of repo.
+func NewDeliverer(repo rdbms.Repository, logger *slog.Logger, options ...DelivererOption) *Deliverer {
+	d := &Deliverer{
+		repo:   repo,
+		logger: logger,
+		options: delivererOptions{
+			interval:       time.Second,
+			batchSize:      20,
+			maxAttempts:    8,
+			initialBackoff: 30 * time.Second,
+			maxBackoff:     time.Hour,
+			client:         &http.Client{Timeout: 10 * time.Second},
+			now:            time.Now,
+		},
+	}
+	for _, opt := range options {
+		opt(&d.options)
+	}
+	if d.options.batchSize < 1 {
+		d.options.batchSize = 1
+	}
+	if d.options.maxAttempts < 1 {
+		d.options.maxAttempts = 1
+	}
+	return d
+}
+
+// DeliverOnce attempts a batch of due deliveries of every tenant, returning how many were attempted. Each is
+// attempted in the tenant of its webhook.
+func (d *Deliverer) DeliverOnce(ctx context.Context) (int, error) {
+	deliveries, err := d.claim(auth.WithTenant(ctx, auth.AllTenants))
+	if err != nil {
+		return 0, err
+	}
+	webhooks := make(map[uuid.UUID]domain.Webhook)
+	for _, delivery := range deliveries {
+		ctx := auth.WithTenant(ctx, delivery.TenantID)
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			if webhook, err = d.repo.GetWebhook(ctx, delivery.WebhookID); err != nil && !errors.Is(err, domain.ErrWebhookNotFound) {
+				return 0, fmt.Errorf("getting webhook: %w", err)
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+		delivery = d.attempt(ctx, webhook, delivery)
+		if err := d.repo.UpdateDelivery(ctx, delivery); err != nil && !errors.Is(err, domain.ErrDeliveryNotFound) {
+			return 0, fmt.Errorf("updating delivery: %w", err)
+		}
+	}
+	return len(deliveries), nil
+}
+
+// claim returns the due deliveries, postponed by the time their attempt may take so the other deliverers
+// leave them alone meanwhile.
+func (d *Deliverer) claim(ctx context.Context) ([]domain.Delivery, error) {
+	var claimed []domain.Delivery
+	err := d.repo.WithTx(ctx, func(repo rdbms.Repository) error {
+		now := d.now()
+		deliveries, err := repo.DueDeliveries(ctx, now, d.options.batchSize)
+		if err != nil {
+			return fmt.Errorf("getting due deliveries: %w", err)
+		}
+		claimed = deliveries
+		for _, delivery := range deliveries {
+			delivery.NextAttemptAt = now.Add(d.lease())
+			if err := repo.UpdateDelivery(auth.WithTenant(ctx, delivery.TenantID), delivery); err != nil {
+				return fmt.Errorf("claiming delivery: %w", err)
+			}
+		}
+		return nil
+	})
+	return claimed, err
+}
+
+// now is the current time in UTC, as stored.
+func (d *Deliverer) now() time.Time {
+	return d.options.now().UTC()
+}
+
+// lease is how long a claimed delivery is left alone, long enough for its attempt to time out.
+func (d *Deliverer) lease() time.Duration {
+	if d.options.client.Timeout > 0 {
+		return 2 * d.options.client.Timeout
+	}
+	return time.Minute
+}
+
+// attempt posts the delivery to the webhook and returns the delivery updated with the outcome.
+func (d *Deliverer) attempt(ctx context.Context, webhook domain.Webhook, delivery domain.Delivery) domain.Delivery {
+	delivery.Attempts++
+	delivery.LastStatusCode, delivery.LastError = 0, ""
+	switch {
+	case webhook.ID == uuid.Nil:
+		delivery.LastError = "webhook deleted"
+	case !webhook.Active:
+		delivery.LastError = "webhook inactive"
+	default:
+		delivery.LastStatusCode, delivery.LastError = d.post(ctx, webhook, delivery)
+	}
+
+	now := d.now()
+	delivery.UpdatedAt = now
+	switch {
+	case delivery.LastError == "":
+		delivery.Status = domain.DeliveryDelivered
+	case delivery.Attempts >= d.options.maxAttempts || webhook.ID == uuid.Nil || !webhook.Active:
+		delivery.Status = domain.DeliveryDead
+		d.logger.Warn("webhook delivery dead", "delivery", delivery.ID, "webhook", delivery.WebhookID,
+			"attempts", delivery.Attempts, "err", delivery.LastError)
+	default:
+		delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+	}
+	return delivery
+}
+
+// post posts the delivery, returning the status code of the response and an error message unless it was 2xx.
+func (d *Deliverer) post(ctx context.Context, webhook domain.Webhook, delivery domain.Delivery) (int, string) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Body))
+	if err != nil {
+		return 0, err.Error()
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "grading-webhooks/1")
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, d.now(), delivery.Body))
+
+	resp, err := d.options.client.Do(req)
+	if err != nil {
+		return 0, err.Error()
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message := resp.Status
+		if len(body) > 0 {
+			message += ": " + strings.TrimSpace(string(body))
+		}
+		return resp.StatusCode, message
+	}
+	return resp.StatusCode, ""
+}
+
+// backoff is the wait after the given number of failed attempts.
+func (d *Deliverer) backoff(attempts int) time.Duration {
+	wait := d.options.initialBackoff
+	for i := 1; i < attempts && wait < d.options.maxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, d.options.maxBackoff)
+}
+
+// Start attempts the due deliveries in the background until Stop is called: batch after batch while there
+// are due deliveries, then every interval.
+func (d *Deliverer) Start(ctx context.Context) {
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.done.Add(1)
+	go func() {
+		defer d.done.Done()
+		ticker := time.NewTicker(d.options.interval)
+		defer ticker.Stop()
+		for {
+			d.drain(ctx)
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (d *Deliverer) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		attempted, err := d.DeliverOnce(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				d.logger.Warn("delivering webhooks failed", "err", err)
+			}
+			return
+		}
+		if attempted < d.options.batchSize {
+			return
+		}
+	}
+}
+
+// Stop stops delivering, abandoning the attempts in progress, and waits for the deliverer to return.
+func (d *Deliverer) Stop() {
+	d.stopOnce.Do(func() {
+		if d.cancel != nil {
+			d.cancel()
+		}
+	})
+	d.done.Wait()
+}
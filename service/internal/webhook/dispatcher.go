@@ -0,0 +1,93 @@
+// Package webhook posts the events to the webhooks partner systems subscribed, see domain.Webhook.
+//
+// The outbox relay hands the events to the Dispatcher, which stores a delivery per event and subscribed webhook.
+// The Deliverer posts the deliveries signed with the secret of their webhook, see Sign, retrying with an
+// exponential backoff until they are delivered or out of attempts, then dead.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/outbox"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+)
+
+var _ outbox.TxSink = new(Dispatcher)
+
+// Dispatcher is the outbox sink enqueuing the deliveries of the events to the subscribed webhooks.
+type Dispatcher struct {
+	repo rdbms.Repository
+}
+
+// NewDispatcher returns a dispatcher to the webhooks of repo.
+func NewDispatcher(repo rdbms.Repository) *Dispatcher {
+	return &Dispatcher{repo: repo}
+}
+
+// Deliver enqueues the deliveries of the events.
+func (d *Dispatcher) Deliver(ctx context.Context, events []domain.Event) error {
+	return d.DeliverTx(ctx, d.repo, events)
+}
+
+// DeliverTx enqueues the deliveries of the events within the unit of work of repo, each to the webhooks of the
+// tenant of the event only.
+func (d *Dispatcher) DeliverTx(ctx context.Context, repo rdbms.Repository, events []domain.Event) error {
+	var tenants []string
+	byTenant := make(map[string][]domain.Event)
+	for _, event := range events {
+		if _, ok := byTenant[event.TenantID]; !ok {
+			tenants = append(tenants, event.TenantID)
+		}
+		byTenant[event.TenantID] = append(byTenant[event.TenantID], event)
+	}
+	for _, tenant := range tenants {
+		if err := d.deliverTenant(auth.WithTenant(ctx, tenant), repo, byTenant[tenant]); err != nil {
+			return fmt.Errorf("tenant %s: %w", tenant, err)
+		}
+	}
+	return nil
+}
+
+// deliverTenant enqueues the deliveries of the events of the tenant of ctx.
+func (d *Dispatcher) deliverTenant(ctx context.Context, repo rdbms.Repository, events []domain.Event) error {
+	webhooks, err := repo.ListWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("listing webhooks: %w", err)
+	}
+	now := time.Now().UTC()
+	var deliveries []domain.Delivery
+	for _, event := range events {
+		var body []byte
+		for _, webhook := range webhooks {
+			if !webhook.Subscribed(event.Type) {
+				continue
+			}
+			if body == nil {
+				if body, err = json.Marshal(event); err != nil {
+					return fmt.Errorf("encoding event %s: %w", event.ID, err)
+				}
+			}
+			deliveries = append(deliveries, domain.Delivery{
+				ID:            uuid.New(),
+				WebhookID:     webhook.ID,
+				EventID:       event.ID,
+				EventType:     event.Type,
+				Body:          body,
+				Status:        domain.DeliveryPending,
+				NextAttemptAt: now,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return repo.EnqueueDeliveries(ctx, deliveries)
+}
@@ -0,0 +1,268 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/internal/outbox"
+	"github.com/mnabbasabadi/grading/service/internal/storage/memory"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/exp/slog"
+)
+
+const secret = "whsec_test"
+
+// receiver is a webhook endpoint verifying the signature of the requests, answering the given statuses in turn
+// and then 204.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	bodies   [][]byte
+	headers  []http.Header
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil || Verify(secret, req.Header.Get(SignatureHeader), body, time.Now(), time.Hour) != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, body)
+	r.headers = append(r.headers, req.Header.Clone())
+	if len(r.statuses) > 0 {
+		status := r.statuses[0]
+		r.statuses = r.statuses[1:]
+		http.Error(w, "unavailable", status)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (r *receiver) received() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.bodies)
+}
+
+// newStore returns a store with a webhook of the url subscribed to the event types, and an event of each type
+// dispatched to it.
+func newStore(t *testing.T, url string, subscribed ...domain.EventType) (*memory.Store, domain.Webhook) {
+	t.Helper()
+	ctx := context.Background()
+	store := memory.New()
+	webhook := domain.Webhook{ID: uuid.New(), URL: url, Secret: secret, EventTypes: subscribed, Active: true}
+	require.NoError(t, store.CreateWebhook(ctx, webhook))
+
+	posted, err := domain.NewGradePosted(domain.Grade{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 3})
+	require.NoError(t, err)
+	updated, err := domain.NewScaleUpdated("4.0", nil)
+	require.NoError(t, err)
+	require.NoError(t, store.AppendEvents(ctx, []domain.Event{posted, updated}))
+	relay := outbox.NewRelay(store, NewDispatcher(store), slog.New(slog.NewJSONHandler(io.Discard, nil)))
+	_, err = relay.RelayOnce(ctx)
+	require.NoError(t, err)
+	return store, webhook
+}
+
+func deliveries(t *testing.T, store *memory.Store, webhook domain.Webhook) []domain.Delivery {
+	t.Helper()
+	deliveries, _, err := store.ListDeliveries(context.Background(), webhook.ID, 10, 0)
+	require.NoError(t, err)
+	return deliveries
+}
+
+func TestSignature(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	now := time.Now()
+	header := Sign(secret, now, body)
+
+	testCases := map[string]struct {
+		secret string
+		header string
+		body   []byte
+		now    time.Time
+		valid  bool
+	}{
+		"valid":         {secret: secret, header: header, body: body, now: now, valid: true},
+		"other secret":  {secret: "whsec_other", header: header, body: body, now: now},
+		"tampered body": {secret: secret, header: header, body: []byte(`{"id":"2"}`), now: now},
+		"replayed":      {secret: secret, header: header, body: body, now: now.Add(10 * time.Minute)},
+		"malformed":     {secret: secret, header: "v1=00", body: body, now: now},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := Verify(tc.secret, tc.header, tc.body, tc.now, 5*time.Minute)
+			if tc.valid {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidSignature)
+		})
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	store, webhook := newStore(t, "http://localhost", domain.GradePosted)
+	enqueued := deliveries(t, store, webhook)
+	require.Len(t, enqueued, 1, "only the subscribed events are delivered")
+	require.Equal(t, domain.GradePosted, enqueued[0].EventType)
+	require.Equal(t, domain.DeliveryPending, enqueued[0].Status)
+
+	// the relay handing the event over again does not deliver it twice
+	event, err := domain.NewGradePosted(domain.Grade{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 1})
+	require.NoError(t, err)
+	event.ID = enqueued[0].EventID
+	require.NoError(t, NewDispatcher(store).Deliver(context.Background(), []domain.Event{event}))
+	require.Len(t, deliveries(t, store, webhook), 1)
+}
+
+func TestDispatcher_Tenants(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+	defer server.Close()
+	store, webhook := newStore(t, server.URL)
+	ctx := auth.WithTenant(context.Background(), "north-high")
+	tenantWebhook := domain.Webhook{ID: uuid.New(), URL: server.URL, Secret: secret, Active: true}
+	require.NoError(t, store.CreateWebhook(ctx, tenantWebhook))
+
+	event, err := domain.NewGradePosted(domain.Grade{StudentID: uuid.New(), CourseID: uuid.New(), Grade: 1})
+	require.NoError(t, err)
+	require.NoError(t, store.AppendEvents(ctx, []domain.Event{event}))
+	_, err = outbox.NewRelay(store, NewDispatcher(store), slog.New(slog.NewJSONHandler(io.Discard, nil))).RelayOnce(context.Background())
+	require.NoError(t, err)
+	require.Len(t, deliveries(t, store, webhook), 2, "the events of a tenant must not go to the webhooks of others")
+	enqueued, _, err := store.ListDeliveries(ctx, tenantWebhook.ID, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, enqueued, 1)
+	require.Equal(t, event.ID, enqueued[0].EventID)
+
+	attempted, err := NewDeliverer(store, slog.New(slog.NewJSONHandler(io.Discard, nil)), Client(server.Client())).
+		DeliverOnce(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 3, attempted)
+	delivery, err := store.GetDelivery(ctx, enqueued[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.DeliveryDelivered, delivery.Status, "the deliveries of every tenant must be attempted")
+}
+
+func TestDeliverer(t *testing.T) {
+	testCases := map[string]struct {
+		statuses         []int
+		maxAttempts      int
+		expectedStatus   domain.DeliveryStatus
+		expectedAttempts int
+		expectedCode     int
+	}{
+		"delivered": {
+			maxAttempts:      3,
+			expectedStatus:   domain.DeliveryDelivered,
+			expectedAttempts: 1,
+			expectedCode:     http.StatusNoContent,
+		},
+		"delivered after retries": {
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusBadGateway},
+			maxAttempts:      3,
+			expectedStatus:   domain.DeliveryDelivered,
+			expectedAttempts: 3,
+			expectedCode:     http.StatusNoContent,
+		},
+		"dead": {
+			statuses:         []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError},
+			maxAttempts:      3,
+			expectedStatus:   domain.DeliveryDead,
+			expectedAttempts: 3,
+			expectedCode:     http.StatusInternalServerError,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &receiver{statuses: tc.statuses}
+			server := httptest.NewServer(r)
+			defer server.Close()
+			store, webhook := newStore(t, server.URL, domain.GradePosted)
+
+			now := time.Now()
+			d := NewDeliverer(store, slog.New(slog.NewJSONHandler(io.Discard, nil)),
+				MaxAttempts(tc.maxAttempts), Backoff(time.Minute, 90*time.Second), Client(server.Client()),
+				Clock(func() time.Time { return now }))
+			backoffs := []time.Duration{0, time.Minute, 90 * time.Second}
+			for attempt := 0; attempt < tc.expectedAttempts; attempt++ {
+				now = now.Add(backoffs[attempt])
+				attempted, err := d.DeliverOnce(ctx)
+				require.NoError(t, err)
+				require.Equal(t, 1, attempted, "attempt %d", attempt+1)
+				// nothing is due before the backoff elapses
+				attempted, err = d.DeliverOnce(ctx)
+				require.NoError(t, err)
+				require.Zero(t, attempted)
+			}
+
+			delivery := deliveries(t, store, webhook)[0]
+			require.Equal(t, tc.expectedStatus, delivery.Status)
+			require.Equal(t, tc.expectedAttempts, delivery.Attempts)
+			require.Equal(t, tc.expectedCode, delivery.LastStatusCode)
+			require.Equal(t, tc.expectedAttempts, r.received())
+			require.Equal(t, delivery.ID.String(), r.headers[0].Get(DeliveryHeader))
+			require.Equal(t, string(domain.GradePosted), r.headers[0].Get(EventHeader))
+			require.JSONEq(t, string(delivery.Body), string(r.bodies[0]))
+			if tc.expectedStatus == domain.DeliveryDead {
+				require.Contains(t, delivery.LastError, "unavailable")
+			}
+		})
+	}
+}
+
+func TestDeliverer_DeletedWebhook(t *testing.T) {
+	ctx := context.Background()
+	store, webhook := newStore(t, "http://localhost")
+	pending := deliveries(t, store, webhook)
+	require.Len(t, pending, 2)
+	require.NoError(t, store.DeleteWebhook(ctx, webhook.ID))
+	// the deliveries went with the webhook, enqueue one left behind
+	require.NoError(t, store.EnqueueDeliveries(ctx, pending[:1]))
+
+	d := NewDeliverer(store, slog.New(slog.NewJSONHandler(io.Discard, nil)))
+	attempted, err := d.DeliverOnce(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, attempted)
+	delivery, err := store.GetDelivery(ctx, pending[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, domain.DeliveryDead, delivery.Status)
+	require.Equal(t, "webhook deleted", delivery.LastError)
+}
+
+func TestDeliverer_Start(t *testing.T) {
+	r := &receiver{statuses: []int{http.StatusServiceUnavailable}}
+	server := httptest.NewServer(r)
+	defer server.Close()
+	store, webhook := newStore(t, server.URL)
+
+	d := NewDeliverer(store, slog.New(slog.NewJSONHandler(io.Discard, nil)),
+		Interval(10*time.Millisecond), Backoff(10*time.Millisecond, 10*time.Millisecond), Client(server.Client()))
+	d.Start(context.Background())
+	defer d.Stop()
+	require.Eventually(t, func() bool {
+		for _, delivery := range deliveries(t, store, webhook) {
+			if delivery.Status != domain.DeliveryDelivered {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, 3, r.received(), "two events, one retried")
+}
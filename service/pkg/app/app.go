@@ -6,10 +6,18 @@ import (
 	"net/http"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	kitGRPC "github.com/mnabbasabadi/grading/service/foundation/grpc"
 	kitHTTP "github.com/mnabbasabadi/grading/service/foundation/http"
+	gradingGraphQL "github.com/mnabbasabadi/grading/service/internal/api/graphql"
+	gradingRPC "github.com/mnabbasabadi/grading/service/internal/api/grpc"
 	gradingAPI "github.com/mnabbasabadi/grading/service/internal/api/http"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/mysql"
 	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/postgres"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/sqlite"
 	"github.com/mnabbasabadi/grading/service/internal/usecase"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
 	"golang.org/x/exp/slog"
 )
 
@@ -17,11 +25,25 @@ import (
 type Environment struct {
 	logger *slog.Logger
 
-	dbConn *sqlx.DB
+	dbConn   *sqlx.DB
+	dbDriver db.Driver
+	replicas *db.Cluster
+	repo     rdbms.Repository
+
+	readYourWrites   bool
+	rankViewerRoles  []string
+	adminRoles       []string
+	roleClaim        string
+	studentClaim     string
+	standingRules    domain.StandingRules
+	conversionTables domain.ConversionTables
+	tenantClaim      string
+	tenantRequired   bool
 
 	Logic usecase.Logic
 
 	HTTPRegister kitHTTP.Registrar
+	GRPCRegister kitGRPC.Registrar
 }
 
 // Params ...
@@ -29,19 +51,59 @@ type Params struct {
 	//metrics *Metrics
 	Logger       *slog.Logger
 	HTTPRegister kitHTTP.Registrar
+	// GRPCRegister is optional, the gRPC API is not served when it is nil.
+	GRPCRegister kitGRPC.Registrar
 
 	// storage
 	DB *sqlx.DB
+	// DBDriver is the backend of DB, postgres if empty.
+	DBDriver db.Driver
+	// Replicas are optional read replicas of a postgres DB.
+	Replicas *db.Cluster
+	// ReadYourWrites is the consistency of the requests not choosing with the ReadYourWritesHeader.
+	ReadYourWrites bool
+	// RankViewerRoles are the roles allowed to see the rank of any student, the usecase defaults when nil.
+	RankViewerRoles []string
+	// AdminRoles are the roles allowed to manage the webhooks and to run the standing of a term, the usecase
+	// defaults when nil.
+	AdminRoles []string
+	// CallerRoleClaim and CallerStudentClaim are the claims of the bearer token naming the caller of a request.
+	CallerRoleClaim    string
+	CallerStudentClaim string
+	// StandingRules are the rules evaluated to find the standing of the students, the usecase defaults when nil.
+	StandingRules domain.StandingRules
+	// ConversionTables are the tables converting the letters of a scale to another.
+	ConversionTables domain.ConversionTables
+	// TenantClaim is the claim of the bearer token naming the tenant of a request without the TenantHeader.
+	TenantClaim string
+	// TenantRequired rejects the requests naming no tenant, instead of serving them the default one.
+	TenantRequired bool
+	// Repository is used instead of DB when set, e.g. an in-memory store.
+	Repository rdbms.Repository
 }
 
 // NewEnvironment ...
 func NewEnvironment(ctx context.Context, params Params) *Environment {
 	e := &Environment{
 		//metrics: params.Metrics,
-		logger: params.Logger,
-		dbConn: params.DB,
+		logger:   params.Logger,
+		dbConn:   params.DB,
+		dbDriver: params.DBDriver,
+		replicas: params.Replicas,
+		repo:     params.Repository,
+
+		readYourWrites:   params.ReadYourWrites,
+		rankViewerRoles:  params.RankViewerRoles,
+		adminRoles:       params.AdminRoles,
+		roleClaim:        params.CallerRoleClaim,
+		studentClaim:     params.CallerStudentClaim,
+		standingRules:    params.StandingRules,
+		conversionTables: params.ConversionTables,
+		tenantClaim:      params.TenantClaim,
+		tenantRequired:   params.TenantRequired,
 
 		HTTPRegister: params.HTTPRegister,
+		GRPCRegister: params.GRPCRegister,
 	}
 	e.Setup(ctx)
 	return e
@@ -49,13 +111,34 @@ func NewEnvironment(ctx context.Context, params Params) *Environment {
 
 // Setup ...
 func (e *Environment) Setup(_ context.Context) {
-	repo := postgres.New(e.dbConn)
-	logic := usecase.New(e.logger, repo)
+	if e.repo == nil {
+		e.repo = e.newRepository()
+	}
+	var options []usecase.Option
+	if e.rankViewerRoles != nil {
+		options = append(options, usecase.RankViewerRoles(e.rankViewerRoles...))
+	}
+	if e.adminRoles != nil {
+		options = append(options, usecase.AdminRoles(e.adminRoles...))
+	}
+	if e.standingRules != nil {
+		options = append(options, usecase.StandingRules(e.standingRules))
+	}
+	options = append(options, usecase.ConversionTables(e.conversionTables))
+	logic := usecase.New(e.logger, e.repo, options...)
 
 	gradingHandler := gradingAPI.NewHandler(logic, e.logger)
+	graphqlHandler, err := gradingGraphQL.NewHandler(logic, e.logger)
+	if err != nil {
+		panic(err)
+	}
 
 	e.HTTPRegister(func(mux *http.ServeMux) {
-		mw := []kitHTTP.Middleware{}
+		mw := []kitHTTP.Middleware{
+			ConsistencyMiddleware(e.readYourWrites),
+			CallerMiddleware(e.roleClaim, e.studentClaim),
+			TenantMiddleware(e.tenantClaim, e.tenantRequired),
+		}
 		// add metrics middleware
 		//mw = append(mw, kitHTTP.Metrics(e.metrics))
 		// add tracing middleware
@@ -66,7 +149,12 @@ func (e *Environment) Setup(_ context.Context) {
 		//mw = append(mw, kitHTTP.Authentication(e.auth))
 		h := kitHTTP.Chain(gradingHandler, mw...)
 		mux.Handle("/", h)
+		mux.Handle("/graphql", kitHTTP.Chain(graphqlHandler, mw...))
 	})
+
+	if e.GRPCRegister != nil {
+		e.GRPCRegister(gradingRPC.Register(logic, e.logger))
+	}
 }
 
 // Shutdown ...
@@ -74,8 +162,22 @@ func (e *Environment) Shutdown() {
 	//e.metrics.Close()
 }
 
-// GetPostgresDB ...
-func (e *Environment) GetPostgresDB() postgres.Repository {
-	//return e.dbConn
-	return nil
+// Repository returns the repository the use cases are served from.
+func (e *Environment) Repository() rdbms.Repository {
+	return e.repo
+}
+
+// newRepository returns the repository of the configured database driver.
+func (e *Environment) newRepository() rdbms.Repository {
+	switch e.dbDriver {
+	case db.MySQL:
+		return mysql.New(e.dbConn)
+	case db.SQLite:
+		return sqlite.New(e.dbConn)
+	default:
+		if e.replicas != nil {
+			return postgres.New(e.dbConn, postgres.WithReplicas(e.replicas))
+		}
+		return postgres.New(e.dbConn)
+	}
 }
@@ -0,0 +1,47 @@
+package app
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	kitHTTP "github.com/mnabbasabadi/grading/service/foundation/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// callerOf returns the caller named by the role and student claims of the bearer token of the authorization,
+// which the gateway verified. A malformed student id is ignored, leaving the caller a role only.
+func callerOf(authorization, roleClaim, studentClaim string) auth.Caller {
+	caller := auth.Caller{Role: tokenClaim(authorization, roleClaim)}
+	if studentID, err := uuid.Parse(tokenClaim(authorization, studentClaim)); err == nil {
+		caller.StudentID = studentID
+	}
+	return caller
+}
+
+// CallerMiddleware makes every HTTP request made by the caller of its bearer token, see auth.WithCaller and
+// callerOf. A request without a token is made by an anonymous caller.
+func CallerMiddleware(roleClaim, studentClaim string) kitHTTP.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			caller := callerOf(r.Header.Get("Authorization"), roleClaim, studentClaim)
+			next.ServeHTTP(w, r.WithContext(auth.WithCaller(r.Context(), caller)))
+		})
+	}
+}
+
+// CallerInterceptor makes every unary RPC made by the caller of the bearer token of its metadata, like
+// CallerMiddleware.
+func CallerInterceptor(roleClaim, studentClaim string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var authorization string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				authorization = values[0]
+			}
+		}
+		return handler(auth.WithCaller(ctx, callerOf(authorization, roleClaim, studentClaim)), req)
+	}
+}
@@ -0,0 +1,46 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	kitHTTP "github.com/mnabbasabadi/grading/service/foundation/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ReadYourWritesHeader lets a request choose whether its reads following a write go to the primary.
+const ReadYourWritesHeader = "X-Read-Your-Writes"
+
+// readYourWrites returns the choice of the request, the default when it makes none.
+func readYourWrites(value string, defaultValue bool) bool {
+	if choice, err := strconv.ParseBool(value); err == nil {
+		return choice
+	}
+	return defaultValue
+}
+
+// ConsistencyMiddleware starts the consistency session of every HTTP request, see db.WithSession.
+func ConsistencyMiddleware(defaultReadYourWrites bool) kitHTTP.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := db.WithSession(r.Context(), readYourWrites(r.Header.Get(ReadYourWritesHeader), defaultReadYourWrites))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// ConsistencyInterceptor starts the consistency session of every unary RPC, see db.WithSession.
+func ConsistencyInterceptor(defaultReadYourWrites bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var value string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(ReadYourWritesHeader); len(values) > 0 {
+				value = values[0]
+			}
+		}
+		return handler(db.WithSession(ctx, readYourWrites(value, defaultReadYourWrites)), req)
+	}
+}
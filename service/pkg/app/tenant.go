@@ -0,0 +1,132 @@
+package app
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	kitHTTP "github.com/mnabbasabadi/grading/service/foundation/http"
+	gradingAPI "github.com/mnabbasabadi/grading/service/internal/api/http"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TenantHeader names the tenant of a request, set by the gateway authenticating it. Without it, the tenant is
+// read from a claim of the bearer token, which the gateway verified; a request whose header names another
+// tenant than the claim is rejected.
+const TenantHeader = "X-Tenant-ID"
+
+// tenantlessPaths are served without a tenant even when one is required: probes and documentation.
+var tenantlessPaths = []string{"/live", "/ready", "/openapi.json", "/openapi.yaml", "/docs/"}
+
+var (
+	errTenantRequired = errors.New("a tenant is required")
+	errInvalidTenant  = errors.New("invalid tenant")
+	errTenantMismatch = errors.New("the tenant header does not match the tenant of the token")
+)
+
+// resolveTenant returns the tenant named by the claim of the bearer token of the authorization, else by the
+// header. It returns errTenantMismatch when both name one and they differ, and an empty tenant when neither
+// names one.
+func resolveTenant(header, authorization, claim string) (string, error) {
+	tenant := tokenClaim(authorization, claim)
+	switch {
+	case tenant == "":
+		tenant = header
+	case header != "" && header != tenant:
+		return "", errTenantMismatch
+	}
+	if tenant != "" && !auth.ValidTenant(tenant) {
+		return "", fmt.Errorf("%w %q", errInvalidTenant, tenant)
+	}
+	return tenant, nil
+}
+
+// tokenClaim returns the string claim of the JWT bearer token of the authorization, empty if there is none.
+// The signature is not checked: the gateway in front of the service did.
+func tokenClaim(authorization, claim string) string {
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || claim == "" {
+		return ""
+	}
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	value, _ := claims[claim].(string)
+	return value
+}
+
+// TenantMiddleware scopes every HTTP request to the tenant it names, see auth.WithTenant and resolveTenant.
+// A request naming an invalid tenant is rejected, as is one naming none when required is set, and with 403 one
+// whose header and token name different tenants.
+func TenantMiddleware(claim string, required bool) kitHTTP.Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tenant, err := resolveTenant(r.Header.Get(TenantHeader), r.Header.Get("Authorization"), claim)
+			if err == nil && tenant == "" && required && !tenantless(r.URL.Path) {
+				err = errTenantRequired
+			}
+			if err != nil {
+				code := http.StatusBadRequest
+				if errors.Is(err, errTenantMismatch) {
+					code = http.StatusForbidden
+				}
+				_ = gradingAPI.RespondError(w, err, code)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(auth.WithTenant(r.Context(), tenant)))
+		})
+	}
+}
+
+func tenantless(path string) bool {
+	for _, p := range tenantlessPaths {
+		if path == p || strings.HasSuffix(p, "/") && strings.HasPrefix(path, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// TenantInterceptor scopes every unary RPC to the tenant of its metadata, like TenantMiddleware. Health
+// checks and reflection are served without a tenant.
+func TenantInterceptor(claim string, required bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var header, authorization string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(TenantHeader); len(values) > 0 {
+				header = values[0]
+			}
+			if values := md.Get("authorization"); len(values) > 0 {
+				authorization = values[0]
+			}
+		}
+		tenant, err := resolveTenant(header, authorization, claim)
+		if errors.Is(err, errTenantMismatch) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if tenant == "" && required && !strings.HasPrefix(info.FullMethod, "/grpc.") {
+			return nil, status.Error(codes.InvalidArgument, errTenantRequired.Error())
+		}
+		return handler(auth.WithTenant(ctx, tenant), req)
+	}
+}
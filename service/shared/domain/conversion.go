@@ -0,0 +1,278 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Conversion methods. A table maps the letters of a scale to the letters of another; linear interpolation
+// puts a grade at the same position in the range of the other scale; percentile equivalence gives the grade at the
+// same percentile of the grades on the other scale.
+const (
+	ConvertByTable      ConversionMethod = "table"
+	ConvertByLinear     ConversionMethod = "linear"
+	ConvertByPercentile ConversionMethod = "percentile"
+)
+
+type (
+	// ConversionMethod is how a grade is converted from a scale to another.
+	ConversionMethod string
+
+	// ConversionTable maps the letters of a scale to the letters of another, e.g. the ECTS B to the 4.0 A-.
+	ConversionTable struct {
+		From    ScaleType
+		To      ScaleType
+		Letters map[string]string
+	}
+
+	// ConversionTables are the configured tables, at most one per pair of scale types.
+	ConversionTables []ConversionTable
+
+	// Conversion asks for a grade of a scale on another. The grade is given as a number, or as a letter of
+	// the scale when Grade is nil. An empty Method uses the table of the scales if any, and linear
+	// interpolation otherwise.
+	Conversion struct {
+		From   ScaleType
+		To     ScaleType
+		Grade  *int
+		Letter string
+		Method ConversionMethod
+	}
+
+	// ConvertedGrade is a grade converted from a scale to another. A letter given is converted from the lowest
+	// grade of its band, which is also the grade of a letter found with a table.
+	ConvertedGrade struct {
+		From       ScaleType
+		To         ScaleType
+		Method     ConversionMethod
+		FromGrade  int
+		FromLetter string
+		Grade      int
+		Letter     string
+	}
+)
+
+// ParseConversionTables parses tables written as `from->to: letter=letter, letter=letter; from->to: ...`,
+// e.g. `ECTS->4.0: A=A, B=B, C=C, D=D, E=D, F=F`. An empty spec has no tables.
+func ParseConversionTables(spec string) (ConversionTables, error) {
+	var tables ConversionTables
+	for _, raw := range strings.Split(spec, ";") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		pair, letters, ok := strings.Cut(raw, ":")
+		from, to, arrow := strings.Cut(pair, "->")
+		if !ok || !arrow {
+			return nil, fmt.Errorf("%w: table %q is not from->to: letters", ErrInvalidConversion, strings.TrimSpace(raw))
+		}
+		table := ConversionTable{
+			From:    ScaleType(strings.TrimSpace(from)),
+			To:      ScaleType(strings.TrimSpace(to)),
+			Letters: make(map[string]string),
+		}
+		for _, scaleType := range []ScaleType{table.From, table.To} {
+			if !scaleType.Valid() {
+				return nil, fmt.Errorf("%w: unknown scale type %q", ErrInvalidConversion, scaleType)
+			}
+		}
+		if _, found := tables.Find(table.From, table.To); found {
+			return nil, fmt.Errorf("%w: duplicate table %s->%s", ErrInvalidConversion, table.From, table.To)
+		}
+		for _, mapping := range strings.Split(letters, ",") {
+			fromLetter, toLetter, ok := strings.Cut(mapping, "=")
+			fromLetter, toLetter = strings.TrimSpace(fromLetter), strings.TrimSpace(toLetter)
+			if !ok || fromLetter == "" || toLetter == "" {
+				return nil, fmt.Errorf("%w: %q of table %s->%s is not letter=letter", ErrInvalidConversion,
+					strings.TrimSpace(mapping), table.From, table.To)
+			}
+			table.Letters[fromLetter] = toLetter
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// Find returns the table converting from a scale type to another.
+func (t ConversionTables) Find(from, to ScaleType) (ConversionTable, bool) {
+	for _, table := range t {
+		if table.From == from && table.To == to {
+			return table, true
+		}
+	}
+	return ConversionTable{}, false
+}
+
+// Validate checks the conversion is between known scale types, of either a grade or a letter, with a known
+// method if any.
+func (c Conversion) Validate() error {
+	for _, scaleType := range []ScaleType{c.From, c.To} {
+		if !scaleType.Valid() {
+			return fmt.Errorf("%w: unknown scale type %q", ErrInvalidConversion, scaleType)
+		}
+	}
+	switch {
+	case c.Grade == nil && c.Letter == "":
+		return fmt.Errorf("%w: a grade or a letter is required", ErrInvalidConversion)
+	case c.Grade != nil && c.Letter != "":
+		return fmt.Errorf("%w: either a grade or a letter is converted, not both", ErrInvalidConversion)
+	}
+	switch c.Method {
+	case "", ConvertByTable, ConvertByLinear, ConvertByPercentile:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown method %q", ErrInvalidConversion, c.Method)
+}
+
+// Convert converts a grade between scales, both sorted by Min in descending order. Percentile equivalence ranks
+// the grade among the grades of the cohort on the from scale, those it has a letter for, and returns the grade at
+// the same percentile of the grades of the cohort on the to scale; grades are how many times the cohort got each
+// grade, unused by the other methods.
+// It returns ErrScaleNotFound when a scale has no bands.
+func (c Conversion) Convert(from, to Scales, tables ConversionTables, grades []GradeCount) (ConvertedGrade, error) {
+	if len(from) == 0 || len(to) == 0 {
+		return ConvertedGrade{}, ErrScaleNotFound
+	}
+	converted := ConvertedGrade{From: c.From, To: c.To, Method: c.Method}
+	if c.Grade == nil {
+		grade, ok := from.Min(c.Letter)
+		if !ok {
+			return ConvertedGrade{}, fmt.Errorf("%w: no letter %q in scale %s", ErrInvalidConversion, c.Letter, c.From)
+		}
+		converted.FromGrade, converted.FromLetter = grade, c.Letter
+	} else {
+		letter, err := from.GetGPA(*c.Grade)
+		if err != nil {
+			return ConvertedGrade{}, fmt.Errorf("%w: scale %s: %w", ErrInvalidConversion, c.From, err)
+		}
+		converted.FromGrade, converted.FromLetter = *c.Grade, letter
+	}
+
+	table, found := tables.Find(c.From, c.To)
+	if converted.Method == "" {
+		converted.Method = ConvertByLinear
+		if found {
+			converted.Method = ConvertByTable
+		}
+	}
+	switch converted.Method {
+	case ConvertByLinear:
+		converted.Grade = to.atPosition(from.position(converted.FromGrade))
+	case ConvertByPercentile:
+		fromGrades, toGrades := from.graded(grades), to.graded(grades)
+		if len(fromGrades) == 0 || len(toGrades) == 0 {
+			return ConvertedGrade{}, fmt.Errorf("%w: percentile equivalence needs grades on both %s and %s",
+				ErrInvalidConversion, c.From, c.To)
+		}
+		converted.Grade = atPercentile(toGrades, percentRank(fromGrades, converted.FromGrade))
+	}
+	if converted.Method != ConvertByTable {
+		letter, err := to.GetGPA(converted.Grade)
+		if err != nil {
+			return ConvertedGrade{}, fmt.Errorf("%w: scale %s: %w", ErrInvalidConversion, c.To, err)
+		}
+		converted.Letter = letter
+		return converted, nil
+	}
+
+	if !found {
+		return ConvertedGrade{}, fmt.Errorf("%w: no table from %s to %s", ErrInvalidConversion, c.From, c.To)
+	}
+	letter, ok := table.Letters[converted.FromLetter]
+	if !ok {
+		return ConvertedGrade{}, fmt.Errorf("%w: table from %s to %s has no letter %q", ErrInvalidConversion,
+			c.From, c.To, converted.FromLetter)
+	}
+	grade, ok := to.Min(letter)
+	if !ok {
+		return ConvertedGrade{}, fmt.Errorf("%w: table from %s to %s gives letter %q, not in the scale", ErrInvalidConversion,
+			c.From, c.To, letter)
+	}
+	converted.Grade, converted.Letter = grade, letter
+	return converted, nil
+}
+
+// Min returns the lowest grade of the band of a letter, which is above its Min when that is exclusive.
+// It reports false when the scales have no band of the letter, or when that grade maps to another letter.
+func (s Scales) Min(letter string) (int, bool) {
+	for _, scale := range s {
+		if scale.GPA == letter {
+			grade := scale.lowest()
+			if gpa, err := s.GetGPA(grade); err != nil || gpa != letter {
+				return 0, false
+			}
+			return grade, true
+		}
+	}
+	return 0, false
+}
+
+// bounds returns the range of the sorted scales: from the lowest grade of the lowest band to the highest grade
+// of the highest band, or its lowest one when it has no max.
+func (s Scales) bounds() (int, int) {
+	highest, bounded := s[0].highest()
+	if !bounded {
+		highest = s[0].lowest()
+	}
+	return s[len(s)-1].lowest(), highest
+}
+
+// position returns where a grade is in the range of the sorted scales, from 0 at its lowest grade to 1 at its
+// highest grade and above. A range of a single grade is all at 1.
+func (s Scales) position(grade int) float64 {
+	lowest, highest := s.bounds()
+	if grade >= highest {
+		return 1
+	}
+	return float64(grade-lowest) / float64(highest-lowest)
+}
+
+// atPosition returns the grade closest to a position in the range of the sorted scales.
+func (s Scales) atPosition(position float64) int {
+	lowest, highest := s.bounds()
+	return lowest + int(math.Round(position*float64(highest-lowest)))
+}
+
+// graded returns the grades the sorted scales have a letter for.
+func (s Scales) graded(grades []GradeCount) []GradeCount {
+	var graded []GradeCount
+	for _, count := range grades {
+		if _, err := s.GetGPA(count.Grade); err == nil && count.Count > 0 {
+			graded = append(graded, count)
+		}
+	}
+	return graded
+}
+
+// percentRank returns the share of the other grades below a grade, as the percent_rank of SQL does: from 0 for the
+// lowest grade to 1 for the highest one and above. A single grade is at 0.
+func percentRank(grades []GradeCount, grade int) float64 {
+	var below, total int
+	for _, count := range grades {
+		if count.Grade < grade {
+			below += count.Count
+		}
+		total += count.Count
+	}
+	if total == 1 {
+		return 0
+	}
+	return math.Min(float64(below)/float64(total-1), 1)
+}
+
+// atPercentile returns the grade of the given percent rank among grades sorted in ascending order, the closest
+// one when none has it.
+func atPercentile(grades []GradeCount, rank float64) int {
+	var total int
+	for _, count := range grades {
+		total += count.Count
+	}
+	index := int(math.Round(rank * float64(total-1)))
+	for _, count := range grades {
+		if index < count.Count {
+			return count.Grade
+		}
+		index -= count.Count
+	}
+	return grades[len(grades)-1].Grade
+}
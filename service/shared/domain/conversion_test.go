@@ -0,0 +1,270 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	fourPoint = Scales{{Min: 4, GPA: "A"}, {Min: 3, GPA: "B"}, {Min: 2, GPA: "C"}, {Min: 1, GPA: "D"}, {Min: 0, GPA: "F"}}
+	tenPoint  = Scales{{Min: 9, GPA: "A"}, {Min: 8, GPA: "B"}, {Min: 7, GPA: "C"}, {Min: 6, GPA: "D"}, {Min: 5, GPA: "E"}, {Min: 1, GPA: "F"}}
+	ects      = Scales{{Min: 90, GPA: "A"}, {Min: 80, GPA: "B"}, {Min: 70, GPA: "C"}, {Min: 60, GPA: "D"}, {Min: 50, GPA: "E"}, {Min: 10, GPA: "F"}}
+
+	// the bounded scales letter the grades of their own range only, and a cohort graded on both
+	boundedFourPoint = Scales{{Min: 4, GPA: "A", Max: intPtr(4)}, {Min: 3, GPA: "B"}, {Min: 2, GPA: "C"}, {Min: 1, GPA: "D"}, {Min: 0, GPA: "F"}}
+	boundedECTS      = Scales{{Min: 90, GPA: "A", Max: intPtr(100)}, {Min: 80, GPA: "B"}, {Min: 70, GPA: "C"}, {Min: 60, GPA: "D"}, {Min: 50, GPA: "E"}, {Min: 10, GPA: "F"}}
+	cohort           = []GradeCount{{Grade: 0, Count: 1}, {Grade: 1, Count: 2}, {Grade: 2, Count: 4}, {Grade: 3, Count: 2}, {Grade: 4, Count: 1},
+		{Grade: 20, Count: 1}, {Grade: 55, Count: 2}, {Grade: 65, Count: 4}, {Grade: 80, Count: 2}, {Grade: 95, Count: 1}}
+)
+
+func TestParseConversionTables(t *testing.T) {
+	testCases := map[string]struct {
+		spec        string
+		expected    ConversionTables
+		expectedErr error
+	}{
+		"tables": {
+			spec: "ECTS->4.0: A=A, B=B, E=D; 4.0->ECTS: A=A",
+			expected: ConversionTables{
+				{From: "ECTS", To: "4.0", Letters: map[string]string{"A": "A", "B": "B", "E": "D"}},
+				{From: "4.0", To: "ECTS", Letters: map[string]string{"A": "A"}},
+			},
+		},
+		"empty":              {spec: ""},
+		"missing pair":       {spec: "A=A", expectedErr: ErrInvalidConversion},
+		"missing arrow":      {spec: "ECTS: A=A", expectedErr: ErrInvalidConversion},
+		"unknown scale type": {spec: "ECTS->6.0: A=A", expectedErr: ErrInvalidConversion},
+		"duplicate table":    {spec: "ECTS->4.0: A=A; ECTS->4.0: B=B", expectedErr: ErrInvalidConversion},
+		"missing letter":     {spec: "ECTS->4.0: A=", expectedErr: ErrInvalidConversion},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tables, err := ParseConversionTables(tc.spec)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, tables)
+		})
+	}
+}
+
+func TestConversionValidate(t *testing.T) {
+	grade := 3
+	testCases := map[string]struct {
+		conversion  Conversion
+		expectedErr error
+	}{
+		"grade":              {conversion: Conversion{From: "ECTS", To: "4.0", Grade: &grade}},
+		"letter with method": {conversion: Conversion{From: "ECTS", To: "4.0", Letter: "B", Method: ConvertByTable}},
+		"by percentile":      {conversion: Conversion{From: "ECTS", To: "4.0", Grade: &grade, Method: ConvertByPercentile}},
+		"unknown scale type": {conversion: Conversion{From: "ECTS", To: "6.0", Grade: &grade}, expectedErr: ErrInvalidConversion},
+		"nothing":            {conversion: Conversion{From: "ECTS", To: "4.0"}, expectedErr: ErrInvalidConversion},
+		"grade and letter":   {conversion: Conversion{From: "ECTS", To: "4.0", Grade: &grade, Letter: "B"}, expectedErr: ErrInvalidConversion},
+		"unknown method":     {conversion: Conversion{From: "ECTS", To: "4.0", Grade: &grade, Method: "zscore"}, expectedErr: ErrInvalidConversion},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tc.conversion.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestConversionConvert(t *testing.T) {
+	tables, err := ParseConversionTables("ECTS->4.0: A=A, B=B, C=C, D=D, E=D, F=F; 4.0->ECTS: A=A, B=B, C=C, D=D, F=F; ECTS->10.0: A=Z")
+	require.NoError(t, err)
+	grade := func(grade int) *int { return &grade }
+	testCases := map[string]struct {
+		conversion  Conversion
+		from, to    Scales
+		grades      []GradeCount
+		expected    ConvertedGrade
+		expectedErr error
+	}{
+		"letter by table": {
+			conversion: Conversion{From: "ECTS", To: "4.0", Letter: "E"},
+			from:       ects,
+			to:         fourPoint,
+			expected:   ConvertedGrade{From: "ECTS", To: "4.0", Method: ConvertByTable, FromGrade: 50, FromLetter: "E", Grade: 1, Letter: "D"},
+		},
+		"grade by table": {
+			conversion: Conversion{From: "ECTS", To: "4.0", Grade: grade(85)},
+			from:       ects,
+			to:         fourPoint,
+			expected:   ConvertedGrade{From: "ECTS", To: "4.0", Method: ConvertByTable, FromGrade: 85, FromLetter: "B", Grade: 3, Letter: "B"},
+		},
+		"grade by linear interpolation without a table": {
+			conversion: Conversion{From: "10.0", To: "4.0", Grade: grade(7)},
+			from:       tenPoint,
+			to:         fourPoint,
+			expected:   ConvertedGrade{From: "10.0", To: "4.0", Method: ConvertByLinear, FromGrade: 7, FromLetter: "C", Grade: 3, Letter: "B"},
+		},
+		"grade by linear interpolation despite a table": {
+			conversion: Conversion{From: "ECTS", To: "4.0", Grade: grade(10), Method: ConvertByLinear},
+			from:       ects,
+			to:         fourPoint,
+			expected:   ConvertedGrade{From: "ECTS", To: "4.0", Method: ConvertByLinear, FromGrade: 10, FromLetter: "F", Grade: 0, Letter: "F"},
+		},
+		"grade above the highest band": {
+			conversion: Conversion{From: "10.0", To: "4.0", Grade: grade(10)},
+			from:       tenPoint,
+			to:         fourPoint,
+			expected:   ConvertedGrade{From: "10.0", To: "4.0", Method: ConvertByLinear, FromGrade: 10, FromLetter: "A", Grade: 4, Letter: "A"},
+		},
+		"grade from a bounded scale": {
+			conversion: Conversion{From: "ECTS", To: "4.0", Grade: grade(60), Method: ConvertByLinear},
+			from:       Scales{{Min: 90, GPA: "A", Max: intPtr(100)}, {Min: 50, GPA: "C"}, {Min: 0, GPA: "F"}},
+			to:         fourPoint,
+			expected:   ConvertedGrade{From: "ECTS", To: "4.0", Method: ConvertByLinear, FromGrade: 60, FromLetter: "C", Grade: 2, Letter: "C"},
+		},
+		"grade to a scale with an exclusive max": {
+			conversion: Conversion{From: "4.0", To: "ECTS", Grade: grade(4), Method: ConvertByLinear},
+			from:       fourPoint,
+			to:         Scales{{Min: 90, GPA: "A", Max: intPtr(101), MaxExclusive: true}, {Min: -1, GPA: "F", MinExclusive: true}},
+			expected:   ConvertedGrade{From: "4.0", To: "ECTS", Method: ConvertByLinear, FromGrade: 4, FromLetter: "A", Grade: 100, Letter: "A"},
+		},
+		"letter of a band with an exclusive min": {
+			conversion: Conversion{From: "ECTS", To: "4.0", Letter: "A", Method: ConvertByLinear},
+			from:       Scales{{Min: 89, GPA: "A", MinExclusive: true, Max: intPtr(100)}, {Min: 0, GPA: "F", Max: intPtr(89)}},
+			to:         fourPoint,
+			expected:   ConvertedGrade{From: "ECTS", To: "4.0", Method: ConvertByLinear, FromGrade: 90, FromLetter: "A", Grade: 4, Letter: "A"},
+		},
+		"grade by percentile equivalence": {
+			conversion: Conversion{From: "ECTS", To: "4.0", Grade: grade(80), Method: ConvertByPercentile},
+			from:       boundedECTS,
+			to:         boundedFourPoint,
+			grades:     cohort,
+			expected:   ConvertedGrade{From: "ECTS", To: "4.0", Method: ConvertByPercentile, FromGrade: 80, FromLetter: "B", Grade: 3, Letter: "B"},
+		},
+		"letter by percentile equivalence": {
+			conversion: Conversion{From: "4.0", To: "ECTS", Letter: "C", Method: ConvertByPercentile},
+			from:       boundedFourPoint,
+			to:         boundedECTS,
+			grades:     cohort,
+			expected:   ConvertedGrade{From: "4.0", To: "ECTS", Method: ConvertByPercentile, FromGrade: 2, FromLetter: "C", Grade: 65, Letter: "D"},
+		},
+		"grade above the cohort by percentile equivalence": {
+			conversion: Conversion{From: "ECTS", To: "4.0", Grade: grade(100), Method: ConvertByPercentile},
+			from:       boundedECTS,
+			to:         boundedFourPoint,
+			grades:     cohort,
+			expected:   ConvertedGrade{From: "ECTS", To: "4.0", Method: ConvertByPercentile, FromGrade: 100, FromLetter: "A", Grade: 4, Letter: "A"},
+		},
+		"no grades on a scale to rank against": {
+			conversion:  Conversion{From: "ECTS", To: "4.0", Grade: grade(80), Method: ConvertByPercentile},
+			from:        boundedECTS,
+			to:          boundedFourPoint,
+			grades:      cohort[:5],
+			expectedErr: ErrInvalidConversion,
+		},
+		"no table": {
+			conversion:  Conversion{From: "10.0", To: "4.0", Letter: "A", Method: ConvertByTable},
+			from:        tenPoint,
+			to:          fourPoint,
+			expectedErr: ErrInvalidConversion,
+		},
+		"letter not in the scale": {
+			conversion:  Conversion{From: "ECTS", To: "4.0", Letter: "FX"},
+			from:        ects,
+			to:          fourPoint,
+			expectedErr: ErrInvalidConversion,
+		},
+		"letter not in the table": {
+			conversion:  Conversion{From: "ECTS", To: "10.0", Letter: "B"},
+			from:        ects,
+			to:          tenPoint,
+			expectedErr: ErrInvalidConversion,
+		},
+		"table letter not in the scale": {
+			conversion:  Conversion{From: "ECTS", To: "10.0", Letter: "A"},
+			from:        ects,
+			to:          tenPoint,
+			expectedErr: ErrInvalidConversion,
+		},
+		"grade below the scale": {
+			conversion:  Conversion{From: "10.0", To: "4.0", Grade: grade(0)},
+			from:        tenPoint,
+			to:          fourPoint,
+			expectedErr: ErrInvalidConversion,
+		},
+		"grade by linear interpolation into a gap": {
+			conversion:  Conversion{From: "4.0", To: "10.0", Grade: grade(2)},
+			from:        fourPoint,
+			to:          Scales{{Min: 10, GPA: "A"}, {Min: 0, GPA: "F", Max: intPtr(2)}},
+			expectedErr: ErrGradeOutOfScale,
+		},
+		"scale without bands": {
+			conversion:  Conversion{From: "10.0", To: "4.0", Grade: grade(7)},
+			from:        tenPoint,
+			expectedErr: ErrScaleNotFound,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			converted, err := tc.conversion.Convert(tc.from, tc.to, tables, tc.grades)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, converted)
+		})
+	}
+}
+
+func TestScales_Min(t *testing.T) {
+	scales := Scales{{Min: 89, GPA: "A", MinExclusive: true, Max: intPtr(100)}, {Min: 50, GPA: "C", Max: intPtr(89)}}
+	grade, ok := scales.Min("A")
+	require.True(t, ok)
+	require.Equal(t, 90, grade)
+	grade, ok = scales.Min("C")
+	require.True(t, ok)
+	require.Equal(t, 50, grade)
+	_, ok = scales.Min("B")
+	require.False(t, ok)
+
+	// the lowest grade of a band holding none is in no band, so it does not map back to the letter
+	_, ok = Scales{{Min: 5, GPA: "A", MinExclusive: true, Max: intPtr(5)}}.Min("A")
+	require.False(t, ok)
+}
+
+func TestConversionConvert_RoundTrip(t *testing.T) {
+	tables, err := ParseConversionTables("ECTS->4.0: A=A, B=B, C=C, D=D, E=D, F=F; 4.0->ECTS: A=A, B=B, C=C, D=D, F=F")
+	require.NoError(t, err)
+	convert := func(conversion Conversion, from, to Scales) ConvertedGrade {
+		converted, err := conversion.Convert(from, to, tables, cohort)
+		require.NoError(t, err)
+		return converted
+	}
+
+	// letters round-trip through tables mapping them back, bands of different widths lose them by linear interpolation
+	for _, band := range fourPoint {
+		there := convert(Conversion{From: "4.0", To: "ECTS", Letter: band.GPA}, fourPoint, ects)
+		back := convert(Conversion{From: "ECTS", To: "4.0", Letter: there.Letter}, ects, fourPoint)
+		require.Equal(t, band.GPA, back.Letter)
+		require.Equal(t, band.Min, back.Grade)
+	}
+	// grades round-trip by linear interpolation when one range is a multiple of the other
+	for grade := 0; grade <= 4; grade++ {
+		grade := grade
+		there := convert(Conversion{From: "4.0", To: "ECTS", Grade: &grade, Method: ConvertByLinear}, fourPoint, ects)
+		back := convert(Conversion{From: "ECTS", To: "4.0", Grade: &there.Grade, Method: ConvertByLinear}, ects, fourPoint)
+		require.Equal(t, grade, back.Grade)
+	}
+	// grades of the cohort round-trip by percentile equivalence when it has as many grades on both scales,
+	// spread alike
+	for _, count := range cohort[:5] {
+		grade := count.Grade
+		there := convert(Conversion{From: "4.0", To: "ECTS", Grade: &grade, Method: ConvertByPercentile}, boundedFourPoint, boundedECTS)
+		back := convert(Conversion{From: "ECTS", To: "4.0", Grade: &there.Grade, Method: ConvertByPercentile}, boundedECTS, boundedFourPoint)
+		require.Equal(t, grade, back.Grade)
+	}
+}
@@ -1,7 +1,10 @@
 package domain
 
 import (
+	"fmt"
+	"math"
 	"sort"
+	"strconv"
 
 	"github.com/google/uuid"
 )
@@ -9,44 +12,318 @@ import (
 type (
 	// ScaleType is the grade point average.
 	ScaleType string
+	// GradeType is the outcome a grade records. Only numeric grades count in GPAs, see GradeType.Counted.
+	GradeType string
 	// Grade ...
 	Grade struct {
 		StudentID uuid.UUID `db:"student_id"`
 		CourseID  uuid.UUID `db:"course_id"`
 		Grade     int       `db:"grade"`
+		// Term is the term the grade was given in, named to sort chronologically, e.g. 2024-1; empty if unknown.
+		Term string `db:"term"`
+		// Credits are the credits of the course.
+		Credits int `db:"credits"`
+		// Type is the outcome the grade records, NumericGrade unless set. Grade is 0 for the other types.
+		Type GradeType `db:"type"`
 	}
 
 	GradeWithGPA struct {
 		*Grade
 		GPA string `db:"gpa"`
+		// GPAs are the letters of the grade under several scales, keyed by scale type, when asked for.
+		GPAs map[ScaleType]string
 	}
 
-	// Scale ...
+	// Scale is a band of grades with a letter or points. Min is inclusive unless MinExclusive is set; a nil Max
+	// leaves the band open up to the next band, or above for the highest one.
 	Scale struct {
-		Min int    `db:"min"`
-		GPA string `db:"gpa"`
+		Min          int    `db:"min"`
+		GPA          string `db:"gpa"`
+		Max          *int   `db:"max"`
+		MinExclusive bool   `db:"min_exclusive"`
+		MaxExclusive bool   `db:"max_exclusive"`
 	}
 	// Scales ...
 	Scales []Scale
+
+	// StudentGPA is the GPA of a student under a scale: the letter of the average of all their grades.
+	StudentGPA struct {
+		StudentID uuid.UUID
+		Average   float64
+		GPA       string
+		Grades    []GradeWithGPA
+	}
+
+	// GradeSummary aggregates a set of grades.
+	GradeSummary struct {
+		Count int
+		Mean  float64
+		Min   int
+		Max   int
+	}
 )
 
 const (
 	DefaultScaleType ScaleType = "default"
 )
 
+// ScaleTypes are the scale types known by the service.
+var ScaleTypes = []ScaleType{DefaultScaleType, "4.0", "4.3", "5.0", "7.0", "10.0", "ECTS"}
+
+// Grade types. A numeric grade is looked up in the scales, the other types record an outcome without a grade.
+const (
+	NumericGrade    GradeType = ""
+	PassGrade       GradeType = "pass"
+	FailGrade       GradeType = "fail"
+	IncompleteGrade GradeType = "incomplete"
+	WithdrawnGrade  GradeType = "withdrawn"
+	AuditGrade      GradeType = "audit"
+)
+
+// GradeTypes are the grade types known by the service.
+var GradeTypes = []GradeType{NumericGrade, PassGrade, FailGrade, IncompleteGrade, WithdrawnGrade, AuditGrade}
+
+// gradeLabels are the labels the grades of the types other than numeric are shown with instead of a letter.
+var gradeLabels = map[GradeType]string{
+	PassGrade:       "P",
+	FailGrade:       "NP",
+	IncompleteGrade: "I",
+	WithdrawnGrade:  "W",
+	AuditGrade:      "AU",
+}
+
+// Validate checks the grade can be recorded: it is for a student and a course, is not negative, and is 0 unless
+// it is a numeric one.
+func (g Grade) Validate() error {
+	switch {
+	case g.StudentID == uuid.Nil:
+		return fmt.Errorf("%w: missing student", ErrInvalidGrade)
+	case g.CourseID == uuid.Nil:
+		return fmt.Errorf("%w: missing course", ErrInvalidGrade)
+	case !g.Type.Valid():
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidGrade, g.Type)
+	case g.Grade < 0:
+		return fmt.Errorf("%w: negative grade %d", ErrInvalidGrade, g.Grade)
+	case g.Grade != 0 && !g.Type.Counted():
+		return fmt.Errorf("%w: %s grade with a value %d", ErrInvalidGrade, g.Type, g.Grade)
+	case g.Credits < 0:
+		return fmt.Errorf("%w: negative credits %d", ErrInvalidGrade, g.Credits)
+	}
+	return nil
+}
+
+// Valid reports whether the grade type is one of the known GradeTypes.
+func (t GradeType) Valid() bool {
+	for _, known := range GradeTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Counted reports whether the grades of the type count in GPAs, averages, ranks and course stats: only numeric
+// grades do.
+func (t GradeType) Counted() bool {
+	return t == NumericGrade
+}
+
+// Label returns the label the grades of the type are shown with, empty for numeric grades.
+func (t GradeType) Label() string {
+	return gradeLabels[t]
+}
+
+// CountedGrades returns the grades counting in GPAs, see GradeType.Counted.
+func CountedGrades(grades []Grade) []Grade {
+	counted := make([]Grade, 0, len(grades))
+	for _, grade := range grades {
+		if grade.Type.Counted() {
+			counted = append(counted, grade)
+		}
+	}
+	return counted
+}
+
+// Valid reports whether the scale type is one of the known ScaleTypes.
+func (t ScaleType) Valid() bool {
+	for _, known := range ScaleTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks that the scales can be used to look up GPAs:
+// there is at least one band, every band has a GPA and holds a grade, and no two bands share a grade.
+func (s Scales) Validate() error {
+	if len(s) == 0 {
+		return fmt.Errorf("%w: at least one band is required", ErrInvalidScales)
+	}
+	seen := make(map[int]struct{}, len(s))
+	for _, scale := range s {
+		if scale.GPA == "" {
+			return fmt.Errorf("%w: band with min %d has no gpa", ErrInvalidScales, scale.Min)
+		}
+		if _, ok := seen[scale.Min]; ok {
+			return fmt.Errorf("%w: duplicate band with min %d", ErrInvalidScales, scale.Min)
+		}
+		seen[scale.Min] = struct{}{}
+		if scale.Max == nil && scale.MaxExclusive {
+			return fmt.Errorf("%w: band with min %d has an exclusive max but no max", ErrInvalidScales, scale.Min)
+		}
+		if highest, bounded := scale.highest(); bounded && highest < scale.lowest() {
+			return fmt.Errorf("%w: band with min %d holds no grade", ErrInvalidScales, scale.Min)
+		}
+	}
+	sorted := s.Sorted()
+	for i := 1; i < len(sorted); i++ {
+		higher, lower := sorted[i-1], sorted[i]
+		highest, bounded := lower.highest()
+		if lower.lowest() >= higher.lowest() || bounded && highest >= higher.lowest() {
+			return fmt.Errorf("%w: band with min %d overlaps band with min %d", ErrInvalidScales, lower.Min, higher.Min)
+		}
+	}
+	return nil
+}
+
+// Contains reports whether the grade is within the bounds of the band.
+func (s Scale) Contains(grade int) bool {
+	highest, bounded := s.highest()
+	return grade >= s.lowest() && (!bounded || grade <= highest)
+}
+
+// lowest returns the lowest grade of the band.
+func (s Scale) lowest() int {
+	if s.MinExclusive {
+		return s.Min + 1
+	}
+	return s.Min
+}
+
+// highest returns the highest grade of the band, if it has a max.
+func (s Scale) highest() (int, bool) {
+	if s.Max == nil {
+		return 0, false
+	}
+	if s.MaxExclusive {
+		return *s.Max - 1, true
+	}
+	return *s.Max, true
+}
+
+// Sorted returns a copy of the scales sorted by Min in descending order, as expected by GetGPA.
+func (s Scales) Sorted() Scales {
+	sorted := make(Scales, len(s))
+	copy(sorted, s)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Min > sorted[j].Min
+	})
+	return sorted
+}
+
 // GetGPA is a method on Scales that returns the GPA for a given grade.
-// if the grade is not found, it returns an empty string.
-// scales should be sorted by Min in descending order.
-func (s Scales) GetGPA(grade int) string {
+// It returns ErrGradeOutOfScale when the grade is in no band: below the lowest one, above a highest one with a
+// max, or in a gap between bands. scales should be sorted by Min in descending order.
+func (s Scales) GetGPA(grade int) (string, error) {
+	idx, ok := s.band(grade)
+	if !ok {
+		return "", fmt.Errorf("%w: grade %d", ErrGradeOutOfScale, grade)
+	}
+	return s[idx].GPA, nil
+}
+
+// band returns the index of the band of a grade in the sorted scales.
+func (s Scales) band(grade int) (int, bool) {
 	// Use binary search for faster lookup
 	idx := sort.Search(len(s), func(i int) bool {
-		return s[i].Min <= grade
+		return s[i].lowest() <= grade
 	})
+	if idx < len(s) && s[idx].Contains(grade) {
+		return idx, true
+	}
+	return 0, false
+}
+
+// GetGradeGPA returns the GPA of a grade: the letter of its band for a numeric grade, the label of its type
+// otherwise.
+func (s Scales) GetGradeGPA(grade Grade) (string, error) {
+	if !grade.Type.Counted() {
+		return grade.Type.Label(), nil
+	}
+	return s.GetGPA(grade.Grade)
+}
+
+// GPAPoints returns the value of a GPA of a scale giving points rather than letters, e.g. 3.7 for "3.7". It reports
+// false for letters and labels.
+func GPAPoints(gpa string) (float64, bool) {
+	points, err := strconv.ParseFloat(gpa, 64)
+	if err != nil || math.IsNaN(points) || math.IsInf(points, 0) {
+		return 0, false
+	}
+	return points, true
+}
+
+// GetAverageGPA returns the GPA of an average of grades.
+// Bands have integer bounds, so the band of an average is the band of its floor.
+func (s Scales) GetAverageGPA(average float64) (string, error) {
+	return s.GetGPA(int(math.Floor(average)))
+}
+
+// NewStudentGPA computes the GPA of a student from all their grades under the given scales, the grades not
+// counting in GPAs being listed with their label. The GPA is empty when none of the grades counts.
+// It returns ErrGradeOutOfScale when a grade, or their average, is in no band.
+func NewStudentGPA(studentID uuid.UUID, grades []Grade, scales Scales) (StudentGPA, error) {
+	gradesWithGPA := make([]GradeWithGPA, len(grades))
+	for i := range grades {
+		gpa, err := scales.GetGradeGPA(grades[i])
+		if err != nil {
+			return StudentGPA{}, err
+		}
+		gradesWithGPA[i] = GradeWithGPA{
+			Grade: &grades[i],
+			GPA:   gpa,
+		}
+	}
+	summary := SummarizeGrades(grades)
+	if summary.Count == 0 {
+		return StudentGPA{StudentID: studentID, Grades: gradesWithGPA}, nil
+	}
+	average := summary.Mean
+	gpa, err := scales.GetAverageGPA(average)
+	if err != nil {
+		return StudentGPA{}, fmt.Errorf("average: %w", err)
+	}
+	return StudentGPA{
+		StudentID: studentID,
+		Average:   average,
+		GPA:       gpa,
+		Grades:    gradesWithGPA,
+	}, nil
+}
 
-	// If we found a match
-	if idx < len(s) {
-		return s[idx].GPA
+// SummarizeGrades returns the count, mean, min and max of the grades counting in GPAs, see CountedGrades.
+// The summary of no grades is all zeros.
+func SummarizeGrades(grades []Grade) GradeSummary {
+	grades = CountedGrades(grades)
+	if len(grades) == 0 {
+		return GradeSummary{}
+	}
+	summary := GradeSummary{
+		Count: len(grades),
+		Min:   grades[0].Grade,
+		Max:   grades[0].Grade,
+	}
+	var sum int
+	for _, grade := range grades {
+		sum += grade.Grade
+		if grade.Grade < summary.Min {
+			summary.Min = grade.Grade
+		}
+		if grade.Grade > summary.Max {
+			summary.Max = grade.Grade
+		}
 	}
-	// Default GPA if not found
-	return "N/A"
+	summary.Mean = float64(sum) / float64(len(grades))
+	return summary
 }
@@ -3,14 +3,27 @@ package domain
 import (
 	"testing"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 )
 
+// plusMinus has bands with explicit bounds a gap from 72 to 79 and no grade of 70 or below.
+var plusMinus = Scales{
+	{Min: 90, GPA: "A", Max: intPtr(100)},
+	{Min: 87, GPA: "B+", Max: intPtr(90), MaxExclusive: true},
+	{Min: 83, GPA: "B", Max: intPtr(86)},
+	{Min: 80, GPA: "B-", Max: intPtr(83), MaxExclusive: true},
+	{Min: 70, GPA: "C", Max: intPtr(71), MinExclusive: true},
+}
+
+func intPtr(i int) *int { return &i }
+
 func TestGetGPA(t *testing.T) {
 	testCases := map[string]struct {
 		scales      Scales
 		grade       int
 		expectedGPA string
+		expectedErr error
 	}{
 		"Grade Found in Middle": {
 			scales: Scales{
@@ -28,7 +41,7 @@ func TestGetGPA(t *testing.T) {
 				{Min: 70, GPA: "C"},
 			},
 			grade:       65,
-			expectedGPA: "N/A",
+			expectedErr: ErrGradeOutOfScale,
 		},
 		"Exact Match": {
 			scales: Scales{
@@ -55,7 +68,7 @@ func TestGetGPA(t *testing.T) {
 				{Min: 70, GPA: "C"},
 			},
 			grade:       60,
-			expectedGPA: "N/A",
+			expectedErr: ErrGradeOutOfScale,
 		},
 		"Highest Grade": {
 			scales: Scales{
@@ -69,7 +82,7 @@ func TestGetGPA(t *testing.T) {
 		"Empty Scale": {
 			scales:      Scales{},
 			grade:       85,
-			expectedGPA: "N/A",
+			expectedErr: ErrGradeOutOfScale,
 		},
 		"Single Entry in Scale": {
 			scales: Scales{
@@ -78,15 +91,237 @@ func TestGetGPA(t *testing.T) {
 			grade:       85,
 			expectedGPA: "B",
 		},
+		"Plus Band": {
+			scales:      plusMinus,
+			grade:       89,
+			expectedGPA: "B+",
+		},
+		"Minus Band Exclusive Max": {
+			scales:      plusMinus,
+			grade:       82,
+			expectedGPA: "B-",
+		},
+		"Exclusive Max Boundary": {
+			scales:      plusMinus,
+			grade:       83,
+			expectedGPA: "B",
+		},
+		"Exclusive Min Boundary": {
+			scales:      plusMinus,
+			grade:       70,
+			expectedErr: ErrGradeOutOfScale,
+		},
+		"Above Bounded Highest Band": {
+			scales:      plusMinus,
+			grade:       101,
+			expectedErr: ErrGradeOutOfScale,
+		},
+		"Gap Between Bands": {
+			scales:      plusMinus,
+			grade:       75,
+			expectedErr: ErrGradeOutOfScale,
+		},
 	}
 
 	for name, tc := range testCases {
 		name := name
 		tc := tc
 		t.Run(name, func(t *testing.T) {
-			actualGPA := tc.scales.GetGPA(tc.grade)
+			actualGPA, err := tc.scales.GetGPA(tc.grade)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
 			require.Equal(t, tc.expectedGPA, actualGPA)
 
 		})
 	}
 }
+
+func TestScalesValidate(t *testing.T) {
+	testCases := map[string]struct {
+		scales  Scales
+		wantErr bool
+	}{
+		"valid": {
+			scales: Scales{
+				{Min: 90, GPA: "A"},
+				{Min: 80, GPA: "B"},
+			},
+		},
+		"empty": {
+			scales:  Scales{},
+			wantErr: true,
+		},
+		"missing gpa": {
+			scales: Scales{
+				{Min: 90, GPA: "A"},
+				{Min: 80},
+			},
+			wantErr: true,
+		},
+		"bounded bands with gaps": {
+			scales: plusMinus,
+		},
+		"exclusive max without max": {
+			scales: Scales{
+				{Min: 90, GPA: "A", MaxExclusive: true},
+			},
+			wantErr: true,
+		},
+		"max below min": {
+			scales: Scales{
+				{Min: 90, GPA: "A", Max: intPtr(80)},
+			},
+			wantErr: true,
+		},
+		"no grade between exclusive bounds": {
+			scales: Scales{
+				{Min: 90, GPA: "A", Max: intPtr(91), MinExclusive: true, MaxExclusive: true},
+			},
+			wantErr: true,
+		},
+		"overlapping max": {
+			scales: Scales{
+				{Min: 90, GPA: "A"},
+				{Min: 80, GPA: "B", Max: intPtr(90)},
+			},
+			wantErr: true,
+		},
+		"overlapping exclusive min": {
+			scales: Scales{
+				{Min: 90, GPA: "A"},
+				{Min: 89, GPA: "B", MinExclusive: true},
+			},
+			wantErr: true,
+		},
+		"duplicate min": {
+			scales: Scales{
+				{Min: 90, GPA: "A"},
+				{Min: 90, GPA: "B"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.scales.Validate()
+			require.Equal(t, tc.wantErr, err != nil)
+			if err != nil {
+				require.ErrorIs(t, err, ErrInvalidScales)
+			}
+		})
+	}
+}
+
+func TestScalesSorted(t *testing.T) {
+	scales := Scales{
+		{Min: 70, GPA: "C"},
+		{Min: 90, GPA: "A"},
+		{Min: 80, GPA: "B"},
+	}
+	sorted := scales.Sorted()
+	require.Equal(t, Scales{
+		{Min: 90, GPA: "A"},
+		{Min: 80, GPA: "B"},
+		{Min: 70, GPA: "C"},
+	}, sorted)
+	require.Equal(t, 70, scales[0].Min, "the receiver must not be modified")
+}
+
+func TestScaleTypeValid(t *testing.T) {
+	require.True(t, DefaultScaleType.Valid())
+	require.True(t, ScaleType("ECTS").Valid())
+	require.False(t, ScaleType("3.0").Valid())
+	require.False(t, ScaleType("").Valid())
+}
+
+func TestGradeValidate(t *testing.T) {
+	studentID, courseID := uuid.New(), uuid.New()
+	testCases := map[string]struct {
+		grade       Grade
+		expectedErr error
+	}{
+		"numeric grade":            {grade: Grade{StudentID: studentID, CourseID: courseID, Grade: 3}},
+		"withdrawn":                {grade: Grade{StudentID: studentID, CourseID: courseID, Type: WithdrawnGrade}},
+		"missing student":          {grade: Grade{CourseID: courseID, Grade: 3}, expectedErr: ErrInvalidGrade},
+		"negative grade":           {grade: Grade{StudentID: studentID, CourseID: courseID, Grade: -1}, expectedErr: ErrInvalidGrade},
+		"unknown type":             {grade: Grade{StudentID: studentID, CourseID: courseID, Type: "expelled"}, expectedErr: ErrInvalidGrade},
+		"pass with a value":        {grade: Grade{StudentID: studentID, CourseID: courseID, Grade: 3, Type: PassGrade}, expectedErr: ErrInvalidGrade},
+		"negative credits on pass": {grade: Grade{StudentID: studentID, CourseID: courseID, Credits: -1, Type: PassGrade}, expectedErr: ErrInvalidGrade},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tc.grade.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestGetGradeGPA(t *testing.T) {
+	scales := Scales{{Min: 3, GPA: "A"}, {Min: 0, GPA: "F"}}
+	testCases := map[string]struct {
+		grade    Grade
+		expected string
+	}{
+		"numeric":    {grade: Grade{Grade: 3}, expected: "A"},
+		"pass":       {grade: Grade{Type: PassGrade}, expected: "P"},
+		"fail":       {grade: Grade{Type: FailGrade}, expected: "NP"},
+		"incomplete": {grade: Grade{Type: IncompleteGrade}, expected: "I"},
+		"withdrawn":  {grade: Grade{Type: WithdrawnGrade}, expected: "W"},
+		"audit":      {grade: Grade{Type: AuditGrade}, expected: "AU"},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			gpa, err := scales.GetGradeGPA(tc.grade)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, gpa)
+		})
+	}
+}
+
+func TestGPAPoints(t *testing.T) {
+	points, ok := GPAPoints("3.7")
+	require.True(t, ok)
+	require.Equal(t, 3.7, points)
+	points, ok = GPAPoints("4")
+	require.True(t, ok)
+	require.Equal(t, 4.0, points)
+	for _, gpa := range []string{"A+", "W", "NaN", "Inf", ""} {
+		_, ok = GPAPoints(gpa)
+		require.False(t, ok, gpa)
+	}
+}
+
+func TestSummarizeGrades(t *testing.T) {
+	testCases := map[string]struct {
+		grades   []Grade
+		expected GradeSummary
+	}{
+		"no grades": {
+			expected: GradeSummary{},
+		},
+		"several grades": {
+			grades:   []Grade{{Grade: 70}, {Grade: 40}, {Grade: 85}},
+			expected: GradeSummary{Count: 3, Mean: 65, Min: 40, Max: 85},
+		},
+		"grades not counted": {
+			grades:   []Grade{{Grade: 70}, {Type: PassGrade}, {Grade: 40}, {Type: IncompleteGrade}},
+			expected: GradeSummary{Count: 2, Mean: 55, Min: 40, Max: 70},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, SummarizeGrades(tc.grades))
+		})
+	}
+}
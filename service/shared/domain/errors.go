@@ -7,4 +7,30 @@ import (
 var (
 	// ErrScaleNotFound is the error returned when the entity is not found.
 	ErrScaleNotFound = fmt.Errorf("not found")
+	// ErrStudentNotFound is the error returned when a student has no grades.
+	ErrStudentNotFound = fmt.Errorf("student not found")
+	// ErrInvalidScales is the error returned when scales can not be used to look up GPAs.
+	ErrInvalidScales = fmt.Errorf("invalid scales")
+	// ErrGradeOutOfScale is the error returned when a grade is in no band of a scale.
+	ErrGradeOutOfScale = fmt.Errorf("grade out of scale")
+	// ErrCourseNotFound is the error returned when a course has no grades.
+	ErrCourseNotFound = fmt.Errorf("course not found")
+	// ErrGradeNotFound is the error returned when a student has no grade in a course.
+	ErrGradeNotFound = fmt.Errorf("grade not found")
+	// ErrInvalidGrade is the error returned when a grade can not be recorded.
+	ErrInvalidGrade = fmt.Errorf("invalid grade")
+	// ErrInvalidConversion is the error returned when a grade can not be converted as asked.
+	ErrInvalidConversion = fmt.Errorf("invalid conversion")
+	// ErrInvalidRank is the error returned when a rank can not be computed as asked.
+	ErrInvalidRank = fmt.Errorf("invalid rank")
+	// ErrInvalidStandingRules is the error returned when standing rules can not be parsed.
+	ErrInvalidStandingRules = fmt.Errorf("invalid standing rules")
+	// ErrForbidden is the error returned when the caller is not allowed to see the data asked for.
+	ErrForbidden = fmt.Errorf("forbidden")
+	// ErrWebhookNotFound is the error returned when there is no webhook with the given id.
+	ErrWebhookNotFound = fmt.Errorf("webhook not found")
+	// ErrInvalidWebhook is the error returned when a webhook can not be registered.
+	ErrInvalidWebhook = fmt.Errorf("invalid webhook")
+	// ErrDeliveryNotFound is the error returned when a webhook has no delivery with the given id.
+	ErrDeliveryNotFound = fmt.Errorf("delivery not found")
 )
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event types, the names downstream systems subscribe to.
+const (
+	GradePosted  EventType = "grade.posted"
+	GradeChanged EventType = "grade.changed"
+	ScaleUpdated EventType = "scale.updated"
+	GPAChanged   EventType = "student.gpa_changed"
+	// StandingFlagged is emitted by the standing run of a term for every student meeting a standing rule.
+	StandingFlagged EventType = "student.standing_flagged"
+)
+
+// EventTypes are the event types known by the service.
+var EventTypes = []EventType{GradePosted, GradeChanged, ScaleUpdated, GPAChanged, StandingFlagged}
+
+type (
+	// EventType is the kind of change an event records.
+	EventType string
+
+	// Event records a change, written to the outbox in the same transaction as the change.
+	// Payload is the JSON encoding of the payload of its type, e.g. GradePostedPayload.
+	Event struct {
+		ID   uuid.UUID `json:"id" db:"id"`
+		Type EventType `json:"type" db:"type"`
+		// TenantID is the tenant the change was made in, set by the store from the context appending the event.
+		TenantID   string          `json:"tenant_id" db:"tenant_id"`
+		OccurredAt time.Time       `json:"occurred_at" db:"occurred_at"`
+		Payload    json.RawMessage `json:"payload" db:"payload"`
+		// Key identifies the change the event records when it may be recorded again, e.g. by a rerun; an event with
+		// the key of one already in the outbox of its tenant is dropped. It is empty for the events never dropped.
+		Key string `json:"-" db:"-"`
+	}
+
+	// GradePostedPayload is the payload of GradePosted: a student got their first grade in a course.
+	GradePostedPayload struct {
+		StudentID uuid.UUID `json:"student_id"`
+		CourseID  uuid.UUID `json:"course_id"`
+		Grade     int       `json:"grade"`
+		// Type is absent for numeric grades.
+		Type GradeType `json:"type,omitempty"`
+	}
+
+	// GradeChangedPayload is the payload of GradeChanged: the grade of a student in a course changed.
+	GradeChangedPayload struct {
+		StudentID uuid.UUID `json:"student_id"`
+		CourseID  uuid.UUID `json:"course_id"`
+		Previous  int       `json:"previous"`
+		Grade     int       `json:"grade"`
+		// PreviousType and Type are absent for numeric grades.
+		PreviousType GradeType `json:"previous_type,omitempty"`
+		Type         GradeType `json:"type,omitempty"`
+	}
+
+	// ScaleUpdatedPayload is the payload of ScaleUpdated: the bands of a scale were replaced,
+	// or removed when Bands is empty.
+	ScaleUpdatedPayload struct {
+		ScaleType ScaleType   `json:"scale_type"`
+		Bands     []ScaleBand `json:"bands"`
+	}
+
+	// GPAChangedPayload is the payload of GPAChanged: the average of the grades of a student changed.
+	// PreviousAverage is nil for the first grade of the student.
+	GPAChangedPayload struct {
+		StudentID       uuid.UUID `json:"student_id"`
+		PreviousAverage *float64  `json:"previous_average"`
+		Average         float64   `json:"average"`
+	}
+
+	// StandingFlaggedPayload is the payload of StandingFlagged: a student met standing rules in a term.
+	// The GPAs are nil when they are not applicable, see StandingMetrics.
+	StandingFlaggedPayload struct {
+		StudentID         uuid.UUID `json:"student_id"`
+		Term              string    `json:"term"`
+		Standings         []string  `json:"standings"`
+		TermGPA           *float64  `json:"term_gpa"`
+		TermCredits       int       `json:"term_credits"`
+		CumulativeGPA     *float64  `json:"cumulative_gpa"`
+		CumulativeCredits int       `json:"cumulative_credits"`
+	}
+
+	// ScaleBand is a band of a scale in an event payload.
+	ScaleBand struct {
+		Min int    `json:"min"`
+		GPA string `json:"gpa"`
+	}
+)
+
+// Valid reports whether the event type is one of the known EventTypes.
+func (t EventType) Valid() bool {
+	for _, known := range EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// NewEvent returns an event of the given type occurring now.
+func NewEvent(eventType EventType, payload any) (Event, error) {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("encoding %s payload: %w", eventType, err)
+	}
+	return Event{
+		ID:         uuid.New(),
+		Type:       eventType,
+		OccurredAt: time.Now().UTC(),
+		Payload:    raw,
+	}, nil
+}
+
+// NewGradePosted returns the GradePosted event of the grade.
+func NewGradePosted(grade Grade) (Event, error) {
+	return NewEvent(GradePosted, GradePostedPayload{
+		StudentID: grade.StudentID,
+		CourseID:  grade.CourseID,
+		Grade:     grade.Grade,
+		Type:      grade.Type,
+	})
+}
+
+// NewGradeChanged returns the GradeChanged event of a grade replacing the previous one.
+func NewGradeChanged(previous, grade Grade) (Event, error) {
+	return NewEvent(GradeChanged, GradeChangedPayload{
+		StudentID:    grade.StudentID,
+		CourseID:     grade.CourseID,
+		Previous:     previous.Grade,
+		Grade:        grade.Grade,
+		PreviousType: previous.Type,
+		Type:         grade.Type,
+	})
+}
+
+// NewScaleUpdated returns the ScaleUpdated event of the new bands of a scale, none when it was removed.
+func NewScaleUpdated(scaleType ScaleType, scales Scales) (Event, error) {
+	bands := make([]ScaleBand, len(scales))
+	for i, scale := range scales {
+		bands[i] = ScaleBand{Min: scale.Min, GPA: scale.GPA}
+	}
+	return NewEvent(ScaleUpdated, ScaleUpdatedPayload{ScaleType: scaleType, Bands: bands})
+}
+
+// NewGPAChanged returns the GPAChanged event of a student whose grades were previous and are now grades,
+// and false when their average did not change.
+func NewGPAChanged(studentID uuid.UUID, previous, grades []Grade) (Event, bool, error) {
+	average := SummarizeGrades(grades).Mean
+	payload := GPAChangedPayload{StudentID: studentID, Average: average}
+	if len(previous) > 0 {
+		previousAverage := SummarizeGrades(previous).Mean
+		if previousAverage == average {
+			return Event{}, false, nil
+		}
+		payload.PreviousAverage = &previousAverage
+	}
+	event, err := NewEvent(GPAChanged, payload)
+	return event, err == nil, err
+}
+
+// NewStandingFlagged returns the StandingFlagged event of a student meeting standing rules, keyed by the student
+// and the term so that a student is flagged once per term.
+func NewStandingFlagged(standing Standing) (Event, error) {
+	event, err := NewEvent(StandingFlagged, StandingFlaggedPayload{
+		StudentID:         standing.StudentID,
+		Term:              standing.Term,
+		Standings:         standing.Standings,
+		TermGPA:           standing.TermGPA,
+		TermCredits:       standing.TermCredits,
+		CumulativeGPA:     standing.CumulativeGPA,
+		CumulativeCredits: standing.CumulativeCredits,
+	})
+	event.Key = fmt.Sprintf("%s:%s:%s", StandingFlagged, standing.StudentID, standing.Term)
+	return event, err
+}
@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// Tie methods. With competition ranking, students tied share a rank and the next rank is skipped (1, 2, 2, 4);
+// with dense ranking, it is not (1, 2, 2, 3).
+const (
+	TiesCompetition TieMethod = "competition"
+	TiesDense       TieMethod = "dense"
+)
+
+type (
+	// TieMethod is how students with the same average are ranked.
+	TieMethod string
+
+	// StudentAverage is the average of all the grades of a student.
+	StudentAverage struct {
+		StudentID uuid.UUID `db:"student_id"`
+		Average   float64   `db:"average"`
+	}
+
+	// RankPosition is the position of a student in a cohort ranked by average, the highest first.
+	RankPosition struct {
+		StudentID       uuid.UUID `db:"student_id"`
+		Average         float64   `db:"average"`
+		CompetitionRank int       `db:"competition_rank"`
+		DenseRank       int       `db:"dense_rank"`
+		CohortSize      int       `db:"cohort_size"`
+		// CumeDist is the share of the cohort with an average up to the one of the student.
+		CumeDist float64 `db:"cume_dist"`
+	}
+
+	// ClassRank is the rank of a student in a cohort: every graded student, or those graded in a course.
+	ClassRank struct {
+		StudentID uuid.UUID
+		// CourseID is the course of the cohort, uuid.Nil for every graded student.
+		CourseID   uuid.UUID
+		Ties       TieMethod
+		Average    float64
+		Rank       int
+		CohortSize int
+		// Percentile is the percentage of the cohort with an average up to the one of the student.
+		Percentile float64
+	}
+)
+
+// Validate checks the tie method is known.
+func (m TieMethod) Validate() error {
+	switch m {
+	case TiesCompetition, TiesDense:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown tie method %q", ErrInvalidRank, m)
+}
+
+// RankAverages ranks the averages of a cohort, the highest first, as the rank, dense_rank and cume_dist window
+// functions of SQL do. Students tied keep the order they are given in.
+func RankAverages(averages []StudentAverage) []RankPosition {
+	sorted := append([]StudentAverage(nil), averages...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Average > sorted[j].Average
+	})
+	positions := make([]RankPosition, len(sorted))
+	for i, average := range sorted {
+		positions[i] = RankPosition{
+			StudentID:       average.StudentID,
+			Average:         average.Average,
+			CompetitionRank: i + 1,
+			DenseRank:       1,
+			CohortSize:      len(sorted),
+		}
+		if i > 0 {
+			previous := positions[i-1]
+			switch {
+			case average.Average == previous.Average:
+				positions[i].CompetitionRank, positions[i].DenseRank = previous.CompetitionRank, previous.DenseRank
+			default:
+				positions[i].DenseRank = previous.DenseRank + 1
+			}
+		}
+	}
+	// the students at or below an average are those from the first one tied with it to the last
+	for i := range positions {
+		atOrBelow := len(positions) - positions[i].CompetitionRank + 1
+		positions[i].CumeDist = float64(atOrBelow) / float64(len(positions))
+	}
+	return positions
+}
+
+// NewClassRank returns the rank of the position with the given tie method.
+func NewClassRank(position RankPosition, courseID uuid.UUID, ties TieMethod) ClassRank {
+	rank := position.CompetitionRank
+	if ties == TiesDense {
+		rank = position.DenseRank
+	}
+	return ClassRank{
+		StudentID:  position.StudentID,
+		CourseID:   courseID,
+		Ties:       ties,
+		Average:    position.Average,
+		Rank:       rank,
+		CohortSize: position.CohortSize,
+		Percentile: 100 * position.CumeDist,
+	}
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRankAverages(t *testing.T) {
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	positions := RankAverages([]StudentAverage{
+		{StudentID: a, Average: 2},
+		{StudentID: b, Average: 3.5},
+		{StudentID: c, Average: 3},
+		{StudentID: d, Average: 3},
+	})
+	require.Equal(t, []RankPosition{
+		{StudentID: b, Average: 3.5, CompetitionRank: 1, DenseRank: 1, CohortSize: 4, CumeDist: 1},
+		{StudentID: c, Average: 3, CompetitionRank: 2, DenseRank: 2, CohortSize: 4, CumeDist: 0.75},
+		{StudentID: d, Average: 3, CompetitionRank: 2, DenseRank: 2, CohortSize: 4, CumeDist: 0.75},
+		{StudentID: a, Average: 2, CompetitionRank: 4, DenseRank: 3, CohortSize: 4, CumeDist: 0.25},
+	}, positions)
+	require.Empty(t, RankAverages(nil))
+}
+
+func TestNewClassRank(t *testing.T) {
+	position := RankPosition{StudentID: uuid.New(), Average: 2, CompetitionRank: 4, DenseRank: 3, CohortSize: 4, CumeDist: 0.25}
+	testCases := map[string]struct {
+		ties     TieMethod
+		expected int
+	}{
+		"competition": {ties: TiesCompetition, expected: 4},
+		"dense":       {ties: TiesDense, expected: 3},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			rank := NewClassRank(position, uuid.Nil, tc.ties)
+			require.Equal(t, tc.expected, rank.Rank)
+			require.Equal(t, 25.0, rank.Percentile)
+			require.Equal(t, 4, rank.CohortSize)
+		})
+	}
+	require.NoError(t, TiesDense.Validate())
+	require.ErrorIs(t, TieMethod("fractional").Validate(), ErrInvalidRank)
+}
@@ -0,0 +1,245 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Standing metrics, the left-hand side of the conditions of the standing rules.
+const (
+	TermGPA           StandingMetric = "term_gpa"
+	TermCredits       StandingMetric = "term_credits"
+	CumulativeGPA     StandingMetric = "cumulative_gpa"
+	CumulativeCredits StandingMetric = "cumulative_credits"
+)
+
+// DefaultStandingRules flag the dean's list and academic probation.
+const DefaultStandingRules = "Dean's List: term_gpa >= 3.5 and term_credits >= 12; Probation: cumulative_gpa < 2.0"
+
+// standingOperators are the comparisons of the conditions, the longest first so that >= is not read as >.
+var standingOperators = []string{">=", "<=", "==", ">", "<"}
+
+type (
+	// StandingMetric is a figure of the grades of a student a standing rule looks at.
+	StandingMetric string
+
+	// StandingCondition compares a metric to a value, e.g. term_gpa >= 3.5.
+	StandingCondition struct {
+		Metric StandingMetric
+		Op     string
+		Value  float64
+	}
+
+	// StandingRule grants a standing, e.g. Dean's List, to the students meeting all of its conditions.
+	StandingRule struct {
+		Name       string
+		Conditions []StandingCondition
+	}
+
+	// StandingRules are evaluated in order, a student may meet several of them.
+	StandingRules []StandingRule
+
+	// StandingMetrics are the figures of the grades of a student in a term. A GPA is the average of the grades
+	// weighted by their credits, or the plain average when none of them has credits, and nil when none of the
+	// grades counts, see GradeType.Counted: it is not applicable, and the conditions on it are not met. The
+	// cumulative metrics cover the grades of the term, of the terms before and of unknown terms.
+	StandingMetrics struct {
+		Term              string
+		TermGPA           *float64
+		TermCredits       int
+		CumulativeGPA     *float64
+		CumulativeCredits int
+	}
+
+	// Standing is the academic standing of a student in a term: the names of the rules they meet, if any.
+	Standing struct {
+		StudentID uuid.UUID
+		StandingMetrics
+		Standings []string
+	}
+)
+
+// ParseStandingRules parses rules written as `name: condition and condition; name: condition`, where a
+// condition is `metric operator value`, e.g. DefaultStandingRules. Operators are >=, >, <=, < and ==.
+func ParseStandingRules(spec string) (StandingRules, error) {
+	var rules StandingRules
+	for _, raw := range strings.Split(spec, ";") {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		name, conditions, ok := strings.Cut(raw, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("%w: rule %q is not name: conditions", ErrInvalidStandingRules, strings.TrimSpace(raw))
+		}
+		rule := StandingRule{Name: name}
+		for _, rawCondition := range strings.Split(conditions, " and ") {
+			condition, err := parseStandingCondition(rawCondition)
+			if err != nil {
+				return nil, fmt.Errorf("rule %q: %w", name, err)
+			}
+			rule.Conditions = append(rule.Conditions, condition)
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("%w: no rules", ErrInvalidStandingRules)
+	}
+	return rules, nil
+}
+
+// MustParseStandingRules is like ParseStandingRules but panics if the rules can not be parsed.
+func MustParseStandingRules(spec string) StandingRules {
+	rules, err := ParseStandingRules(spec)
+	if err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+func parseStandingCondition(raw string) (StandingCondition, error) {
+	raw = strings.TrimSpace(raw)
+	for _, op := range standingOperators {
+		metric, value, ok := strings.Cut(raw, op)
+		if !ok {
+			continue
+		}
+		condition := StandingCondition{Metric: StandingMetric(strings.TrimSpace(metric)), Op: op}
+		if !condition.Metric.Valid() {
+			return StandingCondition{}, fmt.Errorf("%w: unknown metric %q", ErrInvalidStandingRules, condition.Metric)
+		}
+		var err error
+		if condition.Value, err = strconv.ParseFloat(strings.TrimSpace(value), 64); err != nil {
+			return StandingCondition{}, fmt.Errorf("%w: value of %q is not a number", ErrInvalidStandingRules, raw)
+		}
+		return condition, nil
+	}
+	return StandingCondition{}, fmt.Errorf("%w: condition %q has no operator", ErrInvalidStandingRules, raw)
+}
+
+// Valid reports whether the metric is known.
+func (m StandingMetric) Valid() bool {
+	switch m {
+	case TermGPA, TermCredits, CumulativeGPA, CumulativeCredits:
+		return true
+	}
+	return false
+}
+
+// Met reports whether the metrics meet the condition, never when its metric is not applicable.
+func (c StandingCondition) Met(metrics StandingMetrics) bool {
+	value, ok := metrics.value(c.Metric)
+	if !ok {
+		return false
+	}
+	switch c.Op {
+	case ">=":
+		return value >= c.Value
+	case ">":
+		return value > c.Value
+	case "<=":
+		return value <= c.Value
+	case "<":
+		return value < c.Value
+	case "==":
+		return value == c.Value
+	}
+	return false
+}
+
+// Evaluate returns the names of the rules the metrics meet, in the order of the rules.
+func (r StandingRules) Evaluate(metrics StandingMetrics) []string {
+	var standings []string
+	for _, rule := range r {
+		met := true
+		for _, condition := range rule.Conditions {
+			met = met && condition.Met(metrics)
+		}
+		if met {
+			standings = append(standings, rule.Name)
+		}
+	}
+	return standings
+}
+
+// value returns the value of the metric, and whether it is applicable.
+func (m StandingMetrics) value(metric StandingMetric) (float64, bool) {
+	switch metric {
+	case TermGPA:
+		return applicable(m.TermGPA)
+	case TermCredits:
+		return float64(m.TermCredits), true
+	case CumulativeGPA:
+		return applicable(m.CumulativeGPA)
+	case CumulativeCredits:
+		return float64(m.CumulativeCredits), true
+	}
+	return 0, false
+}
+
+func applicable(gpa *float64) (float64, bool) {
+	if gpa == nil {
+		return 0, false
+	}
+	return *gpa, true
+}
+
+// NewStandingMetrics computes the metrics of the grades of a student in a term.
+func NewStandingMetrics(term string, grades []Grade) StandingMetrics {
+	var inTerm, upToTerm []Grade
+	for _, grade := range grades {
+		if grade.Term == term {
+			inTerm = append(inTerm, grade)
+		}
+		if grade.Term <= term {
+			upToTerm = append(upToTerm, grade)
+		}
+	}
+	metrics := StandingMetrics{Term: term}
+	metrics.TermGPA, metrics.TermCredits = gpa(inTerm)
+	metrics.CumulativeGPA, metrics.CumulativeCredits = gpa(upToTerm)
+	return metrics
+}
+
+// gpa returns the average of the grades counting in GPAs weighted by their credits, or their plain average when
+// none of them has credits, and their credits. The average is nil when none of the grades counts.
+func gpa(grades []Grade) (*float64, int) {
+	grades = CountedGrades(grades)
+	if len(grades) == 0 {
+		return nil, 0
+	}
+	var points, credits int
+	for _, grade := range grades {
+		points += grade.Grade * grade.Credits
+		credits += grade.Credits
+	}
+	average := float64(points) / float64(credits)
+	if credits == 0 {
+		average = SummarizeGrades(grades).Mean
+	}
+	return &average, credits
+}
+
+// NewStanding evaluates the standing of a student in a term from all their grades.
+func NewStanding(studentID uuid.UUID, term string, grades []Grade, rules StandingRules) Standing {
+	metrics := NewStandingMetrics(term, grades)
+	return Standing{
+		StudentID:       studentID,
+		StandingMetrics: metrics,
+		Standings:       rules.Evaluate(metrics),
+	}
+}
+
+// LatestTerm returns the latest term the grades were given in, empty if none is known.
+func LatestTerm(grades []Grade) string {
+	var latest string
+	for _, grade := range grades {
+		if grade.Term > latest {
+			latest = grade.Term
+		}
+	}
+	return latest
+}
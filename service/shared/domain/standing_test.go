@@ -0,0 +1,127 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStandingRules(t *testing.T) {
+	testCases := map[string]struct {
+		spec        string
+		expected    StandingRules
+		expectedErr error
+	}{
+		"default": {
+			spec: DefaultStandingRules,
+			expected: StandingRules{
+				{Name: "Dean's List", Conditions: []StandingCondition{
+					{Metric: TermGPA, Op: ">=", Value: 3.5},
+					{Metric: TermCredits, Op: ">=", Value: 12},
+				}},
+				{Name: "Probation", Conditions: []StandingCondition{{Metric: CumulativeGPA, Op: "<", Value: 2}}},
+			},
+		},
+		"compact": {
+			spec:     "Honors:cumulative_credits>30;",
+			expected: StandingRules{{Name: "Honors", Conditions: []StandingCondition{{Metric: CumulativeCredits, Op: ">", Value: 30}}}},
+		},
+		"empty":            {spec: " ; ", expectedErr: ErrInvalidStandingRules},
+		"missing name":     {spec: "term_gpa >= 3", expectedErr: ErrInvalidStandingRules},
+		"unknown metric":   {spec: "Honors: gpa >= 3", expectedErr: ErrInvalidStandingRules},
+		"missing operator": {spec: "Honors: term_gpa 3", expectedErr: ErrInvalidStandingRules},
+		"not a number":     {spec: "Honors: term_gpa >= high", expectedErr: ErrInvalidStandingRules},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			rules, err := ParseStandingRules(tc.spec)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, rules)
+		})
+	}
+}
+
+func TestNewStanding(t *testing.T) {
+	rules, err := ParseStandingRules(DefaultStandingRules)
+	require.NoError(t, err)
+	studentID := uuid.New()
+	testCases := map[string]struct {
+		grades    []Grade
+		expected  StandingMetrics
+		standings []string
+	}{
+		"dean's list": {
+			grades: []Grade{
+				{Grade: 4, Term: "2024-2", Credits: 9},
+				{Grade: 3, Term: "2024-2", Credits: 3},
+				{Grade: 1, Term: "2024-1", Credits: 12},
+				{Grade: 0, Term: "2025-1", Credits: 6},
+			},
+			expected:  StandingMetrics{Term: "2024-2", TermGPA: ptr(3.75), TermCredits: 12, CumulativeGPA: ptr(2.375), CumulativeCredits: 24},
+			standings: []string{"Dean's List"},
+		},
+		"probation": {
+			grades: []Grade{
+				{Grade: 4, Term: "2024-2", Credits: 3},
+				{Grade: 1, Term: "2024-1", Credits: 9},
+			},
+			expected:  StandingMetrics{Term: "2024-2", TermGPA: ptr(4), TermCredits: 3, CumulativeGPA: ptr(1.75), CumulativeCredits: 12},
+			standings: []string{"Probation"},
+		},
+		"grades not counted": {
+			grades: []Grade{
+				{Grade: 4, Term: "2024-2", Credits: 12},
+				{Term: "2024-2", Credits: 3, Type: WithdrawnGrade},
+				{Term: "2024-1", Credits: 3, Type: PassGrade},
+			},
+			expected:  StandingMetrics{Term: "2024-2", TermGPA: ptr(4), TermCredits: 12, CumulativeGPA: ptr(4), CumulativeCredits: 12},
+			standings: []string{"Dean's List"},
+		},
+		"no grade counts": {
+			grades: []Grade{
+				{Term: "2024-2", Credits: 6, Type: PassGrade},
+				{Term: "2024-2", Credits: 3, Type: WithdrawnGrade},
+				{Term: "2024-1", Credits: 3, Type: AuditGrade},
+			},
+			expected: StandingMetrics{Term: "2024-2"},
+		},
+		"no grade counts in the term": {
+			grades: []Grade{
+				{Term: "2024-2", Credits: 6, Type: PassGrade},
+				{Grade: 1, Term: "2024-1", Credits: 12},
+			},
+			expected:  StandingMetrics{Term: "2024-2", CumulativeGPA: ptr(1), CumulativeCredits: 12},
+			standings: []string{"Probation"},
+		},
+		"without credits": {
+			grades: []Grade{
+				{Grade: 4, Term: "2024-2"},
+				{Grade: 2, Term: "2024-2"},
+				{Grade: 3},
+			},
+			expected: StandingMetrics{Term: "2024-2", TermGPA: ptr(3), CumulativeGPA: ptr(3)},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			standing := NewStanding(studentID, "2024-2", tc.grades, rules)
+			require.Equal(t, studentID, standing.StudentID)
+			require.Equal(t, tc.expected, standing.StandingMetrics)
+			require.Equal(t, tc.standings, standing.Standings)
+		})
+	}
+	require.Equal(t, "2025-1", LatestTerm([]Grade{{Term: "2024-2"}, {Term: "2025-1"}, {}}))
+}
+
+func ptr(v float64) *float64 {
+	return &v
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// GradeCount is how many times a grade was given.
+	GradeCount struct {
+		Grade int `db:"grade"`
+		Count int `db:"count"`
+	}
+
+	// Percentiles are grades below which the given share of the grades fall, interpolated between grades.
+	Percentiles struct {
+		P10 float64
+		P25 float64
+		P75 float64
+		P90 float64
+	}
+
+	// GradeStats describes the distribution of a set of grades. StdDev is the population standard deviation.
+	GradeStats struct {
+		Count       int
+		Mean        float64
+		Median      float64
+		StdDev      float64
+		Min         int
+		Max         int
+		Percentiles Percentiles
+		// Counts are the grades given, in ascending order, and how many times.
+		Counts []GradeCount
+	}
+
+	// LetterCount is how many grades fall in the band of a letter.
+	LetterCount struct {
+		GPA   string
+		Count int
+	}
+
+	// CourseStats is how a course went: the distribution of its grades and their histogram under a scale.
+	CourseStats struct {
+		CourseID  uuid.UUID
+		ScaleType ScaleType
+		GradeStats
+		// Histogram has a bar per band of the scale, the highest first, including the empty ones.
+		Histogram []LetterCount
+	}
+)
+
+// NewGradeStats computes the distribution of the grades given the number of times each was given.
+// Percentiles interpolate linearly between the closest grades, as the percentile_cont of SQL does.
+// The stats of no grades are all zeros.
+func NewGradeStats(counts []GradeCount) GradeStats {
+	sorted := make([]GradeCount, 0, len(counts))
+	for _, c := range counts {
+		if c.Count > 0 {
+			sorted = append(sorted, c)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Grade < sorted[j].Grade
+	})
+	stats := GradeStats{Counts: sorted}
+	if len(sorted) == 0 {
+		return stats
+	}
+
+	var sum float64
+	for _, c := range sorted {
+		stats.Count += c.Count
+		sum += float64(c.Grade * c.Count)
+	}
+	stats.Mean = sum / float64(stats.Count)
+	var squares float64
+	for _, c := range sorted {
+		squares += float64(c.Count) * (float64(c.Grade) - stats.Mean) * (float64(c.Grade) - stats.Mean)
+	}
+	stats.StdDev = math.Sqrt(squares / float64(stats.Count))
+	stats.Min, stats.Max = sorted[0].Grade, sorted[len(sorted)-1].Grade
+	stats.Median = percentile(sorted, stats.Count, 0.5)
+	stats.Percentiles = Percentiles{
+		P10: percentile(sorted, stats.Count, 0.1),
+		P25: percentile(sorted, stats.Count, 0.25),
+		P75: percentile(sorted, stats.Count, 0.75),
+		P90: percentile(sorted, stats.Count, 0.9),
+	}
+	return stats
+}
+
+// percentile returns the p-th percentile of the total grades of the sorted counts.
+func percentile(sorted []GradeCount, total int, p float64) float64 {
+	position := p * float64(total-1)
+	lower := int(math.Floor(position))
+	low, high := nth(sorted, lower), nth(sorted, lower+1)
+	return float64(low) + (position-float64(lower))*float64(high-low)
+}
+
+// nth returns the grade at the zero-based index of the sorted counts, the highest past the last one.
+func nth(sorted []GradeCount, index int) int {
+	for _, c := range sorted {
+		if index < c.Count {
+			return c.Grade
+		}
+		index -= c.Count
+	}
+	return sorted[len(sorted)-1].Grade
+}
+
+// Histogram buckets the grade counts into the bands of the scales, which should be sorted by Min in descending
+// order. Like GetGPA, it returns ErrGradeOutOfScale when a grade is in no band.
+func (s Scales) Histogram(counts []GradeCount) ([]LetterCount, error) {
+	histogram := make([]LetterCount, len(s))
+	for i, scale := range s {
+		histogram[i].GPA = scale.GPA
+	}
+	for _, c := range counts {
+		idx, ok := s.band(c.Grade)
+		if !ok {
+			return nil, fmt.Errorf("%w: grade %d", ErrGradeOutOfScale, c.Grade)
+		}
+		histogram[idx].Count += c.Count
+	}
+	return histogram, nil
+}
+
+// NewCourseStats computes how a course went under the given scales.
+// It returns ErrGradeOutOfScale when a grade is in no band.
+func NewCourseStats(courseID uuid.UUID, scaleType ScaleType, stats GradeStats, scales Scales) (CourseStats, error) {
+	histogram, err := scales.Histogram(stats.Counts)
+	if err != nil {
+		return CourseStats{}, err
+	}
+	return CourseStats{
+		CourseID:   courseID,
+		ScaleType:  scaleType,
+		GradeStats: stats,
+		Histogram:  histogram,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGradeStats(t *testing.T) {
+	testCases := map[string]struct {
+		counts   []GradeCount
+		expected GradeStats
+	}{
+		"no grades": {
+			expected: GradeStats{Counts: []GradeCount{}},
+		},
+		"single grade": {
+			counts: []GradeCount{{Grade: 3, Count: 2}},
+			expected: GradeStats{
+				Count: 2, Mean: 3, Median: 3, Min: 3, Max: 3,
+				Percentiles: Percentiles{P10: 3, P25: 3, P75: 3, P90: 3},
+				Counts:      []GradeCount{{Grade: 3, Count: 2}},
+			},
+		},
+		"unsorted with empty counts": {
+			counts: []GradeCount{{Grade: 10, Count: 1}, {Grade: 2, Count: 1}, {Grade: 7, Count: 0},
+				{Grade: 1, Count: 1}, {Grade: 4, Count: 1}, {Grade: 3, Count: 1}},
+			expected: GradeStats{
+				Count: 5, Mean: 4, Median: 3, StdDev: math.Sqrt(10), Min: 1, Max: 10,
+				Percentiles: Percentiles{P10: 1.4, P25: 2, P75: 4, P90: 7.6},
+				Counts: []GradeCount{{Grade: 1, Count: 1}, {Grade: 2, Count: 1}, {Grade: 3, Count: 1},
+					{Grade: 4, Count: 1}, {Grade: 10, Count: 1}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			stats := NewGradeStats(tc.counts)
+			require.InDelta(t, tc.expected.StdDev, stats.StdDev, 1e-9)
+			require.InDelta(t, tc.expected.Percentiles.P10, stats.Percentiles.P10, 1e-9)
+			require.InDelta(t, tc.expected.Percentiles.P90, stats.Percentiles.P90, 1e-9)
+			stats.StdDev, stats.Percentiles.P10, stats.Percentiles.P90 = tc.expected.StdDev, tc.expected.Percentiles.P10, tc.expected.Percentiles.P90
+			require.Equal(t, tc.expected, stats)
+		})
+	}
+}
+
+func TestScales_Histogram(t *testing.T) {
+	scales := Scales{{Min: 0, GPA: "F"}, {Min: 2, GPA: "C"}, {Min: 4, GPA: "A"}}.Sorted()
+	counts := []GradeCount{{Grade: 1, Count: 2}, {Grade: 4, Count: 1}, {Grade: 9, Count: 3}}
+	histogram, err := scales.Histogram(counts)
+	require.NoError(t, err)
+	require.Equal(t, []LetterCount{{GPA: "A", Count: 4}, {GPA: "C", Count: 0}, {GPA: "F", Count: 2}}, histogram)
+
+	// grades in no band are an error, as they are for GPAs
+	partial := Scales{{Min: 2, GPA: "pass"}}
+	_, err = partial.Histogram(counts)
+	require.ErrorIs(t, err, ErrGradeOutOfScale)
+
+	gapped := Scales{{Min: 4, GPA: "A"}, {Min: 0, GPA: "F", Max: intPtr(1)}}
+	_, err = gapped.Histogram([]GradeCount{{Grade: 1, Count: 2}, {Grade: 2, Count: 5}, {Grade: 4, Count: 1}})
+	require.ErrorIs(t, err, ErrGradeOutOfScale)
+	histogram, err = gapped.Histogram([]GradeCount{{Grade: 1, Count: 2}, {Grade: 4, Count: 1}, {Grade: 9, Count: 3}})
+	require.NoError(t, err)
+	require.Equal(t, []LetterCount{{GPA: "A", Count: 4}, {GPA: "F", Count: 2}}, histogram)
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delivery statuses. A pending delivery is attempted until it is delivered, or dead once out of attempts.
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryDead      DeliveryStatus = "dead"
+)
+
+type (
+	// EventTypeSet is a set of event types, stored comma separated.
+	EventTypeSet []EventType
+
+	// Webhook is the subscription of a partner system to the events, posted to URL signed with Secret.
+	Webhook struct {
+		ID uuid.UUID `db:"id"`
+		// TenantID is the tenant the webhook gets the events of, set by the store from the context creating it.
+		TenantID string `db:"tenant_id"`
+		URL      string `db:"url"`
+		Secret   string `db:"secret"`
+		// EventTypes are the types of the events posted, all of them when empty.
+		EventTypes EventTypeSet `db:"event_types"`
+		Active     bool         `db:"active"`
+		CreatedAt  time.Time    `db:"created_at"`
+		UpdatedAt  time.Time    `db:"updated_at"`
+	}
+
+	// DeliveryStatus is the state of a delivery.
+	DeliveryStatus string
+
+	// Delivery is an event to post to a webhook, and how its attempts went.
+	Delivery struct {
+		ID        uuid.UUID `db:"id"`
+		WebhookID uuid.UUID `db:"webhook_id"`
+		// TenantID is the tenant of the webhook, set by the store from the context enqueuing the delivery.
+		TenantID  string    `db:"tenant_id"`
+		EventID   uuid.UUID `db:"event_id"`
+		EventType EventType `db:"event_type"`
+		// Body is the JSON encoding of the event, posted as is.
+		Body          []byte         `db:"body"`
+		Status        DeliveryStatus `db:"status"`
+		Attempts      int            `db:"attempts"`
+		NextAttemptAt time.Time      `db:"next_attempt_at"`
+		// LastStatusCode is the status the webhook answered the last attempt with, zero when it did not answer.
+		LastStatusCode int       `db:"last_status_code"`
+		LastError      string    `db:"last_error"`
+		CreatedAt      time.Time `db:"created_at"`
+		UpdatedAt      time.Time `db:"updated_at"`
+	}
+)
+
+// Validate checks the webhook can be registered: its URL is absolute http(s), and its event types are known.
+func (w Webhook) Validate() error {
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https url", ErrInvalidWebhook)
+	}
+	for _, eventType := range w.EventTypes {
+		if !eventType.Valid() {
+			return fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, eventType)
+		}
+	}
+	return nil
+}
+
+// Subscribed reports whether the webhook is posted the events of the given type.
+func (w Webhook) Subscribed(eventType EventType) bool {
+	if !w.Active {
+		return false
+	}
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Value implements driver.Valuer.
+func (s EventTypeSet) Value() (driver.Value, error) {
+	types := make([]string, len(s))
+	for i, t := range s {
+		types[i] = string(t)
+	}
+	return strings.Join(types, ","), nil
+}
+
+// Scan implements sql.Scanner.
+func (s *EventTypeSet) Scan(src any) error {
+	var value string
+	switch src := src.(type) {
+	case string:
+		value = src
+	case []byte:
+		value = string(src)
+	case nil:
+	default:
+		return fmt.Errorf("unsupported event types %T", src)
+	}
+	*s = nil
+	if value == "" {
+		return nil
+	}
+	for _, t := range strings.Split(value, ",") {
+		*s = append(*s, EventType(t))
+	}
+	return nil
+}
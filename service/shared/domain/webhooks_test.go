@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookValidate(t *testing.T) {
+	testCases := map[string]struct {
+		webhook     Webhook
+		expectedErr error
+	}{
+		"all events": {
+			webhook: Webhook{URL: "https://partner.example.com/hooks"},
+		},
+		"some events": {
+			webhook: Webhook{URL: "http://localhost:9000", EventTypes: EventTypeSet{GPAChanged, GradePosted}},
+		},
+		"relative url": {
+			webhook:     Webhook{URL: "/hooks"},
+			expectedErr: ErrInvalidWebhook,
+		},
+		"unsupported scheme": {
+			webhook:     Webhook{URL: "ftp://partner.example.com"},
+			expectedErr: ErrInvalidWebhook,
+		},
+		"unknown event type": {
+			webhook:     Webhook{URL: "https://partner.example.com", EventTypes: EventTypeSet{"grade.deleted"}},
+			expectedErr: ErrInvalidWebhook,
+		},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			require.ErrorIs(t, tc.webhook.Validate(), tc.expectedErr)
+		})
+	}
+}
+
+func TestWebhookSubscribed(t *testing.T) {
+	require.True(t, Webhook{Active: true}.Subscribed(ScaleUpdated))
+	require.False(t, Webhook{}.Subscribed(ScaleUpdated))
+	webhook := Webhook{Active: true, EventTypes: EventTypeSet{GPAChanged}}
+	require.True(t, webhook.Subscribed(GPAChanged))
+	require.False(t, webhook.Subscribed(GradePosted))
+}
+
+func TestEventTypeSet(t *testing.T) {
+	value, err := EventTypeSet{GradePosted, GPAChanged}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "grade.posted,student.gpa_changed", value)
+
+	var s EventTypeSet
+	require.NoError(t, s.Scan([]byte("grade.posted,student.gpa_changed")))
+	require.Equal(t, EventTypeSet{GradePosted, GPAChanged}, s)
+	require.NoError(t, s.Scan(""))
+	require.Nil(t, s)
+}
+
+func TestNewGPAChanged(t *testing.T) {
+	studentID := uuid.New()
+	first := []Grade{{StudentID: studentID, Grade: 2}}
+
+	event, changed, err := NewGPAChanged(studentID, nil, first)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.JSONEq(t, `{"student_id":"`+studentID.String()+`","previous_average":null,"average":2}`, string(event.Payload))
+
+	event, changed, err = NewGPAChanged(studentID, first, append(first, Grade{StudentID: studentID, Grade: 3}))
+	require.NoError(t, err)
+	require.True(t, changed)
+	var payload GPAChangedPayload
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	require.Equal(t, 2.0, *payload.PreviousAverage)
+	require.Equal(t, 2.5, payload.Average)
+
+	_, changed, err = NewGPAChanged(studentID, first, append(first, Grade{StudentID: studentID, Grade: 2}))
+	require.NoError(t, err)
+	require.False(t, changed)
+}
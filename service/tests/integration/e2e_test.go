@@ -8,17 +8,25 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/mnabbasabadi/grading/api/client"
 	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
-	"github.com/mnabbasabadi/grading/service/tests/support/client"
+	"github.com/mnabbasabadi/grading/service/foundation/auth"
+	"github.com/mnabbasabadi/grading/service/foundation/db"
+	"github.com/mnabbasabadi/grading/service/shared/domain"
 	"github.com/mnabbasabadi/grading/service/tests/support/storage/sqlt"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
+// tenant is the institution of the tenant client, besides the default one.
+const tenant = "north-high"
+
 type E2ETestSuite struct {
 	suite.Suite
-	client   *client.GradeAPITestClient
-	pgClient *sqlt.TestDAO
+	client       *client.Client
+	tenantClient *client.Client
+	pgClient     *sqlt.TestDAO
+	dbDriver     db.Driver
 }
 
 func (s *E2ETestSuite) SetupSuite() {
@@ -31,9 +39,8 @@ func (s *E2ETestSuite) TestE2E() {
 	s.T().Run("fail", func(t *testing.T) {
 		t.Run("invalid scale type", func(t *testing.T) {
 			ctx := context.Background()
-			scaleType := gradingAPI.ScaleType("invalid")
-			resp, err := s.client.GetGPA(ctx, scaleType, 10, 0)
-			require.Error(t, err)
+			resp, err := s.client.ListGrades(ctx, client.GradesQuery{ScaleType: "invalid", Limit: 10})
+			require.ErrorIs(t, err, client.ErrBadRequest)
 			require.Nil(t, resp.Grades)
 		})
 	})
@@ -44,30 +51,90 @@ func (s *E2ETestSuite) TestE2E() {
 			CourseId:  uuid.NewString(),
 			Grade:     "2",
 			Gpa:       "C",
+			Gpas:      defaultGPAs("C"),
 		}
 		grade2 := gradingAPI.Grade{
 			StudentId: uuid.NewString(),
 			CourseId:  uuid.NewString(),
 			Grade:     "3",
 			Gpa:       "B",
+			Gpas:      defaultGPAs("B"),
 		}
 		err := s.pgClient.InsertGrade(ctx, grade1.StudentId, grade1.CourseId, 2)
 		require.NoError(t, err)
 		err = s.pgClient.InsertGrade(ctx, grade2.StudentId, grade2.CourseId, 3)
 		require.NoError(t, err)
 
-		scaleType := gradingAPI.ScaleType("default")
-		rsp, err := s.client.GetGPA(ctx, scaleType, 10, 0)
+		rsp, err := s.client.ListGrades(ctx, client.GradesQuery{ScaleType: "default", Limit: 10})
 		require.NoError(t, err)
 		require.Len(t, rsp.Grades, 2)
 		require.Equal(t, grade1, rsp.Grades[0])
 		require.Equal(t, grade2, rsp.Grades[1])
 
-		require.Equal(t, rsp.Pagination, &gradingAPI.Pagination{
+		require.Equal(t, rsp.Pagination, gradingAPI.Pagination{
 			Total:  2,
 			Limit:  10,
 			Offset: 0,
 		})
 
+		var iterated []gradingAPI.Grade
+		it := s.client.Grades(ctx, client.GradesQuery{ScaleType: "default", Limit: 1})
+		for it.Next() {
+			iterated = append(iterated, it.Grade())
+		}
+		require.NoError(t, it.Err())
+		require.Equal(t, []gradingAPI.Grade{grade1, grade2}, iterated)
+
+	})
+	s.T().Run("tenants", func(t *testing.T) {
+		ctx := context.Background()
+		studentID, courseID := uuid.New(), uuid.New()
+		_, err := s.tenantClient.PostGrade(ctx, studentID, courseID, 3)
+		require.NoError(t, err)
+		_, err = s.tenantClient.ListGrades(ctx, client.GradesQuery{ScaleType: "default", Limit: 10})
+		require.ErrorIs(t, err, client.ErrBadRequest, "a new tenant has no scales")
+
+		require.NoError(t, s.pgClient.SetScales(auth.WithTenant(ctx, tenant), "default", domain.Scales{{Min: 0, GPA: "P"}}))
+		rsp, err := s.tenantClient.ListGrades(ctx, client.GradesQuery{ScaleType: "default", Limit: 10})
+		require.NoError(t, err)
+		require.Equal(t, []gradingAPI.Grade{
+			{StudentId: studentID.String(), CourseId: courseID.String(), Grade: "3", Gpa: "P", Gpas: defaultGPAs("P")},
+		}, rsp.Grades)
+
+		rsp, err = s.client.ListGrades(ctx, client.GradesQuery{ScaleType: "default", Limit: 10})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, rsp.Pagination.Total, "the default tenant must not see the grades of others")
+
+		t.Run("row level security", func(t *testing.T) {
+			if s.dbDriver != db.Postgres {
+				t.Skip("only postgres enforces row level security")
+			}
+			count, err := s.pgClient.CountGradesAs(ctx, tenant)
+			require.NoError(t, err)
+			require.Equal(t, 1, count)
+			count, err = s.pgClient.CountGradesAs(ctx, "south-high")
+			require.NoError(t, err)
+			require.Zero(t, count)
+		})
+	})
+	s.T().Run("grade types", func(t *testing.T) {
+		ctx := context.Background()
+		studentID, courseID := uuid.New(), uuid.New()
+		_, err := s.tenantClient.PostOutcome(ctx, studentID, courseID, gradingAPI.Withdrawn)
+		require.NoError(t, err)
+
+		rsp, err := s.tenantClient.ListGrades(ctx, client.GradesQuery{ScaleType: "default", Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, rsp.Grades, 2)
+		withdrawn := gradingAPI.Withdrawn
+		require.Equal(t, gradingAPI.Grade{
+			StudentId: studentID.String(), CourseId: courseID.String(), Grade: "W", Gpa: "W", Gpas: defaultGPAs("W"),
+			Type: &withdrawn,
+		}, rsp.Grades[1])
 	})
 }
+
+// defaultGPAs are the GPAs of a grade of the letter under the default scale, the only one asked for.
+func defaultGPAs(letter string) *map[string]gradingAPI.ScaleGPA {
+	return &map[string]gradingAPI.ScaleGPA{string(domain.DefaultScaleType): {Letter: letter}}
+}
@@ -8,14 +8,12 @@ package integration
 import (
 	"context"
 	"net"
-	"net/http"
 	"os"
 	"testing"
 	"time"
 
-	gradingAPI "github.com/mnabbasabadi/grading/api/v1"
+	"github.com/mnabbasabadi/grading/api/client"
 	"github.com/mnabbasabadi/grading/service/pkg/app"
-	"github.com/mnabbasabadi/grading/service/tests/support/client"
 	"github.com/mnabbasabadi/grading/service/tests/support/storage/sqlt"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -34,7 +32,7 @@ func TestMain(m *testing.M) {
 func TestIntegration(t *testing.T) {
 	ctx := context.TODO()
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	dbConn, dbCloser, err := getDB(logger)
+	dbConn, dbDriver, dbCloser, err := getDB(logger)
 	require.NoError(t, err)
 	defer dbCloser()
 
@@ -44,6 +42,7 @@ func TestIntegration(t *testing.T) {
 	params := app.Params{
 		Logger:       logger,
 		DB:           dbConn,
+		DBDriver:     dbDriver,
 		HTTPRegister: httpServer.Register,
 	}
 
@@ -53,13 +52,17 @@ func TestIntegration(t *testing.T) {
 	addr := net.JoinHostPort(host, port)
 	httpServer.Start(addr, nil)
 
-	testClient, err := client.NewGradingAPITestClient(addr, gradingAPI.WithHTTPClient(http.DefaultClient))
+	testClient, err := client.New(addr)
+	require.NoError(t, err)
+	tenantClient, err := client.New(addr, client.WithTenant(tenant))
 	require.NoError(t, err)
 
 	suites := map[string]suite.TestingSuite{
 		"E2E": &E2ETestSuite{
-			client:   testClient,
-			pgClient: sqlt.NewTestDAO(dbConn),
+			client:       testClient,
+			tenantClient: tenantClient,
+			pgClient:     sqlt.NewTestDAO(dbConn, env.Repository()),
+			dbDriver:     dbDriver,
 		},
 	}
 	for _, s := range suites {
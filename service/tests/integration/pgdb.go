@@ -5,12 +5,13 @@
 package integration
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"time"
 
-	"github.com/cenkalti/backoff/v4"
 	"github.com/mnabbasabadi/grading/service/foundation/db"
 
 	"github.com/jmoiron/sqlx"
@@ -18,7 +19,7 @@ import (
 	"github.com/orlangure/gnomock/preset/postgres"
 	"golang.org/x/exp/slog"
 
-	migration "github.com/mnabbasabadi/grading/service/internal/storage/migration/postgres"
+	"github.com/mnabbasabadi/grading/service/internal/storage/migration"
 )
 
 const (
@@ -56,7 +57,7 @@ func connectLocal() (*sqlx.DB, func() error, error) {
 		return gnomock.Stop(container)
 	}, nil
 }
-func connectHost() (*sqlx.DB, func() error, error) {
+func connectHost(logger *slog.Logger) (*sqlx.DB, func() error, error) {
 	user, ok := os.LookupEnv("POSTGRES_USER")
 	if !ok {
 		return nil, nil, fmt.Errorf("POSTGRES_USER not set")
@@ -85,7 +86,8 @@ func connectHost() (*sqlx.DB, func() error, error) {
 		db.WithDatabase(database),
 		db.WithSSlMode(db.Disable),
 	}
-	pg, err := db.ConnectToPostgres(opts...)
+	policy := db.RetryPolicy{MaxAttempts: 100, InitialInterval: time.Second, MaxInterval: time.Second}
+	pg, err := db.ConnectWithRetry(context.Background(), db.Postgres, policy, logger, opts...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -93,38 +95,62 @@ func connectHost() (*sqlx.DB, func() error, error) {
 		return pg.Close()
 	}, nil
 }
-func getDB(logger *slog.Logger) (*sqlx.DB, func(), error) {
-	isCli := isLocal()
+
+// connectSQLite opens a SQLite database in a temporary directory, for environments without docker.
+func connectSQLite() (*sqlx.DB, func() error, error) {
+	dir, err := os.MkdirTemp("", "grading")
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := db.ConnectToSQLite(db.WithDatabase(filepath.Join(dir, "grading.db")))
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, func() error {
+		if err := conn.Close(); err != nil {
+			return err
+		}
+		return os.RemoveAll(dir)
+	}, nil
+}
+
+// getDB connects to the database of the driver set in DB.DRIVER, postgres by default, and migrates it.
+func getDB(logger *slog.Logger) (*sqlx.DB, db.Driver, func(), error) {
+	driver := dbDriver()
 	var (
 		pg     *sqlx.DB
 		err    error
 		closer func() error
 	)
-	if isCli {
+	switch {
+	case driver == db.SQLite:
+		pg, closer, err = connectSQLite()
+	case isLocal():
 		pg, closer, err = connectLocal()
-	} else {
-		pg, closer, err = connectHost()
+	default:
+		pg, closer, err = connectHost(logger)
 	}
 	if err != nil {
-		return nil, nil, err
-	}
-
-	if err := backoff.Retry(func() error {
-		return pg.Ping()
-	}, backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Second), 100)); err != nil {
-		return nil, nil, err
+		return nil, "", nil, err
 	}
 
-	if err := migration.GooseUP(pg.DB); err != nil {
-		return nil, nil, err
+	if err := migration.Up(context.Background(), driver, pg.DB); err != nil {
+		return nil, "", nil, err
 	}
-	return pg, func() {
+	return pg, driver, func() {
 		if err := closer(); err != nil {
 			logger.With(err).Error("error closing database connection")
 		}
 	}, nil
 }
 
+func dbDriver() db.Driver {
+	if driver, ok := os.LookupEnv("DB.DRIVER"); ok && driver != "" {
+		return db.Driver(driver)
+	}
+	return db.Postgres
+}
+
 func isLocal() bool {
 	isCli, ok := os.LookupEnv("IS_CLI")
 	if !ok {
@@ -2,32 +2,67 @@ package sqlt
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
-	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms/postgres"
+	"github.com/mnabbasabadi/grading/service/internal/storage/rdbms"
 )
 
 // TestDAO ...
 type TestDAO struct {
-	postgres.Repository
+	rdbms.Repository
 	db *sqlx.DB
 }
 
 // NewTestDAO ...
-func NewTestDAO(db *sqlx.DB) *TestDAO {
+func NewTestDAO(db *sqlx.DB, repo rdbms.Repository) *TestDAO {
 	return &TestDAO{
 		db:         db,
-		Repository: postgres.New(db),
+		Repository: repo,
 	}
 }
 
-// language=postgresql
-const insertgrade = `INSERT INTO grade (student_id, course_id, grade) VALUES ($1, $2, $3)`
+// language=sql
+const insertgrade = `INSERT INTO grade (student_id, course_id, grade) VALUES (?, ?, ?)`
 
 // InsertGrade ...
 func (t *TestDAO) InsertGrade(ctx context.Context, student_id, course_id string, grade int) error {
-	if _, err := t.db.ExecContext(ctx, insertgrade, student_id, course_id, grade); err != nil {
+	if _, err := t.db.ExecContext(ctx, t.db.Rebind(insertgrade), student_id, course_id, grade); err != nil {
 		return err
 	}
 	return nil
 }
+
+// language=postgresql
+const (
+	createRole  = `create role grading_rls_test nologin`
+	grantSelect = `grant select on grade to grading_rls_test`
+	setRole     = `set local role grading_rls_test`
+	setTenant   = `select set_config('grading.tenant_id', $1, true)`
+	countGrades = `select count(*) from grade`
+)
+
+// CountGradesAs counts the grades a role without privileges beyond reading them sees when scoped to the tenant,
+// in a transaction rolled back afterwards. Postgres only: it checks the row level security policies.
+func (t *TestDAO) CountGradesAs(ctx context.Context, tenant string) (int, error) {
+	tx, err := t.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	for _, query := range []string{createRole, grantSelect, setRole} {
+		if _, err := tx.ExecContext(ctx, query); err != nil {
+			return 0, fmt.Errorf("%s: %w", query, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, setTenant, tenant); err != nil {
+		return 0, err
+	}
+	var count int
+	if err := tx.GetContext(ctx, &count, countGrades); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
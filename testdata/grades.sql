@@ -1,7 +0,0 @@
-/*
- student_id and course_id are uuids
- */
-INSERT INTO grade(student_id, course_id, grade) VALUES ('a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11', 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a12', 3.5);
-INSERT INTO grade(student_id, course_id, grade) VALUES ('a1eebc99-9c0b-4ef8-bb6d-6bb9bd380a11', 'a1eebc99-9c0b-4ef8-bb6d-6bb9bd380a13', 4.0);
-INSERT INTO grade(student_id, course_id, grade) VALUES ('a2eebc99-9c0b-4ef8-bb6d-6bb9bd380a11', 'a2eebc99-9c0b-4ef8-bb6d-6bb9bd380a14', 3.0);
-INSERT INTO grade(student_id, course_id, grade) VALUES ('a3eebc99-9c0b-4ef8-bb6d-6bb9bd380a15', 'a3eebc99-9c0b-4ef8-bb6d-6bb9bd380a12', 3.0);
\ No newline at end of file